@@ -410,6 +410,27 @@ Admin.prototype.getConfig = function () {
     return this._sendRequest("get", "/getConfig", null, options.callback);
 };
 
+/**
+ * Method mint a block immediately, only supported by the dev consensus.
+ *
+ * @param {Function} [callback] - Without callback return data synchronous.
+ *
+ * @return [block]{@link https://github.com/nebulasio/wiki/blob/master/rpc_admin.md#mintblock}
+ *
+ * @example
+ * var admin = new Neb().admin;
+ * //sync
+ * var block = admin.mintBlock();
+ * //async
+ * admin.mintBlock(function(block) {
+ * //code
+ * });
+ */
+Admin.prototype.mintBlock = function () {
+    var options = utils.argumentsToObject(['callback'], arguments);
+    return this._sendRequest("post", "/mintBlock", null, options.callback);
+};
+
 Admin.prototype._sendRequest = function (method, api, params, callback) {
     var action = this._path + api;
     if (typeof callback === "function") {
@@ -832,6 +853,117 @@ API.prototype.getDynasty = function (height, callback) {
     return this._sendRequest("post", "/dynasty", params, callback);
 };
 
+/**
+ * Method get nebulas rank of the address.
+ *
+ * @param {Object} options
+ * @param {HexString} options.address
+ * @param {Number} options.period - The period of nebulas rank, 0 for the latest.
+ * @param {Function} [options.callback] - Without callback return data synchronous.
+ *
+ * @return [nr]{@link https://github.com/nebulasio/wiki/blob/master/rpc.md#getnrbyaddress}
+ *
+ * @example
+ * var api = new Neb().api;
+ * var nr = api.getNRByAddress({address: "n1QsosVXKxiV3B4iDWNmxfN4VqpHn2TeUcn", period: 0});
+ */
+API.prototype.getNRByAddress = function () {
+    var options = utils.argumentsToObject(['address', 'period', 'callback'], arguments);
+    var params = { "address": options.address, "period": options.period };
+    return this._sendRequest("post", "/nr", params, options.callback);
+};
+
+/**
+ * Method get the developer incentive rewards of the period.
+ *
+ * @param {Object} options
+ * @param {Number} options.period - The period of dip, 0 for the latest.
+ * @param {Function} [options.callback] - Without callback return data synchronous.
+ *
+ * @return [rewards]{@link https://github.com/nebulasio/wiki/blob/master/rpc.md#getdiprewards}
+ *
+ * @example
+ * var api = new Neb().api;
+ * var rewards = api.getDipRewards({period: 0});
+ */
+API.prototype.getDipRewards = function () {
+    var options = utils.argumentsToObject(['period', 'callback'], arguments);
+    var params = { "period": options.period };
+    return this._sendRequest("post", "/dipRewards", params, options.callback);
+};
+
+/**
+ * Method get the produced and missed blocks of the miners in a dynasty.
+ *
+ * @param {Object} options
+ * @param {Number} options.height - A block height in the dynasty, 0 for the latest irreversible block.
+ * @param {Function} [options.callback] - Without callback return data synchronous.
+ *
+ * @return [stats]{@link https://github.com/nebulasio/wiki/blob/master/rpc.md#getminerstats}
+ *
+ * @example
+ * var api = new Neb().api;
+ * var stats = api.getMinerStats({height: 0});
+ */
+API.prototype.getMinerStats = function () {
+    var options = utils.argumentsToObject(['height', 'callback'], arguments);
+    var params = { "height": options.height };
+    return this._sendRequest("post", "/minerStats", params, options.callback);
+};
+
+/**
+ * Method get the source, deployer and public functions of a contract.
+ *
+ * @param {Object} options
+ * @param {HexString} options.address - Contract address.
+ * @param {Function} [options.callback] - Without callback return data synchronous.
+ *
+ * @return [contract]{@link https://github.com/nebulasio/wiki/blob/master/rpc.md#getcontract}
+ *
+ * @example
+ * var api = new Neb().api;
+ * var contract = api.getContract({address: "n1rVLTRxQEXscTgThmbTnn2NqdWFEKwpYUM"});
+ */
+API.prototype.getContract = function () {
+    var options = utils.argumentsToObject(['address', 'callback'], arguments);
+    var params = { "address": options.address };
+    return this._sendRequest("post", "/getContract", params, options.callback);
+};
+
+/**
+ * Method get the gas schedules of NVM operations, and the version activated at the tail block.
+ *
+ * @param {Function} [callback] - Without callback return data synchronous.
+ *
+ * @return [gasSchedules]{@link https://github.com/nebulasio/wiki/blob/master/rpc.md#getgasschedules}
+ *
+ * @example
+ * var api = new Neb().api;
+ * var schedules = api.getGasSchedules();
+ */
+API.prototype.getGasSchedules = function () {
+    var options = utils.argumentsToObject(['callback'], arguments);
+    return this._sendRequest("post", "/getGasSchedules", {}, options.callback);
+};
+
+/**
+ * Method get the finality proof of block, the pre-commits signed by its dynasty members.
+ *
+ * @param {String} hash
+ * @param {Function} [callback] - Without callback return data synchronous.
+ *
+ * @return [finalityProof]{@link https://github.com/nebulasio/wiki/blob/master/rpc.md#getfinalityproof}
+ *
+ * @example
+ * var api = new Neb().api;
+ * var proof = api.getFinalityProof("ec239d532249f84f158ef8ec9262e1d3d439709ebf4dd5f7c1036b26c6fe8073");
+ */
+API.prototype.getFinalityProof = function () {
+    var options = utils.argumentsToObject(['hash', 'callback'], arguments);
+    var params = { "hash": options.hash };
+    return this._sendRequest("post", "/getFinalityProof", params, options.callback);
+};
+
 API.prototype._sendRequest = function (method, api, params, callback) {
     var action = this._path + api;
     if (typeof callback === "function") {
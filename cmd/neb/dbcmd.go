@@ -0,0 +1,142 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/urfave/cli"
+)
+
+var (
+	// DBRepairFlag repair the database after check
+	DBRepairFlag = cli.BoolFlag{
+		Name:  "repair",
+		Usage: "roll the tail back to the last consistent block",
+	}
+
+	dbCommand = cli.Command{
+		Name:     "db",
+		Usage:    "Manage the chain database",
+		Category: "BLOCKCHAIN COMMANDS",
+		Description: `
+Manage the chain database in the datadir of config.`,
+
+		Subcommands: []cli.Command{
+			{
+				Name:   "migrate",
+				Usage:  "Migrate a flat database into keyspaces",
+				Action: MergeFlags(migrateDB),
+				Description: `
+    neb db migrate
+
+Move blocks, height indexes, tail/LIB pointers and trie nodes of a database
+created by an old version into their keyspaces. Stop the node before migration.`,
+			},
+			{
+				Name:   "check",
+				Usage:  "Check the integrity of database",
+				Action: MergeFlags(checkDB),
+				Flags:  []cli.Flag{DBRepairFlag},
+				Description: `
+    neb db check [--repair]
+
+Walk the canonical chain from the latest irreversible block to tail, verify the
+state/txs/events/consensus roots of every block resolve in database and report
+missing nodes. With --repair the tail is rolled back to the last consistent block.
+Stop the node before check.`,
+			},
+		},
+	}
+)
+
+func migrateDB(ctx *cli.Context) error {
+	neb, err := makeNeb(ctx)
+	if err != nil {
+		return err
+	}
+
+	stor, err := storage.NewStorage(neb.Config().Chain.Storage, neb.Config().Chain.Datadir)
+	if err != nil {
+		FatalF("open database failed: %v", err)
+	}
+	defer stor.Close()
+
+	err = core.CheckStorageScheme(stor)
+	if err == nil {
+		fmt.Println("database is up to date, nothing to migrate")
+		return nil
+	}
+	if err != core.ErrIncompatibleStorageScheme {
+		FatalF("check database failed: %v", err)
+	}
+
+	result, err := core.MigrateStorageScheme(stor)
+	if err != nil {
+		FatalF("migrate database failed: %v", err)
+	}
+	fmt.Printf("migrated blocks: %d, indexes: %d, metas: %d, trie nodes: %d, dropped: %d, skipped: %d\n",
+		result.Blocks, result.Indexes, result.Metas, result.States, result.Dropped, result.Skipped)
+	return nil
+}
+
+func checkDB(ctx *cli.Context) error {
+	neb, err := makeNeb(ctx)
+	if err != nil {
+		return err
+	}
+
+	stor, err := storage.NewStorage(neb.Config().Chain.Storage, neb.Config().Chain.Datadir)
+	if err != nil {
+		FatalF("open database failed: %v", err)
+	}
+	defer stor.Close()
+
+	if err := core.CheckStorageScheme(stor); err != nil {
+		FatalF("check database failed: %v", err)
+	}
+
+	report, err := core.CheckStorageIntegrity(stor)
+	if err != nil {
+		FatalF("check database failed: %v", err)
+	}
+
+	fmt.Printf("lib: %d %s\n", report.LIBHeight, report.LIBHash)
+	fmt.Printf("tail: %d %s\n", report.TailHeight, report.TailHash)
+	fmt.Printf("checked blocks: %d\n", report.Checked)
+	for _, node := range report.Missing {
+		fmt.Printf("missing %s node %s in block %d %s\n", node.Kind, node.Hash, node.Height, node.Block)
+	}
+	if report.Consistent() {
+		fmt.Println("database is consistent")
+		return nil
+	}
+	fmt.Printf("last consistent block: %d %s\n", report.ConsistentHeight, report.ConsistentHash)
+
+	if !ctx.Bool(DBRepairFlag.Name) {
+		return nil
+	}
+	if err := core.RepairStorage(stor, report); err != nil {
+		FatalF("repair database failed: %v", err)
+	}
+	fmt.Printf("tail is rolled back to %d %s\n", report.ConsistentHeight, report.ConsistentHash)
+	return nil
+}
@@ -69,6 +69,18 @@ var (
 		Usage: "chain data storage dirctory",
 	}
 
+	// ChainStorageFlag chain storage backend
+	ChainStorageFlag = cli.StringFlag{
+		Name:  "chain.storage",
+		Usage: "chain data storage backend, rocksdb, leveldb, boltdb or memory",
+	}
+
+	// ChainConsensusFlag chain consensus engine
+	ChainConsensusFlag = cli.StringFlag{
+		Name:  "chain.consensus",
+		Usage: "chain consensus engine, dpos, poa, pod or dev",
+	}
+
 	// ChainKeyDirFlag chain key dir
 	ChainKeyDirFlag = cli.StringFlag{
 		Name:  "chain.keydir",
@@ -115,6 +127,8 @@ var (
 	ChainFlags = []cli.Flag{
 		ChainIDFlag,
 		ChainDataDirFlag,
+		ChainStorageFlag,
+		ChainConsensusFlag,
 		ChainKeyDirFlag,
 		ChainStartMineFlag,
 		ChainCoinbaseFlag,
@@ -264,6 +278,12 @@ func chainConfig(ctx *cli.Context, cfg *nebletpb.ChainConfig) {
 	if ctx.GlobalIsSet(ChainDataDirFlag.Name) {
 		cfg.Datadir = ctx.GlobalString(ChainDataDirFlag.Name)
 	}
+	if ctx.GlobalIsSet(ChainStorageFlag.Name) {
+		cfg.Storage = ctx.GlobalString(ChainStorageFlag.Name)
+	}
+	if ctx.GlobalIsSet(ChainConsensusFlag.Name) {
+		cfg.Consensus = ctx.GlobalString(ChainConsensusFlag.Name)
+	}
 	if ctx.GlobalIsSet(ChainKeyDirFlag.Name) {
 		cfg.Keydir = ctx.GlobalString(ChainKeyDirFlag.Name)
 	}
@@ -71,6 +71,7 @@ func main() {
 		licenseCommand,
 		configCommand,
 		blockDumpCommand,
+		dbCommand,
 	}
 	sort.Sort(cli.CommandsByName(app.Commands))
 
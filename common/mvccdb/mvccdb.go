@@ -431,6 +431,11 @@ func (db *MVCCDB) GetParentDB() *MVCCDB {
 	return db.parentDB
 }
 
+// BaseStorage return the storage the db is layered on.
+func (db *MVCCDB) BaseStorage() storage.Storage {
+	return db.storage
+}
+
 func (db *MVCCDB) getFromStorage(key []byte) ([]byte, error) {
 	return db.storage.Get(key)
 }
@@ -443,6 +448,40 @@ func (db *MVCCDB) delFromStorage(key []byte) error {
 	return db.storage.Del(key)
 }
 
+// NewIterator return an iterator over the committed entries whose key has the prefix,
+// uncommitted changes in staging table are invisible to the iterator.
+func (db *MVCCDB) NewIterator(prefix []byte) (storage.Iterator, error) {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	if db.isPreparedDB && db.isPreparedDBClosed {
+		return nil, ErrPreparedDBIsClosed
+	}
+	return db.storage.NewIterator(prefix)
+}
+
+// NewRangeIterator return an iterator over the committed entries in [start, limit).
+func (db *MVCCDB) NewRangeIterator(start []byte, limit []byte) (storage.Iterator, error) {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	if db.isPreparedDB && db.isPreparedDBClosed {
+		return nil, ErrPreparedDBIsClosed
+	}
+	return db.storage.NewRangeIterator(start, limit)
+}
+
+// NewSnapshot return a point-in-time view of the committed entries.
+func (db *MVCCDB) NewSnapshot() (storage.Snapshot, error) {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	if db.isPreparedDB && db.isPreparedDBClosed {
+		return nil, ErrPreparedDBIsClosed
+	}
+	return db.storage.NewSnapshot()
+}
+
 // EnableBatch enable batch write.
 func (db *MVCCDB) EnableBatch() {
 }
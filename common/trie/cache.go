@@ -0,0 +1,116 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package trie
+
+import (
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/nebulasio/go-nebulas/storage"
+)
+
+// DefaultNodeCacheSize the default count of nodes in cache
+const DefaultNodeCacheSize = 65536
+
+// nodes are addressed by the hash of their content, and cached per base storage,
+// so a node in one storage never hides the same node missing in another.
+var (
+	nodeCacheLock sync.RWMutex
+	nodeCache, _  = lru.New(DefaultNodeCacheSize)
+)
+
+// SetNodeCacheSize replace the node cache with a new one of size, the cache is disabled if size is 0
+func SetNodeCacheSize(size int) error {
+	var cache *lru.Cache
+	if size > 0 {
+		c, err := lru.New(size)
+		if err != nil {
+			return err
+		}
+		cache = c
+	}
+
+	nodeCacheLock.Lock()
+	nodeCache = cache
+	nodeCacheLock.Unlock()
+	metricsNodeCacheSize.Update(0)
+	return nil
+}
+
+func currentNodeCache() *lru.Cache {
+	nodeCacheLock.RLock()
+	defer nodeCacheLock.RUnlock()
+	return nodeCache
+}
+
+// LayeredStorage is implemented by the storages layered on a base storage, such as MVCCDB,
+// the tries built on them share the node cache of the base storage. Only the nodes read
+// from the base storage are cached, the nodes staged in the layered storage are not.
+type LayeredStorage interface {
+	BaseStorage() storage.Storage
+}
+
+type nodeCacheKey struct {
+	scope storage.Storage
+	hash  string
+}
+
+// cacheScope return the base storage whose nodes are cached together.
+func cacheScope(stor storage.Storage) storage.Storage {
+	for {
+		layered, ok := stor.(LayeredStorage)
+		if !ok || layered.BaseStorage() == nil {
+			return stor
+		}
+		stor = layered.BaseStorage()
+	}
+}
+
+// cachedNode return a copy of the cached node, nodes are modified in place while updating trie.
+func cachedNode(stor storage.Storage, hash []byte) *node {
+	cache := currentNodeCache()
+	if cache == nil {
+		return nil
+	}
+	if v, ok := cache.Get(nodeCacheKey{cacheScope(stor), string(hash)}); ok {
+		metricsNodeCacheHit.Mark(1)
+		return v.(*node).copy()
+	}
+	metricsNodeCacheMiss.Mark(1)
+	return nil
+}
+
+func cacheNode(stor storage.Storage, n *node) {
+	cache := currentNodeCache()
+	if cache == nil {
+		return
+	}
+	cache.Add(nodeCacheKey{cacheScope(stor), string(n.Hash)}, n.copy())
+	metricsNodeCacheSize.Update(int64(cache.Len()))
+}
+
+func (n *node) copy() *node {
+	val := make([][]byte, len(n.Val))
+	copy(val, n.Val)
+	return &node{
+		Hash:  n.Hash,
+		Bytes: n.Bytes,
+		Val:   val,
+	}
+}
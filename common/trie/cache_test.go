@@ -0,0 +1,161 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package trie
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+// stagingStorage stages the writes on the base storage until commit, like MVCCDB.
+type stagingStorage struct {
+	storage.Storage
+	staging map[string][]byte
+}
+
+func newStagingStorage(base storage.Storage) *stagingStorage {
+	return &stagingStorage{Storage: base, staging: make(map[string][]byte)}
+}
+
+func (s *stagingStorage) Get(key []byte) ([]byte, error) {
+	if v, ok := s.staging[string(key)]; ok {
+		return v, nil
+	}
+	return s.Storage.Get(key)
+}
+
+func (s *stagingStorage) Put(key []byte, value []byte) error {
+	s.staging[string(key)] = value
+	return nil
+}
+
+func (s *stagingStorage) BaseStorage() storage.Storage {
+	return s.Storage
+}
+
+func (s *stagingStorage) commit() error {
+	for k, v := range s.staging {
+		if err := s.Storage.Put([]byte(k), v); err != nil {
+			return err
+		}
+	}
+	s.rollBack()
+	return nil
+}
+
+func (s *stagingStorage) rollBack() {
+	s.staging = make(map[string][]byte)
+}
+
+func TestNodeCache(t *testing.T) {
+	defer SetNodeCacheSize(DefaultNodeCacheSize)
+	assert.Nil(t, SetNodeCacheSize(16))
+
+	stor, err := storage.NewMemoryStorage()
+	assert.Nil(t, err)
+	tr, err := NewTrie(nil, stor, false)
+	assert.Nil(t, err)
+	_, err = tr.Put([]byte("a1"), []byte("value1"))
+	assert.Nil(t, err)
+	_, err = tr.Put([]byte("a2"), []byte("value2"))
+	assert.Nil(t, err)
+	root := tr.RootHash()
+
+	// nodes are served from cache once fetched.
+	value, err := tr.Get([]byte("a1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value1"), value)
+	assert.NotNil(t, cachedNode(stor, root))
+
+	// the cache is shared across tries and clones.
+	other, err := NewTrie(root, stor, false)
+	assert.Nil(t, err)
+	assert.Nil(t, stor.Del(root))
+	value, err = other.Get([]byte("a2"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value2"), value)
+
+	// modifying a fetched node does not change the cached one.
+	n, err := other.fetchNode(root)
+	assert.Nil(t, err)
+	n.Val[0] = []byte("modified")
+	cached := cachedNode(stor, root)
+	assert.NotEqual(t, []byte("modified"), cached.Val[0])
+
+	clone, err := other.Clone()
+	assert.Nil(t, err)
+	_, err = clone.Put([]byte("a3"), []byte("value3"))
+	assert.Nil(t, err)
+	value, err = other.Get([]byte("a1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value1"), value)
+	_, err = other.Get([]byte("a3"))
+	assert.Equal(t, ErrNotFound, err)
+
+	// a node cached for one storage does not hide it missing in another.
+	missing, err := storage.NewMemoryStorage()
+	assert.Nil(t, err)
+	orphan := &Trie{rootHash: root, storage: missing}
+	_, err = orphan.Get([]byte("a1"))
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+
+	// the cache is bypassed when disabled.
+	assert.Nil(t, SetNodeCacheSize(0))
+	_, err = other.Get([]byte("a1"))
+	assert.Equal(t, ErrNotFound, err)
+}
+
+func TestNodeCacheRollBack(t *testing.T) {
+	defer SetNodeCacheSize(DefaultNodeCacheSize)
+	assert.Nil(t, SetNodeCacheSize(16))
+
+	base, err := storage.NewMemoryStorage()
+	assert.Nil(t, err)
+	stor := newStagingStorage(base)
+	tr, err := NewTrie(nil, stor, false)
+	assert.Nil(t, err)
+	_, err = tr.Put([]byte("a1"), []byte("value1"))
+	assert.Nil(t, err)
+	root := tr.RootHash()
+
+	// the staged nodes are read but not cached.
+	value, err := tr.Get([]byte("a1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value1"), value)
+	assert.Nil(t, cachedNode(stor, root))
+
+	// the nodes rolled back are missing.
+	stor.rollBack()
+	_, err = tr.Get([]byte("a1"))
+	assert.Equal(t, ErrNotFound, err)
+
+	// the nodes committed to base storage are cached.
+	tr, err = NewTrie(nil, stor, false)
+	assert.Nil(t, err)
+	_, err = tr.Put([]byte("a1"), []byte("value1"))
+	assert.Nil(t, err)
+	assert.Nil(t, stor.commit())
+	value, err = tr.Get([]byte("a1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value1"), value)
+	assert.NotNil(t, cachedNode(stor, root))
+	assert.NotNil(t, cachedNode(base, root))
+}
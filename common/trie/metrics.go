@@ -0,0 +1,30 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package trie
+
+import (
+	metrics "github.com/nebulasio/go-nebulas/metrics"
+)
+
+// Metrics for trie
+var (
+	metricsNodeCacheHit  = metrics.NewMeter("neb.trie.cache.hit")
+	metricsNodeCacheMiss = metrics.NewMeter("neb.trie.cache.miss")
+	metricsNodeCacheSize = metrics.NewGauge("neb.trie.cache.size")
+)
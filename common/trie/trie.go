@@ -132,9 +132,32 @@ func (t *Trie) createNode(val [][]byte) (*node, error) {
 	return n, nil
 }
 
-// FetchNode in trie
+// FetchNode in trie, read through the shared node cache
 func (t *Trie) fetchNode(hash []byte) (*node, error) {
-	ir, err := t.storage.Get(hash)
+	if n := cachedNode(t.storage, hash); n != nil {
+		return n, nil
+	}
+	// only the nodes read from the base storage are cached, the nodes staged
+	// in a layered storage are gone once it rolls back.
+	scope := cacheScope(t.storage)
+	n, err := loadNode(scope, hash)
+	if err == nil {
+		cacheNode(scope, n)
+		return n, nil
+	}
+	if scope == t.storage || err != storage.ErrKeyNotFound {
+		return nil, err
+	}
+	return t.loadNode(hash)
+}
+
+// LoadNode from storage
+func (t *Trie) loadNode(hash []byte) (*node, error) {
+	return loadNode(t.storage, hash)
+}
+
+func loadNode(stor storage.Storage, hash []byte) (*node, error) {
+	ir, err := stor.Get(hash)
 
 	if err != nil {
 		return nil, err
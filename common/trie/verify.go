@@ -0,0 +1,114 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package trie
+
+import (
+	"github.com/nebulasio/go-nebulas/storage"
+)
+
+// LeafRefs return the roots of tries referenced by a leaf value, e.g. the variables of an account.
+type LeafRefs func(value []byte) ([][]byte, error)
+
+// Verifier walks tries and finds the nodes missing in storage.
+// The complete subtrees are remembered, so tries sharing nodes are verified only once.
+type Verifier struct {
+	trie     *Trie
+	complete map[string]bool
+}
+
+// NewVerifier return a verifier on storage
+func NewVerifier(storage storage.Storage) *Verifier {
+	return &Verifier{
+		trie:     &Trie{storage: storage},
+		complete: make(map[string]bool),
+	}
+}
+
+// Verify walks the trie from rootHash and returns the hashes of missing nodes.
+// The tries referenced by leaves are walked too if refs is not nil.
+func (v *Verifier) Verify(rootHash []byte, refs LeafRefs) ([][]byte, error) {
+	if len(rootHash) == 0 {
+		return nil, nil
+	}
+	missing := [][]byte{}
+	if _, err := v.walk(rootHash, refs, make(map[string]bool), &missing); err != nil {
+		return nil, err
+	}
+	return missing, nil
+}
+
+func (v *Verifier) walk(hash []byte, refs LeafRefs, found map[string]bool, missing *[][]byte) (bool, error) {
+	if v.complete[string(hash)] {
+		return true, nil
+	}
+
+	// nodes are loaded from storage, the cache may hide the missing ones.
+	n, err := v.trie.loadNode(hash)
+	if err == ErrNotFound {
+		if !found[string(hash)] {
+			found[string(hash)] = true
+			*missing = append(*missing, hash)
+		}
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	flag, err := n.Type()
+	if err != nil {
+		return false, err
+	}
+	var children [][]byte
+	childRefs := refs
+	switch flag {
+	case branch:
+		for _, child := range n.Val {
+			if len(child) > 0 {
+				children = append(children, child)
+			}
+		}
+	case ext:
+		children = [][]byte{n.Val[2]}
+	case leaf:
+		if refs != nil {
+			if children, err = refs(n.Val[2]); err != nil {
+				return false, err
+			}
+		}
+		// leaves of referenced tries are plain values.
+		childRefs = nil
+	}
+
+	complete := true
+	for _, child := range children {
+		if len(child) == 0 {
+			continue
+		}
+		ok, err := v.walk(child, childRefs, found, missing)
+		if err != nil {
+			return false, err
+		}
+		complete = complete && ok
+	}
+	if complete {
+		v.complete[string(hash)] = true
+	}
+	return complete, nil
+}
@@ -0,0 +1,70 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package trie
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifier(t *testing.T) {
+	stor, err := storage.NewMemoryStorage()
+	assert.Nil(t, err)
+
+	vars, err := NewTrie(nil, stor, false)
+	assert.Nil(t, err)
+	_, err = vars.Put([]byte("var"), []byte("value"))
+	assert.Nil(t, err)
+
+	tr, err := NewTrie(nil, stor, false)
+	assert.Nil(t, err)
+	for _, key := range []string{"a1", "a2", "b1", "c1"} {
+		_, err = tr.Put([]byte(key), vars.RootHash())
+		assert.Nil(t, err)
+	}
+	refs := func(value []byte) ([][]byte, error) {
+		return [][]byte{value}, nil
+	}
+
+	missing, err := NewVerifier(stor).Verify(tr.RootHash(), refs)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(missing))
+
+	// the referenced trie is lost.
+	assert.Nil(t, stor.Del(vars.RootHash()))
+	missing, err = NewVerifier(stor).Verify(tr.RootHash(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(missing))
+
+	verifier := NewVerifier(stor)
+	missing, err = verifier.Verify(tr.RootHash(), refs)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{vars.RootHash()}, missing)
+	// incomplete subtrees are walked again.
+	missing, err = verifier.Verify(tr.RootHash(), refs)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{vars.RootHash()}, missing)
+
+	assert.Nil(t, stor.Del(tr.RootHash()))
+	missing, err = verifier.Verify(tr.RootHash(), refs)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{tr.RootHash()}, missing)
+}
@@ -0,0 +1,370 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package dev
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// const
+const (
+	DefaultMaxUnlockDuration time.Duration = 1<<63 - 1
+
+	// a block packs txs for at most mintDurationInMs.
+	mintDurationInMs = int64(1000)
+	// the pool is checked again after idleInterval if no tx can be packed.
+	idleInterval = time.Second
+	// the VRF ancestor of a block is its grandparent.
+	blocksInDynasty = uint64(1)
+)
+
+// Errors in Dev Consensus
+var (
+	ErrMissingMinerForDev    = errors.New("missing miner for dev consensus")
+	ErrInvalidBlockTimestamp = errors.New("invalid block timestamp, should be same as consensus's timestamp")
+	ErrInvalidBlockInterval  = errors.New("invalid block interval, should be later than parent")
+	ErrInvalidBlockProposer  = errors.New("invalid block proposer")
+	ErrCannotMintWhenPending = errors.New("cannot mint block now, waiting for cancel pending again")
+	ErrCannotMintWhenDisable = errors.New("cannot mint block now, waiting for enable it again")
+	ErrAppendNewBlockFailed  = errors.New("failed to append new block to real chain")
+	ErrNoTransactionsToPack  = errors.New("no transactions can be packed")
+)
+
+// Dev an instant-seal consensus for development, the configured miner mints a block as soon as
+// transactions arrive or a block is requested, and every block is final once it is on chain.
+type Dev struct {
+	quitCh     chan bool
+	subscriber *core.EventSubscriber
+
+	chain   *core.BlockChain
+	am      core.AccountManager
+	emitter *core.EventEmitter
+
+	coinbase *core.Address
+	miner    *core.Address
+
+	mintLock sync.Mutex
+	idleAt   time.Time
+
+	enable  bool
+	pending bool
+}
+
+// NewDev create Dev instance.
+func NewDev() *Dev {
+	dev := &Dev{
+		quitCh:     make(chan bool, 5),
+		subscriber: core.NewEventSubscriber(1024, []string{core.TopicPendingTransaction}),
+		enable:     false,
+		pending:    true,
+	}
+	return dev
+}
+
+// Setup a dev consensus handler
+func (dev *Dev) Setup(neblet core.Neblet) error {
+	dev.chain = neblet.BlockChain()
+	dev.am = neblet.AccountManager()
+	dev.emitter = neblet.EventEmitter()
+
+	chainConfig := neblet.Config().Chain
+	miner, err := core.AddressParse(chainConfig.Miner)
+	if err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"address": chainConfig.Miner,
+			"err":     err,
+		}).Error("Failed to parse miner address.")
+		return ErrMissingMinerForDev
+	}
+	coinbase := miner
+	if len(chainConfig.Coinbase) > 0 {
+		if coinbase, err = core.AddressParse(chainConfig.Coinbase); err != nil {
+			logging.CLog().WithFields(logrus.Fields{
+				"address": chainConfig.Coinbase,
+				"err":     err,
+			}).Error("Failed to parse coinbase address.")
+			return err
+		}
+	}
+	dev.coinbase = coinbase
+	dev.miner = miner
+	return nil
+}
+
+// Start start dev service.
+func (dev *Dev) Start() {
+	logging.CLog().Info("Starting Dev Mining...")
+	dev.emitter.Register(dev.subscriber)
+	go dev.loop()
+}
+
+// Stop stop dev service.
+func (dev *Dev) Stop() {
+	logging.CLog().Info("Stopping Dev Mining...")
+	dev.DisableMining()
+	dev.emitter.Deregister(dev.subscriber)
+	dev.quitCh <- true
+}
+
+// EnableMining start the consensus
+func (dev *Dev) EnableMining(passphrase string) error {
+	if err := dev.am.Unlock(dev.miner, []byte(passphrase), DefaultMaxUnlockDuration); err != nil {
+		return err
+	}
+	dev.enable = true
+	logging.CLog().Info("Enabled Dev Mining...")
+	return nil
+}
+
+// DisableMining stop the consensus
+func (dev *Dev) DisableMining() error {
+	if err := dev.am.Lock(dev.miner); err != nil {
+		return err
+	}
+	dev.enable = false
+	logging.CLog().Info("Disable Dev Mining...")
+	return nil
+}
+
+// Enable returns is mining
+func (dev *Dev) Enable() bool {
+	return dev.enable
+}
+
+// Pending return if consensus can do mining now
+func (dev *Dev) Pending() bool {
+	return dev.pending
+}
+
+// SuspendMining pend dev mining
+func (dev *Dev) SuspendMining() {
+	logging.CLog().Info("Suspended Dev Mining.")
+	dev.pending = true
+}
+
+// ResumeMining continue dev mining
+func (dev *Dev) ResumeMining() {
+	logging.CLog().Info("Resumed Dev Mining.")
+	dev.pending = false
+}
+
+// ForkChoice select the highest block as new tail
+func (dev *Dev) ForkChoice() error {
+	bc := dev.chain
+	tailBlock := bc.TailBlock()
+	newTailBlock := tailBlock
+	for _, v := range bc.DetachedTailBlocks() {
+		if v.Height() > newTailBlock.Height() {
+			newTailBlock = v
+		}
+	}
+	if newTailBlock.Hash().Equals(tailBlock.Hash()) {
+		return nil
+	}
+	if err := bc.SetTailBlock(newTailBlock); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"new tail": newTailBlock,
+			"old tail": tailBlock,
+			"err":      err,
+		}).Debug("Failed to set new tail block.")
+		return err
+	}
+	logging.VLog().WithFields(logrus.Fields{
+		"new tail": newTailBlock,
+		"old tail": tailBlock,
+	}).Info("change to new tail.")
+	return nil
+}
+
+// UpdateLIB set the tail as the latest irreversible block
+func (dev *Dev) UpdateLIB() {
+	tail := dev.chain.TailBlock()
+	if tail.Hash().Equals(dev.chain.LIB().Hash()) {
+		return
+	}
+	if err := dev.chain.StoreLIBHashToStorage(tail); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"tail": tail,
+			"err":  err,
+		}).Debug("Failed to store latest irreversible block.")
+		return
+	}
+	dev.chain.SetLIB(tail)
+	dev.chain.EventEmitter().Trigger(&state.Event{
+		Topic: core.TopicLibBlock,
+		Data:  tail.String(),
+	})
+}
+
+// VerifyBlock verify the block is minted by the configured miner
+func (dev *Dev) VerifyBlock(block *core.Block) error {
+	if block.Timestamp() != block.ConsensusRoot().Timestamp {
+		return ErrInvalidBlockTimestamp
+	}
+	if !byteutils.Hash(dev.miner.Bytes()).Equals(block.ConsensusRoot().Proposer) {
+		return ErrInvalidBlockProposer
+	}
+	signer, err := core.RecoverSignerFromSignature(block.Alg(), block.Hash(), block.Signature())
+	if err != nil {
+		return err
+	}
+	if !dev.miner.Equals(signer) {
+		logging.VLog().WithFields(logrus.Fields{
+			"signer": signer,
+			"miner":  dev.miner,
+			"block":  block,
+		}).Debug("Failed to verify block's sign.")
+		return ErrInvalidBlockProposer
+	}
+	if block.Height() >= core.RandomAvailableHeight && !block.HasRandomSeed() {
+		return core.ErrInvalidBlockRandom
+	}
+	return nil
+}
+
+// CheckTimeout blocks never expire in dev consensus
+func (dev *Dev) CheckTimeout(block *core.Block) bool {
+	return false
+}
+
+// CheckDoubleMint blocks are never double minted by the only miner
+func (dev *Dev) CheckDoubleMint(block *core.Block) bool {
+	return false
+}
+
+// NumberOfBlocksInDynasty number of blocks in one dynasty
+func (dev *Dev) NumberOfBlocksInDynasty() uint64 {
+	return blocksInDynasty
+}
+
+// MintBlock mint a block on the tail immediately, even if there is no transaction to pack.
+func (dev *Dev) MintBlock() (*core.Block, error) {
+	return dev.mintBlock(true)
+}
+
+func (dev *Dev) mintBlock(force bool) (*core.Block, error) {
+	dev.mintLock.Lock()
+	defer dev.mintLock.Unlock()
+
+	if !dev.enable {
+		return nil, ErrCannotMintWhenDisable
+	}
+	if dev.pending {
+		return nil, ErrCannotMintWhenPending
+	}
+
+	tail := dev.chain.TailBlock()
+	// blocks are minted in later seconds than their parents, wait for the next second
+	// if the tail was minted in this one, or follow the tail if the clock is behind it.
+	now := time.Now().Unix()
+	if now == tail.Timestamp() {
+		time.Sleep(time.Until(time.Unix(now+1, 0)))
+		now = time.Now().Unix()
+	}
+	if now <= tail.Timestamp() {
+		now = tail.Timestamp() + 1
+	}
+	consensusState, err := tail.WorldState().NextConsensusState(now - tail.Timestamp())
+	if err != nil {
+		return nil, err
+	}
+	block, err := core.NewBlock(dev.chain.ChainID(), dev.coinbase, tail)
+	if err != nil {
+		return nil, err
+	}
+	if block.Height() >= core.RandomAvailableHeight {
+		ancestorHash, parentSeed, err := dev.chain.GetInputForVRFSigner(block.ParentHash(), block.Height())
+		if err != nil {
+			return nil, err
+		}
+		vrfSeed, vrfProof, err := dev.am.GenerateRandomSeed(dev.miner, ancestorHash, parentSeed)
+		if err != nil {
+			return nil, err
+		}
+		block.SetRandomSeed(vrfSeed, vrfProof)
+	}
+	block.WorldState().SetConsensusState(consensusState)
+	block.SetTimestamp(consensusState.TimeStamp())
+	block.CollectTransactions(time.Now().UnixNano()/1e6 + mintDurationInMs)
+	if !force && len(block.Transactions()) == 0 {
+		return nil, ErrNoTransactionsToPack
+	}
+	if err := block.Seal(); err != nil {
+		go block.ReturnTransactions()
+		return nil, err
+	}
+	if err := dev.am.SignBlock(dev.miner, block); err != nil {
+		go block.ReturnTransactions()
+		return nil, err
+	}
+	if err := dev.chain.BlockPool().PushAndBroadcast(block); err != nil {
+		go block.ReturnTransactions()
+		return nil, err
+	}
+	if !dev.chain.TailBlock().Hash().Equals(block.Hash()) {
+		return nil, ErrAppendNewBlockFailed
+	}
+
+	logging.CLog().WithFields(logrus.Fields{
+		"tail":  tail,
+		"block": block,
+		"txs":   len(block.Transactions()),
+	}).Info("Minted new block")
+	return block, nil
+}
+
+// mintPendingTransactions mint blocks until the pool is empty or nothing can be packed.
+func (dev *Dev) mintPendingTransactions() {
+	pool := dev.chain.TransactionPool()
+	for !pool.Empty() && time.Since(dev.idleAt) >= idleInterval {
+		if _, err := dev.mintBlock(false); err != nil {
+			if err == ErrNoTransactionsToPack {
+				dev.idleAt = time.Now()
+			}
+			logging.VLog().WithFields(logrus.Fields{
+				"err": err,
+			}).Debug("Failed to mint pending transactions.")
+			return
+		}
+	}
+}
+
+func (dev *Dev) loop() {
+	logging.CLog().Info("Started Dev Mining.")
+	timeChan := time.NewTicker(idleInterval).C
+	for {
+		select {
+		case <-dev.subscriber.EventChan():
+			dev.mintPendingTransactions()
+		case <-timeChan:
+			dev.mintPendingTransactions()
+		case <-dev.quitCh:
+			logging.CLog().Info("Stopped Dev Mining.")
+			return
+		}
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package dev
+
+import (
+	"fmt"
+
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/consensus/dpos"
+	"github.com/nebulasio/go-nebulas/consensus/pb"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// State carry context in dev consensus, the configured miner proposes all blocks.
+type State struct {
+	timestamp int64
+	proposer  byteutils.Hash
+
+	dynastyTrie *trie.Trie // key: delegatee, val: delegatee, the dynasty in genesis
+
+	dev *Dev
+}
+
+// NewState create a new dev state
+func (dev *Dev) NewState(root *consensuspb.ConsensusRoot, stor storage.Storage, needChangeLog bool) (state.ConsensusState, error) {
+	var dynastyRoot byteutils.Hash
+	if root != nil {
+		dynastyRoot = root.DynastyRoot
+	}
+	dynastyTrie, err := trie.NewTrie(dynastyRoot, stor, needChangeLog)
+	if err != nil {
+		return nil, err
+	}
+	return &State{
+		timestamp:   root.Timestamp,
+		proposer:    root.Proposer,
+		dynastyTrie: dynastyTrie,
+		dev:         dev,
+	}, nil
+}
+
+// GenesisConsensusState create a new genesis dev state, the dynasty in genesis is kept
+// to stay compatible with the genesis checks of chain.
+func (dev *Dev) GenesisConsensusState(chain *core.BlockChain, conf *corepb.Genesis) (state.ConsensusState, error) {
+	dynastyTrie, err := trie.NewTrie(nil, chain.Storage(), false)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range core.GenesisDynasty(conf) {
+		member, err := core.AddressParse(v)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := dynastyTrie.Put(member.Bytes(), member.Bytes()); err != nil {
+			return nil, err
+		}
+	}
+	return &State{
+		timestamp:   core.GenesisTimestamp,
+		proposer:    nil,
+		dynastyTrie: dynastyTrie,
+		dev:         dev,
+	}, nil
+}
+
+func (ds *State) String() string {
+	proposer := ""
+	if ds.proposer != nil {
+		proposer = ds.proposer.String()
+	}
+	return fmt.Sprintf(`{"timestamp": %d, "proposer": "%s", "dynasty": "%s"}`,
+		ds.timestamp,
+		proposer,
+		byteutils.Hex(ds.dynastyTrie.RootHash()),
+	)
+}
+
+// Replay a dev state
+func (ds *State) Replay(done state.ConsensusState) error {
+	state := done.(*State)
+	if _, err := ds.dynastyTrie.Replay(state.dynastyTrie); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Clone a dev state
+func (ds *State) Clone() (state.ConsensusState, error) {
+	dynastyTrie, err := ds.dynastyTrie.Clone()
+	if err != nil {
+		return nil, err
+	}
+	return &State{
+		timestamp:   ds.timestamp,
+		proposer:    ds.proposer,
+		dynastyTrie: dynastyTrie,
+		dev:         ds.dev,
+	}, nil
+}
+
+// RootHash hash dev state
+func (ds *State) RootHash() *consensuspb.ConsensusRoot {
+	return &consensuspb.ConsensusRoot{
+		DynastyRoot: ds.dynastyTrie.RootHash(),
+		Timestamp:   ds.TimeStamp(),
+		Proposer:    ds.Proposer(),
+	}
+}
+
+// Proposer return the current proposer
+func (ds *State) Proposer() byteutils.Hash {
+	return ds.proposer
+}
+
+// TimeStamp return the current timestamp
+func (ds *State) TimeStamp() int64 {
+	return ds.timestamp
+}
+
+// NextConsensusState return the new state after some seconds elapsed, blocks must
+// be minted in later seconds than their parents.
+func (ds *State) NextConsensusState(elapsedSecond int64, worldState state.WorldState) (state.ConsensusState, error) {
+	if elapsedSecond <= 0 {
+		return nil, ErrInvalidBlockInterval
+	}
+	if ds.dev.miner == nil {
+		return nil, ErrMissingMinerForDev
+	}
+	dynastyTrie, err := ds.dynastyTrie.Clone()
+	if err != nil {
+		return nil, err
+	}
+	return &State{
+		timestamp:   ds.timestamp + elapsedSecond,
+		proposer:    ds.dev.miner.Bytes(),
+		dynastyTrie: dynastyTrie,
+		dev:         ds.dev,
+	}, nil
+}
+
+// Dynasty return the dynasty in genesis
+func (ds *State) Dynasty() ([]byteutils.Hash, error) {
+	return dpos.TraverseDynasty(ds.dynastyTrie)
+}
+
+// DynastyRoot return the roothash of dynasty
+func (ds *State) DynastyRoot() byteutils.Hash {
+	return ds.dynastyTrie.RootHash()
+}
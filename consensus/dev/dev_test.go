@@ -0,0 +1,254 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package dev
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/account"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testMiner    = "n1FF1nz6tarkDVwWQkMnnwFPuPKUaQTdptE"
+	testOutsider = "n1GmkKH6nBMw4rrjt16RrJ9WcgvKUtAZP1s"
+)
+
+type Neb struct {
+	config    *nebletpb.Config
+	chain     *core.BlockChain
+	ns        net.Service
+	am        *account.Manager
+	genesis   *corepb.Genesis
+	storage   storage.Storage
+	consensus core.Consensus
+	emitter   *core.EventEmitter
+	nvm       core.NVM
+}
+
+func mockNeb(t *testing.T) *Neb {
+	storage, _ := storage.NewMemoryStorage()
+	eventEmitter := core.NewEventEmitter(1024)
+	dev := NewDev()
+	neb := &Neb{
+		genesis: &corepb.Genesis{
+			Meta: &corepb.GenesisMeta{ChainId: 0},
+			Consensus: &corepb.GenesisConsensus{
+				Engine: &corepb.GenesisConsensus_Dpos{
+					Dpos: &corepb.GenesisConsensusDpos{
+						Dynasty: []string{testMiner},
+					},
+				},
+			},
+			TokenDistribution: []*corepb.GenesisTokenDistribution{
+				&corepb.GenesisTokenDistribution{
+					Address: testMiner,
+					Value:   "5000000000000000000000000",
+				},
+			},
+		},
+		storage:   storage,
+		emitter:   eventEmitter,
+		consensus: dev,
+		nvm:       nvm.NewNebulasVM(),
+		config: &nebletpb.Config{
+			Chain: &nebletpb.ChainConfig{
+				ChainId:    0,
+				Keydir:     "keydir",
+				StartMine:  true,
+				Miner:      testMiner,
+				Passphrase: "passphrase",
+				Consensus:  "dev",
+			},
+		},
+		ns: mockNetService{},
+	}
+
+	am, _ := account.NewManager(neb)
+	neb.am = am
+
+	chain, err := core.NewBlockChain(neb)
+	assert.Nil(t, err)
+	neb.chain = chain
+	assert.Nil(t, dev.Setup(neb))
+	assert.Nil(t, chain.Setup(neb))
+
+	eventEmitter.Start()
+	return neb
+}
+
+func (n *Neb) Config() *nebletpb.Config {
+	return n.config
+}
+
+func (n *Neb) BlockChain() *core.BlockChain {
+	return n.chain
+}
+
+func (n *Neb) NetService() net.Service {
+	return n.ns
+}
+
+func (n *Neb) IsActiveSyncing() bool {
+	return true
+}
+
+func (n *Neb) AccountManager() core.AccountManager {
+	return n.am
+}
+
+func (n *Neb) Genesis() *corepb.Genesis {
+	return n.genesis
+}
+
+func (n *Neb) SetGenesis(genesis *corepb.Genesis) {
+	n.genesis = genesis
+}
+
+func (n *Neb) Storage() storage.Storage {
+	return n.storage
+}
+
+func (n *Neb) EventEmitter() *core.EventEmitter {
+	return n.emitter
+}
+
+func (n *Neb) Consensus() core.Consensus {
+	return n.consensus
+}
+
+func (n *Neb) Nvm() core.NVM {
+	return n.nvm
+}
+
+func (n *Neb) StartActiveSync() {}
+
+func (n *Neb) StartPprof(string) error { return nil }
+
+type mockNetService struct{}
+
+func (n mockNetService) Start() error { return nil }
+func (n mockNetService) Stop()        {}
+
+func (n mockNetService) Node() *net.Node { return nil }
+
+func (n mockNetService) Sync(net.Serializable) error { return nil }
+
+func (n mockNetService) Register(...*net.Subscriber)   {}
+func (n mockNetService) Deregister(...*net.Subscriber) {}
+
+func (n mockNetService) Broadcast(name string, msg net.Serializable, priority int) {}
+func (n mockNetService) Relay(name string, msg net.Serializable, priority int)     {}
+func (n mockNetService) SendMsg(name string, msg []byte, target string, priority int) error {
+	return nil
+}
+
+func (n mockNetService) SendMessageToPeers(messageName string, data []byte, priority int, filter net.PeerFilterAlgorithm) []string {
+	return make([]string, 0)
+}
+func (n mockNetService) SendMessageToPeer(messageName string, data []byte, priority int, peerID string) error {
+	return nil
+}
+
+func (n mockNetService) ClosePeer(peerID string, reason error) {}
+
+func (n mockNetService) BroadcastNetworkID([]byte) {}
+
+func TestMintBlock(t *testing.T) {
+	neb := mockNeb(t)
+	dev := neb.consensus.(*Dev)
+	chain := neb.chain
+
+	_, err := dev.MintBlock()
+	assert.Equal(t, ErrCannotMintWhenDisable, err)
+	assert.Nil(t, dev.EnableMining("passphrase"))
+	_, err = dev.MintBlock()
+	assert.Equal(t, ErrCannotMintWhenPending, err)
+	dev.ResumeMining()
+
+	// empty blocks are minted on request only.
+	_, err = dev.mintBlock(false)
+	assert.Equal(t, ErrNoTransactionsToPack, err)
+	block, err := dev.MintBlock()
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2), block.Height())
+	assert.Equal(t, block.Hash(), chain.TailBlock().Hash())
+	assert.Equal(t, block.Hash(), chain.LIB().Hash())
+
+	// blocks are minted in later seconds than their parents.
+	next, err := dev.MintBlock()
+	assert.Nil(t, err)
+	assert.Equal(t, block.Hash(), next.ParentHash())
+	assert.True(t, next.Timestamp() > block.Timestamp())
+	assert.Equal(t, next.Hash(), chain.LIB().Hash())
+
+	// only the configured miner can mint blocks.
+	outsider, err := core.AddressParse(testOutsider)
+	assert.Nil(t, err)
+	assert.Nil(t, neb.am.Unlock(outsider, []byte("passphrase"), time.Minute))
+	fake, err := core.NewBlock(chain.ChainID(), outsider, next)
+	assert.Nil(t, err)
+	_, err = next.WorldState().NextConsensusState(0)
+	assert.Equal(t, ErrInvalidBlockInterval, err)
+	consensusState, err := next.WorldState().NextConsensusState(1)
+	assert.Nil(t, err)
+	fake.WorldState().SetConsensusState(consensusState)
+	fake.SetTimestamp(consensusState.TimeStamp())
+	assert.Nil(t, fake.Seal())
+	assert.Nil(t, neb.am.SignBlock(outsider, fake))
+	assert.Equal(t, ErrInvalidBlockProposer, dev.VerifyBlock(fake))
+}
+
+func TestMintPendingTransactions(t *testing.T) {
+	neb := mockNeb(t)
+	dev := neb.consensus.(*Dev)
+	chain := neb.chain
+
+	assert.Nil(t, dev.EnableMining("passphrase"))
+	dev.ResumeMining()
+	dev.Start()
+	defer dev.Stop()
+
+	from, err := core.AddressParse(testMiner)
+	assert.Nil(t, err)
+	to, err := core.AddressParse(testOutsider)
+	assert.Nil(t, err)
+	tx, err := core.NewTransaction(chain.ChainID(), from, to, util.NewUint128FromUint(1), 1, core.TxPayloadBinaryType, nil, core.TransactionGasPrice, core.TransactionMaxGas)
+	assert.Nil(t, err)
+	assert.Nil(t, neb.am.SignTransaction(from, tx))
+	assert.Nil(t, chain.TransactionPool().Push(tx))
+
+	for i := 0; i < 50 && chain.LIB().Height() < 2; i++ {
+		time.Sleep(100 * time.Millisecond)
+	}
+	tail := chain.TailBlock()
+	assert.Equal(t, uint64(2), tail.Height())
+	assert.Equal(t, 1, len(tail.Transactions()))
+	assert.Equal(t, tx.Hash(), tail.Transactions()[0].Hash())
+	assert.Equal(t, tail.Hash(), chain.LIB().Hash())
+	assert.True(t, chain.TransactionPool().Empty())
+}
@@ -20,6 +20,7 @@ package dpos
 
 import (
 	"errors"
+	"sync"
 	"time"
 
 	"github.com/nebulasio/go-nebulas/rpc"
@@ -30,9 +31,11 @@ import (
 	"github.com/nebulasio/go-nebulas/crypto/keystore"
 
 	lru "github.com/hashicorp/golang-lru"
+	"github.com/nebulasio/go-nebulas/consensus/finality"
 	"github.com/nebulasio/go-nebulas/core"
 	metrics "github.com/nebulasio/go-nebulas/metrics"
 	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/storage"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
 	"github.com/nebulasio/go-nebulas/util/logging"
 	"github.com/sirupsen/logrus"
@@ -55,6 +58,7 @@ var (
 	ErrBlockMintedInNextSlot      = errors.New("cannot mint block now, there is a block minted in current slot")
 	ErrGenerateNextConsensusState = errors.New("Failed to generate next consensus state")
 	ErrDoubleBlockMinted          = errors.New("double block minted")
+	ErrDisqualifiedProposer       = errors.New("block proposer is disqualified")
 	ErrAppendNewBlockFailed       = errors.New("failed to append new block to real chain")
 	ErrInvalidArgument            = errors.New("invalid argument")
 )
@@ -74,12 +78,30 @@ type Dpos struct {
 	ns    net.Service
 	am    core.AccountManager
 
+	params *Params
+
 	coinbase               *core.Address
 	miner                  *core.Address
 	enableRemoteSignServer bool
 	remoteSignServer       string
 
-	slot *lru.Cache
+	slot      *lru.Cache
+	evidences *finality.Evidences
+	messageCh chan net.Message
+
+	finality *finality.Finality
+	libLock  sync.Mutex
+
+	stats        storage.Storage
+	statsMetrics map[string]bool
+
+	signed         storage.Storage
+	standbyLock    sync.Mutex
+	standbySlots   int
+	standbyActive  bool
+	standbyMissed  int
+	standbyWatched int64
+	standbyMinted  int64
 
 	enable  bool
 	pending bool
@@ -88,9 +110,11 @@ type Dpos struct {
 // NewDpos create Dpos instance.
 func NewDpos() *Dpos {
 	dpos := &Dpos{
-		quitCh:  make(chan bool, 5),
-		enable:  false,
-		pending: true,
+		quitCh:    make(chan bool, 5),
+		messageCh: make(chan net.Message, 128),
+		params:    DefaultParams(),
+		enable:    false,
+		pending:   true,
 	}
 	return dpos
 }
@@ -101,6 +125,15 @@ func (dpos *Dpos) Setup(neblet core.Neblet) error {
 	dpos.ns = neblet.NetService()
 	dpos.am = neblet.AccountManager()
 
+	params, err := LoadParams(neblet.Genesis().GetConsensus().GetDpos())
+	if err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Error("Failed to load dpos params from genesis.")
+		return err
+	}
+	dpos.params = params
+
 	chainConfig := neblet.Config().Chain
 	if chainConfig.StartMine {
 		coinbase, err := core.AddressParse(chainConfig.Coinbase)
@@ -130,6 +163,29 @@ func (dpos *Dpos) Setup(neblet core.Neblet) error {
 		return err
 	}
 	dpos.slot = slot
+
+	if dpos.evidences, err = finality.NewEvidences(dpos, neblet); err != nil {
+		return err
+	}
+
+	if dpos.finality, err = finality.NewFinality(dpos, neblet); err != nil {
+		return err
+	}
+
+	stats, err := storage.OpenKeyspace(neblet.Storage(), storage.MinerKeyspace)
+	if err != nil {
+		return err
+	}
+	dpos.stats = stats
+	dpos.statsMetrics = make(map[string]bool)
+
+	signed, err := storage.OpenKeyspace(neblet.Storage(), storage.SignerKeyspace)
+	if err != nil {
+		return err
+	}
+	dpos.signed = signed
+	dpos.standbySlots = int(chainConfig.StandbySlots)
+	dpos.RegisterInNetwork(dpos.ns)
 	return nil
 }
 
@@ -180,6 +236,9 @@ func less(a *core.Block, b *core.Block) bool {
 
 // ForkChoice select new tail
 func (dpos *Dpos) ForkChoice() error {
+	// the pre-commits arrived before their blocks are counted once the blocks are linked.
+	dpos.finality.ReplayPreCommits()
+
 	bc := dpos.chain
 	tailBlock := bc.TailBlock()
 	detachedTailBlocks := bc.DetachedTailBlocks()
@@ -215,28 +274,46 @@ func (dpos *Dpos) ForkChoice() error {
 		"new tail": newTailBlock,
 		"old tail": tailBlock,
 	}).Info("change to new tail.")
+
+	if newTailBlock.Height() >= core.FinalityAvailableHeight {
+		dpos.finality.PreCommit(newTailBlock)
+	}
 	return nil
 }
 
 // UpdateLIB update the latest irrversible block
 func (dpos *Dpos) UpdateLIB() {
+	dpos.libLock.Lock()
+	defer dpos.libLock.Unlock()
+
+	if dpos.chain.TailBlock().Height() >= core.FinalityAvailableHeight {
+		if lib := dpos.finality.NextLIB(); lib != nil {
+			dpos.setLIB(lib)
+		}
+		return
+	}
+	dpos.updateLIBByProposers()
+}
+
+// updateLIBByProposers set the LIB to the block built on by consensus size distinct proposers
+func (dpos *Dpos) updateLIBByProposers() {
 	lib := dpos.chain.LIB()
 	tail := dpos.chain.TailBlock()
 	cur := tail
 	miners := make(map[string]bool)
 	dynasty := int64(-1)
 	for !cur.Hash().Equals(lib.Hash()) {
-		curDynasty := cur.Timestamp() * SecondInMs / DynastyIntervalInMs
+		curDynasty := cur.Timestamp() * SecondInMs / dpos.params.DynastyIntervalInMs
 		if curDynasty != dynasty {
 			miners = make(map[string]bool)
 			dynasty = curDynasty
 		}
 		// fast prune
-		if int(cur.Height())-int(lib.Height()) < ConsensusSize-len(miners) {
+		if int(cur.Height())-int(lib.Height()) < dpos.params.ConsensusSize-len(miners) {
 			return
 		}
 		miners[byteutils.Hex(cur.ConsensusRoot().Proposer)] = true
-		if len(miners) >= ConsensusSize {
+		if len(miners) >= dpos.params.ConsensusSize {
 			if err := dpos.chain.StoreLIBHashToStorage(cur); err != nil {
 				logging.VLog().WithFields(logrus.Fields{
 					"tail": tail,
@@ -248,16 +325,10 @@ func (dpos *Dpos) UpdateLIB() {
 				"lib.new":          cur,
 				"lib.old":          lib,
 				"tail":             tail,
-				"miners.limit":     ConsensusSize,
+				"miners.limit":     dpos.params.ConsensusSize,
 				"miners.supported": len(miners),
 			}).Info("Succeed to update latest irreversible block.")
-			dpos.chain.SetLIB(cur)
-
-			e := &state.Event{
-				Topic: core.TopicLibBlock,
-				Data:  dpos.chain.LIB().String(),
-			}
-			dpos.chain.EventEmitter().Trigger(e)
+			dpos.setLIB(cur)
 			return
 		}
 
@@ -277,7 +348,7 @@ func (dpos *Dpos) UpdateLIB() {
 		"lib":              lib,
 		"tail":             tail,
 		"err":              "supported miners is not enough",
-		"miners.limit":     ConsensusSize,
+		"miners.limit":     dpos.params.ConsensusSize,
 		"miners.supported": len(miners),
 	}).Debug("Failed to update latest irreversible block.")
 }
@@ -328,6 +399,7 @@ func (dpos *Dpos) CheckDoubleMint(block *core.Block) bool {
 				"curBlock": block,
 				"preBlock": preBlock.(*core.Block),
 			}).Warn("Found someone minted multiple blocks at same time.")
+			go dpos.evidences.ReportDoubleMint(preBlock.(*core.Block), block)
 			return true
 		}
 	}
@@ -342,7 +414,7 @@ func (dpos *Dpos) VerifyBlock(block *core.Block) error {
 		return ErrInvalidBlockTimestamp
 	}
 	elapsedSecondInMs := block.Timestamp() * SecondInMs
-	if elapsedSecondInMs <= 0 || (elapsedSecondInMs%BlockIntervalInMs) != 0 {
+	if elapsedSecondInMs <= 0 || (elapsedSecondInMs%dpos.params.BlockIntervalInMs) != 0 {
 		return ErrInvalidBlockInterval
 	}
 	// check proposer
@@ -354,7 +426,7 @@ func (dpos *Dpos) VerifyBlock(block *core.Block) error {
 		}).Debug("Failed to get miners from dynasty.")
 		return err
 	}
-	proposer, err := FindProposer(block.Timestamp(), miners)
+	proposer, err := FindProposer(block.Timestamp(), miners, dpos.params)
 	if err != nil {
 		logging.VLog().WithFields(logrus.Fields{
 			"proposer": proposer,
@@ -372,6 +444,25 @@ func (dpos *Dpos) VerifyBlock(block *core.Block) error {
 		}).Debug("Failed to parse proposer.")
 		return err
 	}
+	// check proposer is not disqualified in the world state of parent, the blocks of unknown
+	// parents are checked once linked, their seat of disqualified proposer is empty.
+	if parent := dpos.chain.GetBlock(block.ParentHash()); parent != nil {
+		election, err := parent.WorldState().ElectionState()
+		if err != nil {
+			return err
+		}
+		banned, err := election.IsDisqualified(proposer)
+		if err != nil {
+			return err
+		}
+		if banned {
+			logging.VLog().WithFields(logrus.Fields{
+				"proposer": proposer,
+				"block":    block,
+			}).Debug("Found disqualified proposer.")
+			return ErrDisqualifiedProposer
+		}
+	}
 	// check signature
 	if err := verifyBlockSign(miner, block); err != nil {
 		return err
@@ -387,6 +478,7 @@ func (dpos *Dpos) VerifyBlock(block *core.Block) error {
 	}
 
 	dpos.slot.Add(block.Timestamp(), block)
+	dpos.observeBlock(block)
 	return nil
 }
 
@@ -531,41 +623,43 @@ func (dpos *Dpos) newBlock(tail *core.Block, consensusState state.ConsensusState
 	return block, nil
 }
 
-func lastSlot(nowInMs int64) int64 {
-	return int64((nowInMs-SecondInMs)/BlockIntervalInMs) * BlockIntervalInMs
+func (dpos *Dpos) lastSlot(nowInMs int64) int64 {
+	blockIntervalInMs := dpos.params.BlockIntervalInMs
+	return int64((nowInMs-SecondInMs)/blockIntervalInMs) * blockIntervalInMs
 }
 
-func nextSlot(nowInMs int64) int64 {
-	return int64((nowInMs+BlockIntervalInMs-SecondInMs)/BlockIntervalInMs) * BlockIntervalInMs
+func (dpos *Dpos) nextSlot(nowInMs int64) int64 {
+	blockIntervalInMs := dpos.params.BlockIntervalInMs
+	return int64((nowInMs+blockIntervalInMs-SecondInMs)/blockIntervalInMs) * blockIntervalInMs
 }
 
-func deadline(nowInMs int64) int64 {
-	nextSlotInMs := nextSlot(nowInMs)
+func (dpos *Dpos) deadline(nowInMs int64) int64 {
+	nextSlotInMs := dpos.nextSlot(nowInMs)
 	remainInMs := nextSlotInMs - nowInMs
-	if MaxMintDurationInMs > remainInMs {
+	if dpos.params.MaxMintDurationInMs > remainInMs {
 		return nextSlotInMs
 	}
-	return nowInMs + MaxMintDurationInMs
+	return nowInMs + dpos.params.MaxMintDurationInMs
 }
 
 func (dpos *Dpos) checkDeadline(tail *core.Block, nowInMs int64) (int64, error) {
-	lastSlotInMs := lastSlot(nowInMs)
-	nextSlotInMs := nextSlot(nowInMs)
+	lastSlotInMs := dpos.lastSlot(nowInMs)
+	nextSlotInMs := dpos.nextSlot(nowInMs)
 
 	if tail.Timestamp()*SecondInMs >= nextSlotInMs {
 		return 0, ErrBlockMintedInNextSlot
 	}
 	if tail.Timestamp()*SecondInMs == lastSlotInMs {
-		return deadline(nowInMs), nil
+		return dpos.deadline(nowInMs), nil
 	}
-	if nextSlotInMs-nowInMs <= MinMintDurationInMs {
-		return deadline(nowInMs), nil
+	if nextSlotInMs-nowInMs <= dpos.params.MinMintDurationInMs {
+		return dpos.deadline(nowInMs), nil
 	}
 	return 0, ErrWaitingBlockInLastSlot
 }
 
 func (dpos *Dpos) checkProposer(tail *core.Block, nowInMs int64) (state.ConsensusState, error) {
-	slotInMs := nextSlot(nowInMs)
+	slotInMs := dpos.nextSlot(nowInMs)
 	elapsedInMs := slotInMs - tail.Timestamp()*SecondInMs
 	consensusState, err := tail.WorldState().NextConsensusState(elapsedInMs / SecondInMs)
 	if err != nil {
@@ -631,6 +725,11 @@ func (dpos *Dpos) mintBlock(now int64) error {
 
 	tail := dpos.chain.TailBlock()
 
+	// check standby
+	if err := dpos.checkStandby(tail, nowInMs); err != nil {
+		return err
+	}
+
 	deadlineInMs, err := dpos.checkDeadline(tail, nowInMs)
 	if err != nil {
 		logging.VLog().WithFields(logrus.Fields{
@@ -646,6 +745,16 @@ func (dpos *Dpos) mintBlock(now int64) error {
 		return err
 	}
 
+	// record the slot before signing, never sign a slot twice
+	if err := dpos.signSlot(consensusState.TimeStamp()); err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"tail": tail,
+			"slot": consensusState.TimeStamp(),
+			"err":  err,
+		}).Warn("Refused to sign the slot.")
+		return err
+	}
+
 	miner := "nil"
 	if dpos.miner != nil {
 		miner = dpos.miner.String()
@@ -664,7 +773,7 @@ func (dpos *Dpos) mintBlock(now int64) error {
 		return err
 	}
 
-	slotInMs := nextSlot(nowInMs)
+	slotInMs := dpos.nextSlot(nowInMs)
 	currentInMs := time.Now().Unix() * SecondInMs
 	if slotInMs > currentInMs {
 		timer := time.NewTimer(time.Duration(slotInMs-currentInMs) * time.Millisecond).C
@@ -701,6 +810,8 @@ func (dpos *Dpos) blockLoop() {
 		case now := <-timeChan:
 			metricsLruPoolSlotBlock.Update(int64(dpos.slot.Len()))
 			dpos.mintBlock(now.Unix())
+		case msg := <-dpos.messageCh:
+			dpos.onMessage(msg)
 		case <-dpos.quitCh:
 			logging.CLog().Info("Stopped Dpos Mining.")
 			return
@@ -716,7 +827,7 @@ func (dpos *Dpos) findProposer(now int64) (proposer byteutils.Hash, err error) {
 		}).Debug("Failed to get miners from dynasty.")
 		return nil, err
 	}
-	proposer, err = FindProposer(now, miners)
+	proposer, err = FindProposer(now, miners, dpos.params)
 	if err != nil {
 		logging.VLog().WithFields(logrus.Fields{
 			"proposer": proposer,
@@ -729,5 +840,5 @@ func (dpos *Dpos) findProposer(now int64) (proposer byteutils.Hash, err error) {
 
 // NumberOfBlocksInDynasty number of blocks in one dynasty
 func (dpos *Dpos) NumberOfBlocksInDynasty() uint64 {
-	return uint64(DynastyIntervalInMs) / uint64(BlockIntervalInMs)
+	return dpos.params.NumberOfBlocksInDynasty()
 }
@@ -0,0 +1,264 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package dpos
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/consensus/finality"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockDoubleMint(t *testing.T, neb *Neb, miner *core.Address) *core.DoubleMintEvidence {
+	tail := neb.chain.TailBlock()
+	elapsedSecond := BlockIntervalInMs / SecondInMs
+	blocks := []*core.Block{}
+	for _, addr := range DefaultOpenDynasty[:2] {
+		coinbase, err := core.AddressParse(addr)
+		assert.Nil(t, err)
+		consensusState, err := tail.WorldState().NextConsensusState(elapsedSecond)
+		assert.Nil(t, err)
+		block, err := core.NewBlock(neb.chain.ChainID(), coinbase, tail)
+		assert.Nil(t, err)
+		block.WorldState().SetConsensusState(consensusState)
+		block.SetTimestamp(consensusState.TimeStamp())
+		assert.Nil(t, block.Seal())
+		assert.Nil(t, neb.am.SignBlock(miner, block))
+		blocks = append(blocks, block)
+	}
+	evidence, err := core.NewDoubleMintEvidence(blocks[0], blocks[1])
+	assert.Nil(t, err)
+	return evidence
+}
+
+// mockEvidenceBlock mint the block of the next slot on tail, packing the evidence of banned's double mint.
+func mockEvidenceBlock(t *testing.T, neb *Neb, banned *core.Address) *core.Block {
+	payload, err := core.NewEvidencePayload(mockDoubleMint(t, neb, banned))
+	assert.Nil(t, err)
+	data, err := payload.ToBytes()
+	assert.Nil(t, err)
+	tail := neb.chain.TailBlock()
+	reporter := GetUnlockAddress(t, neb.am, DefaultOpenDynasty[1])
+	acc, err := tail.GetAccount(reporter.Bytes())
+	assert.Nil(t, err)
+	gasLimit, _ := util.NewUint128FromInt(200000)
+	tx, err := core.NewTransaction(neb.chain.ChainID(), reporter, reporter, util.NewUint128(), acc.Nonce()+1,
+		core.TxPayloadEvidenceType, data, core.TransactionGasPrice, gasLimit)
+	assert.Nil(t, err)
+	assert.Nil(t, neb.am.SignTransaction(reporter, tx))
+	assert.Nil(t, neb.chain.TransactionPool().Push(tx))
+
+	consensusState, err := tail.WorldState().NextConsensusState(BlockIntervalInMs / SecondInMs)
+	assert.Nil(t, err)
+	proposer, err := core.AddressParseFromBytes(consensusState.Proposer())
+	assert.Nil(t, err)
+	miner := GetUnlockAddress(t, neb.am, proposer.String())
+	block, err := neb.chain.NewBlock(miner)
+	assert.Nil(t, err)
+	block.WorldState().SetConsensusState(consensusState)
+	block.SetTimestamp(consensusState.TimeStamp())
+	block.CollectTransactions((time.Now().Unix() + 1) * SecondInMs)
+	assert.Equal(t, 1, len(block.Transactions()))
+	assert.Nil(t, block.Seal())
+	assert.Nil(t, neb.am.SignBlock(miner, block))
+	assert.Nil(t, neb.chain.BlockPool().Push(block))
+	assert.Equal(t, block.Hash(), neb.chain.TailBlock().Hash())
+	return block
+}
+
+func TestEvidencePayload(t *testing.T) {
+	neb := mockNeb(t)
+	tail := neb.chain.TailBlock()
+	miner, err := core.AddressParse(DefaultOpenDynasty[0])
+	assert.Nil(t, err)
+	assert.Nil(t, neb.am.Unlock(miner, []byte("passphrase"), keystore.DefaultUnlockDuration))
+
+	evidence := mockDoubleMint(t, neb, miner)
+	payload, err := core.NewEvidencePayload(evidence)
+	assert.Nil(t, err)
+	data, err := payload.ToBytes()
+	assert.Nil(t, err)
+	_, err = core.LoadEvidencePayload(data)
+	assert.Nil(t, err)
+	_, err = core.LoadEvidencePayload([]byte(`{"Evidence":"ZGF0YQ=="}`))
+	assert.Equal(t, core.ErrInvalidDoubleMintEvidence, err)
+
+	reporter, err := core.AddressParse(DefaultOpenDynasty[1])
+	assert.Nil(t, err)
+	gasLimit, _ := util.NewUint128FromInt(200000)
+	tx, err := core.NewTransaction(neb.chain.ChainID(), reporter, reporter, util.NewUint128(), 1,
+		core.TxPayloadEvidenceType, data, core.TransactionGasPrice, gasLimit)
+	assert.Nil(t, err)
+
+	block, err := core.NewBlock(neb.chain.ChainID(), reporter, tail)
+	assert.Nil(t, err)
+	ws := block.WorldState()
+	txWorldState, err := ws.Prepare(tx.Hash().String())
+	assert.Nil(t, err)
+	_, _, err = payload.Execute(gasLimit, tx, block, txWorldState)
+	assert.Nil(t, err)
+	_, err = txWorldState.CheckAndUpdate()
+	assert.Nil(t, err)
+
+	election, err := ws.ElectionState()
+	assert.Nil(t, err)
+	disqualified, err := election.IsDisqualified(miner.Bytes())
+	assert.Nil(t, err)
+	assert.True(t, disqualified)
+	events, err := ws.FetchEvents(tx.Hash())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(events))
+	assert.Equal(t, core.TopicDoubleMint, events[0].Topic)
+
+	_, _, err = payload.Execute(gasLimit, tx, block, ws)
+	assert.Equal(t, core.ErrDuplicatedEvidence, err)
+}
+
+func TestDisqualifiedNotElected(t *testing.T) {
+	neb := mockNeb(t)
+	tail := neb.chain.TailBlock()
+	ws, err := tail.WorldState().Clone()
+	assert.Nil(t, err)
+	election, err := ws.ElectionState()
+	assert.Nil(t, err)
+
+	banned, err := core.AddressParse(DefaultOpenDynasty[0])
+	assert.Nil(t, err)
+	candidate, err := core.AddressParse("n1FF1nz6tarkDVwWQkMnnwFPuPKUaQTdptE")
+	assert.Nil(t, err)
+	voter, err := core.AddressParse("n1GmkKH6nBMw4rrjt16RrJ9WcgvKUtAZP1s")
+	assert.Nil(t, err)
+	assert.Nil(t, election.Disqualify(banned.Bytes()))
+
+	// the disqualified member proposes no more in current dynasty.
+	empty := 0
+	for i := int64(1); i <= int64(DynastySize); i++ {
+		next, err := ws.NextConsensusState(i * BlockIntervalInMs / SecondInMs)
+		assert.Nil(t, err)
+		assert.NotEqual(t, byteutils.Hash(banned.Bytes()), next.Proposer())
+		if next.Proposer() == nil {
+			empty++
+		}
+	}
+	assert.Equal(t, 1, empty)
+
+	// nobody else is eligible, the seat of disqualified member is left empty.
+	next, err := ws.NextConsensusState(DynastyIntervalInMs / SecondInMs)
+	assert.Nil(t, err)
+	dynasty, err := next.Dynasty()
+	assert.Nil(t, err)
+	assert.Equal(t, DynastySize-1, len(dynasty))
+	assert.NotContains(t, dynasty, byteutils.Hash(banned.Bytes()))
+
+	// the disqualified candidate is not elected even with votes.
+	assert.Nil(t, election.AddCandidate(banned.Bytes()))
+	assert.Nil(t, election.Vote(voter.Bytes(), banned.Bytes()))
+	next, err = ws.NextConsensusState(DynastyIntervalInMs / SecondInMs)
+	assert.Nil(t, err)
+	dynasty, err = next.Dynasty()
+	assert.Nil(t, err)
+	assert.Equal(t, DynastySize-1, len(dynasty))
+	assert.NotContains(t, dynasty, byteutils.Hash(banned.Bytes()))
+
+	assert.Nil(t, election.DelCandidate(banned.Bytes()))
+	assert.Nil(t, election.AddCandidate(candidate.Bytes()))
+	assert.Nil(t, election.Vote(voter.Bytes(), candidate.Bytes()))
+	next, err = ws.NextConsensusState(DynastyIntervalInMs / SecondInMs)
+	assert.Nil(t, err)
+	dynasty, err = next.Dynasty()
+	assert.Nil(t, err)
+	assert.Equal(t, DynastySize, len(dynasty))
+	assert.NotContains(t, dynasty, byteutils.Hash(banned.Bytes()))
+	assert.Contains(t, dynasty, byteutils.Hash(candidate.Bytes()))
+}
+
+func TestHandleEvidence(t *testing.T) {
+	neb := mockNeb(t)
+	dpos := neb.consensus.(*Dpos)
+	miner, err := core.AddressParse(DefaultOpenDynasty[0])
+	assert.Nil(t, err)
+	assert.Nil(t, neb.am.Unlock(miner, []byte("passphrase"), keystore.DefaultUnlockDuration))
+	evidence := mockDoubleMint(t, neb, miner)
+
+	received = []byte{}
+	assert.Nil(t, dpos.evidences.HandleEvidence(evidence))
+	assert.NotEqual(t, received, []byte{})
+	assert.True(t, dpos.evidences.Known(evidence.Hash()))
+	assert.True(t, neb.chain.TransactionPool().Empty())
+
+	// known evidence is not gossiped again.
+	received = []byte{}
+	assert.Nil(t, dpos.evidences.HandleEvidence(evidence))
+	assert.Equal(t, received, []byte{})
+
+	// the miner submits the evidence on chain, after its txs pending in pool.
+	assert.Nil(t, dpos.EnableMining("passphrase"))
+	pool := neb.chain.TransactionPool()
+	pending, err := core.NewTransaction(neb.chain.ChainID(), miner, miner, util.NewUint128(), 1,
+		core.TxPayloadBinaryType, nil, core.TransactionGasPrice, core.MinGasCountPerTransaction)
+	assert.Nil(t, err)
+	assert.Nil(t, neb.am.SignTransaction(miner, pending))
+	assert.Nil(t, pool.Push(pending))
+	assert.Equal(t, uint64(2), pool.NextNonce(miner, 0))
+
+	evidences, err := finality.NewEvidences(dpos, neb)
+	assert.Nil(t, err)
+	assert.Nil(t, evidences.HandleEvidence(evidence))
+	assert.Equal(t, uint64(3), pool.NextNonce(miner, 0))
+}
+
+func TestVerifyDisqualifiedProposer(t *testing.T) {
+	height := core.ElectionAvailableHeight
+	core.ElectionAvailableHeight = core.LocalElectionAvailableHeight
+	defer func() { core.ElectionAvailableHeight = height }()
+
+	neb := mockNeb(t)
+	dpos := neb.consensus.(*Dpos)
+	genesis := neb.chain.TailBlock()
+	interval := BlockIntervalInMs / SecondInMs
+
+	// the proposer of the second slot is disqualified by the block of the first slot.
+	second, err := genesis.WorldState().NextConsensusState(2 * interval)
+	assert.Nil(t, err)
+	proposer, err := core.AddressParseFromBytes(second.Proposer())
+	assert.Nil(t, err)
+	banned := GetUnlockAddress(t, neb.am, proposer.String())
+	parent := mockEvidenceBlock(t, neb, banned)
+
+	mockBlock := func(parent *core.Block) *core.Block {
+		consensusState, err := parent.WorldState().NextConsensusState(genesis.Timestamp() + 2*interval - parent.Timestamp())
+		assert.Nil(t, err)
+		block, err := core.NewBlock(neb.chain.ChainID(), banned, parent)
+		assert.Nil(t, err)
+		block.WorldState().SetConsensusState(consensusState)
+		block.SetTimestamp(consensusState.TimeStamp())
+		assert.Nil(t, block.Seal())
+		assert.Nil(t, neb.am.SignBlock(banned, block))
+		return block
+	}
+
+	// the disqualification is checked against the parent, not the tail.
+	assert.Equal(t, ErrDisqualifiedProposer, dpos.VerifyBlock(mockBlock(parent)))
+	assert.Nil(t, dpos.VerifyBlock(mockBlock(genesis)))
+}
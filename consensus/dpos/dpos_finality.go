@@ -0,0 +1,80 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package dpos
+
+import (
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// RegisterInNetwork register the double mint evidence and pre-commit subscribers in network.
+func (dpos *Dpos) RegisterInNetwork(ns net.Service) {
+	ns.Register(net.NewSubscriber(dpos, dpos.messageCh, true, core.MessageTypeDoubleMintEvidence, net.MessageWeightZero))
+	ns.Register(net.NewSubscriber(dpos, dpos.messageCh, true, core.MessageTypePreCommit, net.MessageWeightZero))
+}
+
+func (dpos *Dpos) onMessage(msg net.Message) {
+	switch msg.MessageType() {
+	case core.MessageTypeDoubleMintEvidence:
+		dpos.evidences.OnEvidenceMessage(msg)
+	case core.MessageTypePreCommit:
+		dpos.finality.OnPreCommitMessage(msg)
+	default:
+		logging.VLog().WithFields(logrus.Fields{
+			"messageType": msg.MessageType(),
+			"message":     msg,
+			"err":         "not consensus msg",
+		}).Debug("Received unregistered message.")
+	}
+}
+
+// Signer return the miner signing pre-commits and evidences, nil if the node doesn't mint
+// or its blocks are signed by remote sign server.
+func (dpos *Dpos) Signer() *core.Address {
+	if !dpos.enable || dpos.enableRemoteSignServer {
+		return nil
+	}
+	return dpos.miner
+}
+
+// FinalitySize return the consensus size, a block is finalized once consensus size members of
+// its dynasty pre-committed it.
+func (dpos *Dpos) FinalitySize(dynasty []byteutils.Hash) int {
+	return dpos.params.ConsensusSize
+}
+
+func (dpos *Dpos) setLIB(lib *core.Block) {
+	if err := dpos.recordStats(dpos.chain.LIB(), lib); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"lib": lib,
+			"err": err,
+		}).Debug("Failed to record miner stats.")
+	}
+	dpos.chain.SetLIB(lib)
+
+	e := &state.Event{
+		Topic: core.TopicLibBlock,
+		Data:  dpos.chain.LIB().String(),
+	}
+	dpos.chain.EventEmitter().Trigger(e)
+}
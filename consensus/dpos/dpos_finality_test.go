@@ -0,0 +1,124 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package dpos
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/consensus/finality"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreCommitFinality(t *testing.T) {
+	height := core.FinalityAvailableHeight
+	core.FinalityAvailableHeight = core.LocalFinalityAvailableHeight
+	defer func() { core.FinalityAvailableHeight = height }()
+
+	neb := mockNeb(t)
+	chain := neb.chain
+	dpos := neb.consensus.(*Dpos)
+	genesis := chain.LIB()
+
+	addr0 := GetUnlockAddress(t, neb.am, "n1GmkKH6nBMw4rrjt16RrJ9WcgvKUtAZP1s")
+	block0, err := chain.NewBlock(addr0)
+	assert.Nil(t, err)
+	consensusState, err := chain.TailBlock().WorldState().NextConsensusState(BlockIntervalInMs / SecondInMs)
+	assert.Nil(t, err)
+	block0.SetTimestamp(chain.TailBlock().Timestamp() + BlockIntervalInMs/SecondInMs)
+	block0.WorldState().SetConsensusState(consensusState)
+	assert.Nil(t, block0.Seal())
+	assert.Nil(t, neb.am.SignBlock(addr0, block0))
+
+	// the pre-commit arrived before the block is kept until the block is linked.
+	early, err := finality.SignPreCommit(neb.am, GetUnlockAddress(t, neb.am, DefaultOpenDynasty[0]), block0.Hash())
+	assert.Nil(t, err)
+	assert.Nil(t, dpos.finality.HandlePreCommit(early))
+	assert.Equal(t, 1, dpos.finality.PendingPreCommitCount(block0.Hash()))
+	unknown, err := finality.SignPreCommit(neb.am, GetUnlockAddress(t, neb.am, DefaultOpenDynasty[1]), hash.Sha3256([]byte("unknown")))
+	assert.Nil(t, err)
+	assert.Nil(t, dpos.finality.HandlePreCommit(unknown))
+
+	assert.Nil(t, chain.BlockPool().Push(block0))
+	assert.Equal(t, block0.Hash(), chain.TailBlock().Hash())
+	assert.Equal(t, 0, dpos.finality.PendingPreCommitCount(block0.Hash()))
+	assert.Equal(t, 1, dpos.finality.PreCommitCount(block0.Hash()))
+
+	// only the members of dynasty can vote.
+	outsider, err := finality.SignPreCommit(neb.am, GetUnlockAddress(t, neb.am, "n1PJqpN1bkrjZ44pjrNcZAW8AkHc4iAMiBz"), block0.Hash())
+	assert.Nil(t, err)
+	assert.Equal(t, finality.ErrInvalidPreCommitVoter, dpos.finality.HandlePreCommit(outsider))
+
+	for i, member := range DefaultOpenDynasty[1:ConsensusSize] {
+		vote, err := finality.SignPreCommit(neb.am, GetUnlockAddress(t, neb.am, member), block0.Hash())
+		assert.Nil(t, err)
+		assert.Nil(t, dpos.finality.HandlePreCommit(vote))
+		// duplicated votes are not counted.
+		assert.Nil(t, dpos.finality.HandlePreCommit(vote))
+		if i+2 < ConsensusSize {
+			assert.Equal(t, genesis.Hash(), chain.LIB().Hash())
+			_, err := chain.GetFinalityProof(block0.Hash())
+			assert.NotNil(t, err)
+		}
+	}
+	assert.Equal(t, block0.Hash(), chain.LIB().Hash())
+
+	proof, err := chain.GetFinalityProof(block0.Hash())
+	assert.Nil(t, err)
+	assert.Equal(t, ConsensusSize, len(proof.Votes))
+	dynasty, err := block0.WorldState().Dynasty()
+	assert.Nil(t, err)
+	assert.Nil(t, core.VerifyFinalityProof(proof, dynasty, dpos.FinalitySize(dynasty)))
+}
+
+func TestPreCommitHeight(t *testing.T) {
+	height := core.FinalityAvailableHeight
+	core.FinalityAvailableHeight = core.LocalFinalityAvailableHeight
+	defer func() { core.FinalityAvailableHeight = height }()
+
+	neb := mockNeb(t)
+	chain := neb.chain
+	dpos := neb.consensus.(*Dpos)
+	miner := GetUnlockAddress(t, neb.am, DefaultOpenDynasty[0])
+	dpos.miner = miner
+	dpos.enable = true
+
+	last, err := dpos.finality.LastPreCommitHeight(miner)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), last)
+
+	block := chain.TailBlock()
+	dpos.finality.PreCommit(block)
+	last, err = dpos.finality.LastPreCommitHeight(miner)
+	assert.Nil(t, err)
+	assert.Equal(t, block.Height(), last)
+	assert.Equal(t, 1, dpos.finality.PreCommitCount(block.Hash()))
+
+	// the height recorded in storage is kept across restarts,
+	// no block at the height is signed again.
+	restarted, err := finality.NewFinality(dpos, neb)
+	assert.Nil(t, err)
+	last, err = restarted.LastPreCommitHeight(miner)
+	assert.Nil(t, err)
+	assert.Equal(t, block.Height(), last)
+
+	restarted.PreCommit(block)
+	assert.Equal(t, 0, restarted.PreCommitCount(block.Hash()))
+}
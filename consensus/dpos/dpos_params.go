@@ -0,0 +1,122 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package dpos
+
+import (
+	"errors"
+
+	"github.com/nebulasio/go-nebulas/core/pb"
+)
+
+// Errors in dpos params
+var (
+	ErrInvalidBlockIntervalParam   = errors.New("invalid block interval in genesis, should be a positive multiple of second")
+	ErrInvalidDynastyIntervalParam = errors.New("invalid dynasty interval in genesis, should be a multiple of block interval and hold the whole dynasty")
+	ErrInvalidDynastySizeParam     = errors.New("invalid dynasty size in genesis, should be positive")
+	ErrInvalidMintDurationParam    = errors.New("invalid mint durations in genesis, should be positive and within block interval")
+	ErrInvalidNetworkDelayParam    = errors.New("invalid accepted network delay in genesis, should be positive and within block interval")
+)
+
+// Params carry the timing and dynasty size of dpos consensus
+type Params struct {
+	BlockIntervalInMs        int64
+	AcceptedNetWorkDelayInMs int64
+	MaxMintDurationInMs      int64
+	MinMintDurationInMs      int64
+	DynastyIntervalInMs      int64
+	DynastySize              int
+	ConsensusSize            int
+}
+
+// DefaultParams return the params used by mainnet and testnet
+func DefaultParams() *Params {
+	return &Params{
+		BlockIntervalInMs:        BlockIntervalInMs,
+		AcceptedNetWorkDelayInMs: AcceptedNetWorkDelayInMs,
+		MaxMintDurationInMs:      MaxMintDurationInMs,
+		MinMintDurationInMs:      MinMintDurationInMs,
+		DynastyIntervalInMs:      DynastyIntervalInMs,
+		DynastySize:              DynastySize,
+		ConsensusSize:            ConsensusSize,
+	}
+}
+
+// LoadParams read the params from genesis conf, the missing ones are derived from
+// block interval in the same proportion as the defaults.
+func LoadParams(conf *corepb.GenesisConsensusDpos) (*Params, error) {
+	params := DefaultParams()
+	if conf == nil {
+		return params, nil
+	}
+
+	if conf.BlockIntervalInMs != 0 {
+		params.BlockIntervalInMs = conf.BlockIntervalInMs
+		params.AcceptedNetWorkDelayInMs = conf.BlockIntervalInMs * AcceptedNetWorkDelayInMs / BlockIntervalInMs
+		params.MaxMintDurationInMs = conf.BlockIntervalInMs * MaxMintDurationInMs / BlockIntervalInMs
+		params.MinMintDurationInMs = conf.BlockIntervalInMs * MinMintDurationInMs / BlockIntervalInMs
+		params.DynastyIntervalInMs = conf.BlockIntervalInMs * (DynastyIntervalInMs / BlockIntervalInMs)
+	}
+	if conf.DynastyIntervalInMs != 0 {
+		params.DynastyIntervalInMs = conf.DynastyIntervalInMs
+	}
+	if conf.DynastySize != 0 {
+		params.DynastySize = int(conf.DynastySize)
+		params.ConsensusSize = params.DynastySize*2/3 + 1
+	}
+	if conf.AcceptedNetworkDelayInMs != 0 {
+		params.AcceptedNetWorkDelayInMs = conf.AcceptedNetworkDelayInMs
+	}
+	if conf.MaxMintDurationInMs != 0 {
+		params.MaxMintDurationInMs = conf.MaxMintDurationInMs
+	}
+	if conf.MinMintDurationInMs != 0 {
+		params.MinMintDurationInMs = conf.MinMintDurationInMs
+	}
+
+	if err := params.verify(); err != nil {
+		return nil, err
+	}
+	return params, nil
+}
+
+func (p *Params) verify() error {
+	if p.BlockIntervalInMs <= 0 || p.BlockIntervalInMs%SecondInMs != 0 {
+		return ErrInvalidBlockIntervalParam
+	}
+	if p.DynastySize <= 0 {
+		return ErrInvalidDynastySizeParam
+	}
+	if p.DynastyIntervalInMs <= 0 || p.DynastyIntervalInMs%p.BlockIntervalInMs != 0 ||
+		p.DynastyIntervalInMs/p.BlockIntervalInMs < int64(p.DynastySize) {
+		return ErrInvalidDynastyIntervalParam
+	}
+	if p.MinMintDurationInMs <= 0 || p.MinMintDurationInMs > p.MaxMintDurationInMs ||
+		p.MaxMintDurationInMs > p.BlockIntervalInMs {
+		return ErrInvalidMintDurationParam
+	}
+	if p.AcceptedNetWorkDelayInMs <= 0 || p.AcceptedNetWorkDelayInMs > p.BlockIntervalInMs {
+		return ErrInvalidNetworkDelayParam
+	}
+	return nil
+}
+
+// NumberOfBlocksInDynasty number of blocks in one dynasty
+func (p *Params) NumberOfBlocksInDynasty() uint64 {
+	return uint64(p.DynastyIntervalInMs) / uint64(p.BlockIntervalInMs)
+}
@@ -0,0 +1,85 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package dpos
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadParams(t *testing.T) {
+	params, err := LoadParams(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, DefaultParams(), params)
+
+	params, err = LoadParams(&corepb.GenesisConsensusDpos{
+		BlockIntervalInMs: 1000,
+		DynastySize:       3,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1000), params.BlockIntervalInMs)
+	assert.Equal(t, int64(210000), params.DynastyIntervalInMs)
+	assert.Equal(t, int64(250), params.AcceptedNetWorkDelayInMs)
+	assert.Equal(t, int64(350), params.MaxMintDurationInMs)
+	assert.Equal(t, int64(150), params.MinMintDurationInMs)
+	assert.Equal(t, 3, params.DynastySize)
+	assert.Equal(t, 3, params.ConsensusSize)
+	assert.Equal(t, uint64(210), params.NumberOfBlocksInDynasty())
+
+	tests := []struct {
+		name string
+		conf *corepb.GenesisConsensusDpos
+		err  error
+	}{
+		{"negative block interval", &corepb.GenesisConsensusDpos{BlockIntervalInMs: -1000}, ErrInvalidBlockIntervalParam},
+		{"partial second block interval", &corepb.GenesisConsensusDpos{BlockIntervalInMs: 1500}, ErrInvalidBlockIntervalParam},
+		{"dynasty interval not aligned", &corepb.GenesisConsensusDpos{DynastyIntervalInMs: 20000}, ErrInvalidDynastyIntervalParam},
+		{"dynasty interval too short", &corepb.GenesisConsensusDpos{DynastyIntervalInMs: 15000 * 20}, ErrInvalidDynastyIntervalParam},
+		{"mint duration out of interval", &corepb.GenesisConsensusDpos{MaxMintDurationInMs: 16000}, ErrInvalidMintDurationParam},
+		{"min mint duration over max", &corepb.GenesisConsensusDpos{MinMintDurationInMs: 6000}, ErrInvalidMintDurationParam},
+		{"network delay out of interval", &corepb.GenesisConsensusDpos{AcceptedNetworkDelayInMs: 16000}, ErrInvalidNetworkDelayParam},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadParams(tt.conf)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestFindProposerWithParams(t *testing.T) {
+	params, err := LoadParams(&corepb.GenesisConsensusDpos{
+		BlockIntervalInMs:   1000,
+		DynastyIntervalInMs: 6000,
+		DynastySize:         3,
+	})
+	assert.Nil(t, err)
+	miners := []byteutils.Hash{{0x01}, {0x02}, {0x03}}
+
+	for now := int64(0); now < 12; now++ {
+		proposer, err := FindProposer(now, miners, params)
+		assert.Nil(t, err)
+		assert.Equal(t, miners[now%6%3], proposer)
+	}
+	_, err = FindProposer(1, miners, DefaultParams())
+	assert.Equal(t, ErrNotBlockForgTime, err)
+}
@@ -0,0 +1,180 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package dpos
+
+import (
+	"errors"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Errors in standby mode
+var (
+	ErrCannotMintWhenStandby = errors.New("cannot mint block now, waiting for the primary miner to miss slots")
+	ErrSlotAlreadySigned     = errors.New("cannot mint block now, the slot has been signed by the miner")
+	ErrMissingSlotBlock      = errors.New("cannot find the block before the slot")
+)
+
+// LastSignedSlot return the timestamp of the latest slot signed by the miner, by this
+// node or the peer minting with the same key, 0 if none.
+func (dpos *Dpos) LastSignedSlot(miner *core.Address) (int64, error) {
+	value, err := dpos.signed.Get(miner.Bytes())
+	if err != nil {
+		if err == storage.ErrKeyNotFound {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return byteutils.Int64(value), nil
+}
+
+// markSigned record the slot signed by the miner, the record never goes backward.
+func (dpos *Dpos) markSigned(miner *core.Address, slot int64) error {
+	last, err := dpos.LastSignedSlot(miner)
+	if err != nil {
+		return err
+	}
+	if slot <= last {
+		return nil
+	}
+	return dpos.signed.Put(miner.Bytes(), byteutils.FromInt64(slot))
+}
+
+// signSlot record the slot as signed by the miner of this node before signing it,
+// a slot signed already is refused.
+func (dpos *Dpos) signSlot(slot int64) error {
+	dpos.standbyLock.Lock()
+	defer dpos.standbyLock.Unlock()
+
+	last, err := dpos.LastSignedSlot(dpos.miner)
+	if err != nil {
+		return err
+	}
+	if slot <= last {
+		return ErrSlotAlreadySigned
+	}
+	if err := dpos.markSigned(dpos.miner, slot); err != nil {
+		return err
+	}
+	dpos.standbyMinted = slot
+	return nil
+}
+
+// observeBlock record the slot of the block minted with the key of this node's miner.
+// A standby steps back when the primary mints again.
+func (dpos *Dpos) observeBlock(block *core.Block) {
+	if dpos.miner == nil || !byteutils.Hash(block.ConsensusRoot().Proposer).Equals(dpos.miner.Bytes()) {
+		return
+	}
+
+	dpos.standbyLock.Lock()
+	defer dpos.standbyLock.Unlock()
+
+	if err := dpos.markSigned(dpos.miner, block.Timestamp()); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"block": block,
+			"err":   err,
+		}).Debug("Failed to record the signed slot.")
+	}
+	if dpos.standbySlots == 0 {
+		return
+	}
+	dpos.standbyMissed = 0
+	if dpos.standbyActive && block.Timestamp() > dpos.standbyMinted {
+		dpos.standbyActive = false
+		logging.CLog().WithFields(logrus.Fields{
+			"miner": dpos.miner,
+			"block": block,
+		}).Info("Primary miner is back, standby stops mining.")
+	}
+}
+
+// checkStandby count the consecutive slots missed by the primary miner, a standby
+// takes over mining when the count reaches the standby slots.
+func (dpos *Dpos) checkStandby(tail *core.Block, nowInMs int64) error {
+	if dpos.standbySlots == 0 || dpos.miner == nil {
+		return nil
+	}
+
+	dpos.standbyLock.Lock()
+	defer dpos.standbyLock.Unlock()
+
+	if dpos.standbyActive {
+		return nil
+	}
+
+	// the block of the slot before last slot should have arrived.
+	slot := (dpos.lastSlot(nowInMs) - dpos.params.BlockIntervalInMs) / SecondInMs
+	if slot <= dpos.standbyWatched {
+		return ErrCannotMintWhenStandby
+	}
+	dpos.standbyWatched = slot
+
+	proposer, minted, err := dpos.slotProposer(tail, slot)
+	if err != nil {
+		return err
+	}
+	if !proposer.Equals(dpos.miner.Bytes()) {
+		return ErrCannotMintWhenStandby
+	}
+	if minted {
+		dpos.standbyMissed = 0
+		return ErrCannotMintWhenStandby
+	}
+
+	dpos.standbyMissed++
+	logging.CLog().WithFields(logrus.Fields{
+		"miner":  dpos.miner,
+		"slot":   slot,
+		"missed": dpos.standbyMissed,
+		"limit":  dpos.standbySlots,
+	}).Warn("Primary miner missed the slot.")
+	if dpos.standbyMissed < dpos.standbySlots {
+		return ErrCannotMintWhenStandby
+	}
+
+	dpos.standbyActive = true
+	logging.CLog().WithFields(logrus.Fields{
+		"miner":  dpos.miner,
+		"missed": dpos.standbyMissed,
+	}).Info("Standby takes over mining.")
+	return nil
+}
+
+// slotProposer return the proposer of the slot, and whether a block is minted in it on chain.
+func (dpos *Dpos) slotProposer(tail *core.Block, slot int64) (byteutils.Hash, bool, error) {
+	cur := tail
+	for cur.Timestamp() > slot {
+		if cur = dpos.chain.GetBlock(cur.ParentHash()); cur == nil {
+			return nil, false, ErrMissingSlotBlock
+		}
+	}
+	if cur.Timestamp() == slot {
+		return cur.ConsensusRoot().Proposer, true, nil
+	}
+	consensusState, err := cur.WorldState().NextConsensusState(slot - cur.Timestamp())
+	if err != nil {
+		return nil, false, err
+	}
+	return consensusState.Proposer(), false, nil
+}
@@ -0,0 +1,86 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package dpos
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignSlot(t *testing.T) {
+	neb := mockNeb(t)
+	dpos := neb.consensus.(*Dpos)
+
+	last, err := dpos.LastSignedSlot(dpos.miner)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), last)
+
+	assert.Nil(t, dpos.signSlot(30))
+	assert.Equal(t, ErrSlotAlreadySigned, dpos.signSlot(30))
+	assert.Equal(t, ErrSlotAlreadySigned, dpos.signSlot(15))
+	assert.Nil(t, dpos.signSlot(345))
+
+	// the record is persisted in storage.
+	assert.Nil(t, dpos.Setup(neb))
+	last, err = dpos.LastSignedSlot(dpos.miner)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(345), last)
+	assert.Equal(t, ErrSlotAlreadySigned, dpos.signSlot(330))
+}
+
+func TestStandbyTakeover(t *testing.T) {
+	neb := mockNeb(t)
+	dpos := neb.consensus.(*Dpos)
+	genesis := neb.chain.TailBlock()
+
+	// the node shadows the proposer of the first slot.
+	slotInterval := BlockIntervalInMs / SecondInMs
+	consensusState, err := genesis.WorldState().NextConsensusState(slotInterval)
+	assert.Nil(t, err)
+	miner, err := core.AddressParseFromBytes(consensusState.Proposer())
+	assert.Nil(t, err)
+	dpos.miner = miner
+	dpos.standbySlots = 2
+
+	// the first slot is watched once the second one begins.
+	nowInMs := 2*slotInterval*SecondInMs + SecondInMs
+	assert.Equal(t, ErrCannotMintWhenStandby, dpos.checkStandby(genesis, nowInMs))
+	assert.Equal(t, 1, dpos.standbyMissed)
+	// a slot is watched only once.
+	assert.Equal(t, ErrCannotMintWhenStandby, dpos.checkStandby(genesis, nowInMs))
+	assert.Equal(t, 1, dpos.standbyMissed)
+	// the slots of other miners are not counted.
+	nowInMs += BlockIntervalInMs
+	assert.Equal(t, ErrCannotMintWhenStandby, dpos.checkStandby(genesis, nowInMs))
+	assert.Equal(t, 1, dpos.standbyMissed)
+
+	// the next slot of the miner is missed too.
+	nowInMs = (int64(DynastySize)+2)*slotInterval*SecondInMs + SecondInMs
+	assert.Nil(t, dpos.checkStandby(genesis, nowInMs))
+	assert.True(t, dpos.standbyActive)
+	assert.Nil(t, dpos.checkStandby(genesis, nowInMs))
+
+	// the primary mints again, standby steps back and never signs its slot.
+	block := mockSlotBlock(t, neb, 1)
+	assert.False(t, dpos.standbyActive)
+	assert.Equal(t, 0, dpos.standbyMissed)
+	assert.Equal(t, ErrSlotAlreadySigned, dpos.signSlot(block.Timestamp()))
+}
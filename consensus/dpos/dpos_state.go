@@ -19,9 +19,10 @@
 package dpos
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
-	"strconv"
+	"sort"
 	"time"
 
 	"github.com/nebulasio/go-nebulas/consensus/pb"
@@ -32,12 +33,13 @@ import (
 
 	"github.com/nebulasio/go-nebulas/common/trie"
 	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
 	"github.com/nebulasio/go-nebulas/util/logging"
 	"github.com/sirupsen/logrus"
 )
 
-// Consensus Related Constants
+// Consensus Related Constants, the defaults of Params
 const (
 	SecondInMs               = int64(1000)
 	BlockIntervalInMs        = int64(15000)
@@ -51,14 +53,15 @@ const (
 
 // Errors in dpos state
 var (
-	ErrTooFewCandidates        = errors.New("the size of candidates in consensus is un-safe, should be greater than or equal " + strconv.Itoa(ConsensusSize))
-	ErrInitialDynastyNotEnough = errors.New("the size of initial dynasty in genesis block is un-safe, should be greater than or equal " + strconv.Itoa(ConsensusSize))
-	ErrInvalidDynasty          = errors.New("the size of initial dynasty in genesis block is invalid, should be equal " + strconv.Itoa(DynastySize))
+	ErrTooFewCandidates        = errors.New("the size of candidates in consensus is un-safe, should be greater than or equal the consensus size")
+	ErrInitialDynastyNotEnough = errors.New("the size of initial dynasty in genesis block is un-safe, should be greater than or equal the consensus size")
+	ErrInvalidDynasty          = errors.New("the size of initial dynasty in genesis block is invalid, should be equal the dynasty size")
 	ErrCloneDynastyTrie        = errors.New("Failed to clone dynasty trie")
 	ErrCloneNextDynastyTrie    = errors.New("Failed to clone next dynasty trie")
 	ErrCloneDelegateTrie       = errors.New("Failed to clone delegate trie")
 	ErrCloneCandidatesTrie     = errors.New("Failed to clone candidates trie")
 	ErrCloneVoteTrie           = errors.New("Failed to clone vote trie")
+	ErrCloneDisqualifiedTrie   = errors.New("Failed to clone disqualified trie")
 	ErrCloneMintCntTrie        = errors.New("Failed to clone mint count trie")
 	ErrNotBlockForgTime        = errors.New("now is not time to forg block")
 	ErrFoundNilProposer        = errors.New("found a nil proposer")
@@ -69,29 +72,54 @@ type State struct {
 	timestamp int64
 	proposer  byteutils.Hash
 
-	dynastyTrie *trie.Trie // key: delegatee, val: delegatee
+	dynastyTrie   *trie.Trie // key: delegatee, val: delegatee
+	candidateTrie *trie.Trie // key: candidate, val: candidate
+	voteTrie      *trie.Trie // key: voter, val: candidate
 
+	disqualifiedTrie *trie.Trie // key: miner, val: miner
+
+	params    *Params
 	chain     *core.BlockChain
 	consensus core.Consensus
 }
 
 // NewState create a new dpos state
 func (dpos *Dpos) NewState(root *consensuspb.ConsensusRoot, stor storage.Storage, needChangeLog bool) (state.ConsensusState, error) {
-	var dynastyRoot byteutils.Hash
+	var dynastyRoot, candidateRoot, voteRoot, disqualifiedRoot byteutils.Hash
 	if root != nil {
 		dynastyRoot = root.DynastyRoot
+		candidateRoot = root.CandidateRoot
+		voteRoot = root.VoteRoot
+		disqualifiedRoot = root.DisqualifiedRoot
 	}
 	dynastyTrie, err := trie.NewTrie(dynastyRoot, stor, needChangeLog)
 	if err != nil {
 		return nil, err
 	}
+	candidateTrie, err := trie.NewTrie(candidateRoot, stor, needChangeLog)
+	if err != nil {
+		return nil, err
+	}
+	voteTrie, err := trie.NewTrie(voteRoot, stor, needChangeLog)
+	if err != nil {
+		return nil, err
+	}
+	disqualifiedTrie, err := trie.NewTrie(disqualifiedRoot, stor, needChangeLog)
+	if err != nil {
+		return nil, err
+	}
 
 	return &State{
 		timestamp: root.Timestamp,
 		proposer:  root.Proposer,
 
-		dynastyTrie: dynastyTrie,
+		dynastyTrie:   dynastyTrie,
+		candidateTrie: candidateTrie,
+		voteTrie:      voteTrie,
+
+		disqualifiedTrie: disqualifiedTrie,
 
+		params:    dpos.params,
 		chain:     dpos.chain,
 		consensus: dpos,
 	}, nil
@@ -111,12 +139,12 @@ func (dpos *Dpos) CheckTimeout(block *core.Block) bool {
 		return false
 	}
 	behindInMs := nowInMs - blockTimeInMs
-	if behindInMs > AcceptedNetWorkDelayInMs {
+	if behindInMs > dpos.params.AcceptedNetWorkDelayInMs {
 		logging.VLog().WithFields(logrus.Fields{
 			"block": block,
 			"now":   nowInMs,
 			"diff":  behindInMs,
-			"limit": AcceptedNetWorkDelayInMs,
+			"limit": dpos.params.AcceptedNetWorkDelayInMs,
 			"err":   "timeout - expired block",
 		}).Warn("Found a expired block.")
 		return true
@@ -126,18 +154,26 @@ func (dpos *Dpos) CheckTimeout(block *core.Block) bool {
 
 // GenesisConsensusState create a new genesis dpos state
 func (dpos *Dpos) GenesisConsensusState(chain *core.BlockChain, conf *corepb.Genesis) (state.ConsensusState, error) {
+	dposConf := conf.GetConsensus().GetDpos()
+	if dposConf == nil {
+		return nil, ErrMissingConfigForDpos
+	}
+	params, err := LoadParams(dposConf)
+	if err != nil {
+		return nil, err
+	}
 	dynastyTrie, err := trie.NewTrie(nil, chain.Storage(), false)
 	if err != nil {
 		return nil, err
 	}
-	if len(conf.Consensus.Dpos.Dynasty) < ConsensusSize {
+	if len(dposConf.Dynasty) < params.ConsensusSize {
 		return nil, ErrInitialDynastyNotEnough
 	}
-	if len(conf.Consensus.Dpos.Dynasty) != DynastySize {
+	if len(dposConf.Dynasty) != params.DynastySize {
 		return nil, ErrInvalidDynasty
 	}
-	for i := 0; i < len(conf.Consensus.Dpos.Dynasty); i++ {
-		addr := conf.Consensus.Dpos.Dynasty[i]
+	for i := 0; i < len(dposConf.Dynasty); i++ {
+		addr := dposConf.Dynasty[i]
 		member, err := core.AddressParse(addr)
 		if err != nil {
 			return nil, err
@@ -147,12 +183,29 @@ func (dpos *Dpos) GenesisConsensusState(chain *core.BlockChain, conf *corepb.Gen
 			return nil, err
 		}
 	}
+	candidateTrie, err := trie.NewTrie(nil, chain.Storage(), false)
+	if err != nil {
+		return nil, err
+	}
+	voteTrie, err := trie.NewTrie(nil, chain.Storage(), false)
+	if err != nil {
+		return nil, err
+	}
+	disqualifiedTrie, err := trie.NewTrie(nil, chain.Storage(), false)
+	if err != nil {
+		return nil, err
+	}
 	return &State{
 		timestamp: core.GenesisTimestamp,
 		proposer:  nil,
 
-		dynastyTrie: dynastyTrie,
+		dynastyTrie:   dynastyTrie,
+		candidateTrie: candidateTrie,
+		voteTrie:      voteTrie,
+
+		disqualifiedTrie: disqualifiedTrie,
 
+		params:    params,
 		chain:     chain,
 		consensus: dpos,
 	}, nil
@@ -163,10 +216,13 @@ func (ds *State) String() string {
 	if ds.proposer != nil {
 		proposer = ds.proposer.String()
 	}
-	return fmt.Sprintf(`{"timestamp": %d, "proposer": "%s", "dynasty": "%s"}`,
+	return fmt.Sprintf(`{"timestamp": %d, "proposer": "%s", "dynasty": "%s", "candidate": "%s", "vote": "%s", "disqualified": "%s"}`,
 		ds.timestamp,
 		proposer,
 		byteutils.Hex(ds.dynastyTrie.RootHash()),
+		byteutils.Hex(ds.candidateTrie.RootHash()),
+		byteutils.Hex(ds.voteTrie.RootHash()),
+		byteutils.Hex(ds.disqualifiedTrie.RootHash()),
 	)
 }
 
@@ -176,6 +232,15 @@ func (ds *State) Replay(done state.ConsensusState) error {
 	if _, err := ds.dynastyTrie.Replay(state.dynastyTrie); err != nil {
 		return err
 	}
+	if _, err := ds.candidateTrie.Replay(state.candidateTrie); err != nil {
+		return err
+	}
+	if _, err := ds.voteTrie.Replay(state.voteTrie); err != nil {
+		return err
+	}
+	if _, err := ds.disqualifiedTrie.Replay(state.disqualifiedTrie); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -186,12 +251,29 @@ func (ds *State) Clone() (state.ConsensusState, error) {
 	if err != nil {
 		return nil, ErrCloneDynastyTrie
 	}
+	candidateTrie, err := ds.candidateTrie.Clone()
+	if err != nil {
+		return nil, ErrCloneCandidatesTrie
+	}
+	voteTrie, err := ds.voteTrie.Clone()
+	if err != nil {
+		return nil, ErrCloneVoteTrie
+	}
+	disqualifiedTrie, err := ds.disqualifiedTrie.Clone()
+	if err != nil {
+		return nil, ErrCloneDisqualifiedTrie
+	}
 	return &State{
 		timestamp: ds.timestamp,
 		proposer:  ds.proposer,
 
-		dynastyTrie: dynastyTrie,
+		dynastyTrie:   dynastyTrie,
+		candidateTrie: candidateTrie,
+		voteTrie:      voteTrie,
+
+		disqualifiedTrie: disqualifiedTrie,
 
+		params:    ds.params,
 		chain:     ds.chain,
 		consensus: ds.consensus,
 	}, nil
@@ -200,9 +282,13 @@ func (ds *State) Clone() (state.ConsensusState, error) {
 // RootHash hash dpos state
 func (ds *State) RootHash() *consensuspb.ConsensusRoot {
 	return &consensuspb.ConsensusRoot{
-		DynastyRoot: ds.dynastyTrie.RootHash(),
-		Timestamp:   ds.TimeStamp(),
-		Proposer:    ds.Proposer(),
+		DynastyRoot:   ds.dynastyTrie.RootHash(),
+		CandidateRoot: ds.candidateTrie.RootHash(),
+		VoteRoot:      ds.voteTrie.RootHash(),
+		Timestamp:     ds.TimeStamp(),
+		Proposer:      ds.Proposer(),
+
+		DisqualifiedRoot: ds.disqualifiedTrie.RootHash(),
 	}
 }
 
@@ -217,14 +303,14 @@ func (ds *State) DynastyRoot() byteutils.Hash {
 }
 
 // FindProposer for now in given dynasty
-func FindProposer(now int64, miners []byteutils.Hash) (proposer byteutils.Hash, err error) {
+func FindProposer(now int64, miners []byteutils.Hash, params *Params) (proposer byteutils.Hash, err error) {
 	nowInMs := now * SecondInMs
-	offsetInMs := nowInMs % DynastyIntervalInMs
-	if (offsetInMs % BlockIntervalInMs) != 0 {
+	offsetInMs := nowInMs % params.DynastyIntervalInMs
+	if (offsetInMs % params.BlockIntervalInMs) != 0 {
 		return nil, ErrNotBlockForgTime
 	}
-	offset := offsetInMs / BlockIntervalInMs
-	offset %= DynastySize
+	offset := offsetInMs / params.BlockIntervalInMs
+	offset %= int64(params.DynastySize)
 
 	if offset >= 0 && int(offset) < len(miners) {
 		proposer = miners[offset]
@@ -252,7 +338,7 @@ func (ds *State) TimeStamp() int64 {
 // NextConsensusState return the new state after some seconds elapsed
 func (ds *State) NextConsensusState(elapsedSecond int64, worldState state.WorldState) (state.ConsensusState, error) {
 	elapsedSecondInMs := elapsedSecond * SecondInMs
-	if elapsedSecondInMs <= 0 || elapsedSecondInMs%BlockIntervalInMs != 0 {
+	if elapsedSecondInMs <= 0 || elapsedSecondInMs%ds.params.BlockIntervalInMs != 0 {
 		return nil, ErrNotBlockForgTime
 	}
 
@@ -260,31 +346,273 @@ func (ds *State) NextConsensusState(elapsedSecond int64, worldState state.WorldS
 	if err != nil {
 		return nil, err
 	}
+	candidateTrie, err := ds.candidateTrie.Clone()
+	if err != nil {
+		return nil, err
+	}
+	voteTrie, err := ds.voteTrie.Clone()
+	if err != nil {
+		return nil, err
+	}
+	disqualifiedTrie, err := ds.disqualifiedTrie.Clone()
+	if err != nil {
+		return nil, err
+	}
 
 	consensusState := &State{
 		timestamp: ds.timestamp + elapsedSecond,
 
-		dynastyTrie: dynastyTrie,
+		dynastyTrie:   dynastyTrie,
+		candidateTrie: candidateTrie,
+		voteTrie:      voteTrie,
+
+		disqualifiedTrie: disqualifiedTrie,
 
+		params:    ds.params,
 		chain:     ds.chain,
 		consensus: ds.consensus,
 	}
 
-	miners, err := TraverseDynasty(dynastyTrie)
+	// the next dynasty is elected when a new dynasty interval begins.
+	dynastyIntervalInMs := ds.params.DynastyIntervalInMs
+	if ds.timestamp*SecondInMs/dynastyIntervalInMs < consensusState.timestamp*SecondInMs/dynastyIntervalInMs {
+		if err := consensusState.electDynasty(worldState); err != nil {
+			return nil, err
+		}
+	}
+
+	miners, err := TraverseDynasty(consensusState.dynastyTrie)
 	if err != nil {
 		return nil, err
 	}
-	consensusState.proposer, err = FindProposer(consensusState.timestamp, miners)
+	// nobody proposes in the empty seats of a shrunk dynasty, nor in the seats of disqualified members.
+	consensusState.proposer, err = FindProposer(consensusState.timestamp, miners, ds.params)
+	if err == ErrFoundNilProposer {
+		return consensusState, nil
+	}
 	if err != nil {
 		return nil, err
 	}
+	banned, err := consensusState.IsDisqualified(consensusState.proposer)
+	if err != nil {
+		return nil, err
+	}
+	if banned {
+		consensusState.proposer = nil
+	}
 	return consensusState, nil
 }
 
+// IsCandidate return true if the addr is a registered candidate
+func (ds *State) IsCandidate(addr byteutils.Hash) (bool, error) {
+	if _, err := ds.candidateTrie.Get(addr); err != nil {
+		if err == storage.ErrKeyNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// AddCandidate register the addr as a candidate
+func (ds *State) AddCandidate(addr byteutils.Hash) error {
+	_, err := ds.candidateTrie.Put(addr, addr)
+	return err
+}
+
+// DelCandidate unregister the candidate
+func (ds *State) DelCandidate(addr byteutils.Hash) error {
+	_, err := ds.candidateTrie.Del(addr)
+	return err
+}
+
+// Candidates return all registered candidates
+func (ds *State) Candidates() ([]byteutils.Hash, error) {
+	return traverse(ds.candidateTrie, false)
+}
+
+// VoteOf return the candidate voted by voter, nil if the voter doesn't vote.
+func (ds *State) VoteOf(voter byteutils.Hash) (byteutils.Hash, error) {
+	candidate, err := ds.voteTrie.Get(voter)
+	if err != nil {
+		if err == storage.ErrKeyNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return candidate, nil
+}
+
+// Vote record the voter votes for the candidate, replacing the previous vote
+func (ds *State) Vote(voter byteutils.Hash, candidate byteutils.Hash) error {
+	_, err := ds.voteTrie.Put(voter, candidate)
+	return err
+}
+
+// Unvote withdraw the vote of voter
+func (ds *State) Unvote(voter byteutils.Hash) error {
+	_, err := ds.voteTrie.Del(voter)
+	return err
+}
+
+// IsDisqualified return true if the miner is not eligible for dynasties
+func (ds *State) IsDisqualified(miner byteutils.Hash) (bool, error) {
+	if _, err := ds.disqualifiedTrie.Get(miner); err != nil {
+		if err == storage.ErrKeyNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// Disqualify make the miner ineligible for subsequent dynasties
+func (ds *State) Disqualify(miner byteutils.Hash) error {
+	_, err := ds.disqualifiedTrie.Put(miner, miner)
+	return err
+}
+
+type ballot struct {
+	candidate byteutils.Hash
+	votes     *util.Uint128
+}
+
+// electDynasty elect the candidates with most votes, weighted by the balance
+// of voters, as the new dynasty. The seats without elected candidates are
+// kept by the qualified members of current dynasty, the disqualified members
+// lose their seats, and the dynasty shrinks if there is no one else to fill them.
+func (ds *State) electDynasty(worldState state.WorldState) error {
+	candidates, err := ds.Candidates()
+	if err != nil {
+		return err
+	}
+	members, err := TraverseDynasty(ds.dynastyTrie)
+	if err != nil {
+		return err
+	}
+	eligible := []byteutils.Hash{}
+	disqualified := []byteutils.Hash{}
+	for _, member := range members {
+		banned, err := ds.IsDisqualified(member)
+		if err != nil {
+			return err
+		}
+		if banned {
+			disqualified = append(disqualified, member)
+		} else {
+			eligible = append(eligible, member)
+		}
+	}
+
+	ballots, err := ds.countVotes(candidates, worldState)
+	if err != nil {
+		return err
+	}
+	if len(ballots) == 0 && len(disqualified) == 0 {
+		return nil
+	}
+
+	elected := make(map[byteutils.HexHash]bool)
+	dynasty := []byteutils.Hash{}
+	elect := func(members []byteutils.Hash) {
+		for _, member := range members {
+			if len(dynasty) >= ds.params.DynastySize {
+				return
+			}
+			if !elected[member.Hex()] {
+				elected[member.Hex()] = true
+				dynasty = append(dynasty, member)
+			}
+		}
+	}
+	for _, b := range ballots {
+		elect([]byteutils.Hash{b.candidate})
+	}
+	elect(eligible)
+
+	for _, member := range members {
+		if _, err := ds.dynastyTrie.Del(member); err != nil {
+			return err
+		}
+	}
+	for _, member := range dynasty {
+		if _, err := ds.dynastyTrie.Put(member, member); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// countVotes return the qualified candidates with votes, sorted by votes in descending order.
+func (ds *State) countVotes(candidates []byteutils.Hash, worldState state.WorldState) ([]*ballot, error) {
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+	tally := make(map[byteutils.HexHash]*ballot)
+	for _, candidate := range candidates {
+		banned, err := ds.IsDisqualified(candidate)
+		if err != nil {
+			return nil, err
+		}
+		if !banned {
+			tally[candidate.Hex()] = &ballot{candidate: candidate, votes: util.NewUint128()}
+		}
+	}
+
+	// read balances from a copy, the parent world state must stay untouched.
+	ws, err := worldState.Clone()
+	if err != nil {
+		return nil, err
+	}
+	iter, err := ds.voteTrie.Iterator(nil)
+	if err != nil && err != storage.ErrKeyNotFound {
+		return nil, err
+	}
+	if err == nil {
+		exist, err := iter.Next()
+		for exist {
+			if b, ok := tally[byteutils.Hash(iter.Value()).Hex()]; ok {
+				voter, err := ws.GetOrCreateUserAccount(iter.Key())
+				if err != nil {
+					return nil, err
+				}
+				votes, err := b.votes.Add(voter.Balance())
+				if err != nil {
+					return nil, err
+				}
+				b.votes = votes
+			}
+			exist, err = iter.Next()
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ballots := make([]*ballot, 0, len(tally))
+	for _, b := range tally {
+		if b.votes.Cmp(util.NewUint128()) > 0 {
+			ballots = append(ballots, b)
+		}
+	}
+	sort.Slice(ballots, func(i, j int) bool {
+		if c := ballots[i].votes.Cmp(ballots[j].votes); c != 0 {
+			return c > 0
+		}
+		return bytes.Compare(ballots[i].candidate, ballots[j].candidate) < 0
+	})
+	return ballots, nil
+}
+
 // TraverseDynasty return all members in the dynasty
 func TraverseDynasty(dynasty *trie.Trie) ([]byteutils.Hash, error) {
+	return traverse(dynasty, false)
+}
+
+// traverse return the values, or keys if keys is true, of all entries in the trie
+func traverse(t *trie.Trie, keys bool) ([]byteutils.Hash, error) {
 	members := []byteutils.Hash{}
-	iter, err := dynasty.Iterator(nil)
+	iter, err := t.Iterator(nil)
 	if err != nil && err != storage.ErrKeyNotFound {
 		return nil, err
 	}
@@ -293,7 +621,11 @@ func TraverseDynasty(dynasty *trie.Trie) ([]byteutils.Hash, error) {
 	}
 	exist, err := iter.Next()
 	for exist {
-		members = append(members, iter.Value())
+		if keys {
+			members = append(members, iter.Key())
+		} else {
+			members = append(members, iter.Value())
+		}
 		exist, err = iter.Next()
 	}
 	return members, nil
@@ -24,12 +24,15 @@ import (
 	"github.com/nebulasio/go-nebulas/consensus/pb"
 
 	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
 	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/nebulasio/go-nebulas/common/trie"
 	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
 )
 
@@ -55,7 +58,7 @@ func TestBlock_NextDynastyContext(t *testing.T) {
 	// check dynasty
 	consensusRoot := context.RootHash()
 	assert.Nil(t, err)
-	checkDynasty(t, neb.consensus, consensusRoot, neb.Storage())
+	checkDynasty(t, neb.consensus, consensusRoot, neb.chain.Storage())
 
 	context, err = block.WorldState().NextConsensusState((BlockIntervalInMs + DynastyIntervalInMs) / SecondInMs)
 	assert.Nil(t, err)
@@ -64,7 +67,7 @@ func TestBlock_NextDynastyContext(t *testing.T) {
 	// check dynasty
 	consensusRoot = context.RootHash()
 	assert.Nil(t, err)
-	checkDynasty(t, neb.consensus, consensusRoot, neb.Storage())
+	checkDynasty(t, neb.consensus, consensusRoot, neb.chain.Storage())
 
 	context, err = block.WorldState().NextConsensusState(DynastyIntervalInMs / SecondInMs / 2)
 	assert.Nil(t, err)
@@ -73,7 +76,7 @@ func TestBlock_NextDynastyContext(t *testing.T) {
 	// check dynasty
 	consensusRoot = context.RootHash()
 	assert.Nil(t, err)
-	checkDynasty(t, neb.consensus, consensusRoot, neb.Storage())
+	checkDynasty(t, neb.consensus, consensusRoot, neb.chain.Storage())
 
 	context, err = block.WorldState().NextConsensusState((DynastyIntervalInMs*2 + DynastyIntervalInMs/3) / SecondInMs)
 	assert.Nil(t, err)
@@ -83,7 +86,7 @@ func TestBlock_NextDynastyContext(t *testing.T) {
 	// check dynasty
 	consensusRoot = context.RootHash()
 	assert.Nil(t, err)
-	checkDynasty(t, neb.consensus, consensusRoot, neb.Storage())
+	checkDynasty(t, neb.consensus, consensusRoot, neb.chain.Storage())
 
 	// new block
 	coinbase, err := core.AddressParseFromBytes(miners[4])
@@ -112,7 +115,7 @@ func TestTraverseDynasty(t *testing.T) {
 
 func TestInitialDynastyNotEnough(t *testing.T) {
 	neb := mockNeb(t)
-	neb.genesis.Consensus.Dpos.Dynasty = []string{}
+	neb.genesis.Consensus.GetDpos().Dynasty = []string{}
 	chain, err := core.NewBlockChain(neb)
 	assert.Nil(t, err)
 	assert.Equal(t, chain.Setup(neb), core.ErrGenesisNotEqualDynastyLenInDB)
@@ -128,7 +131,7 @@ func TestNewGenesisBlock(t *testing.T) {
 	dumpConf, err := core.DumpGenesis(chain)
 	assert.Nil(t, err)
 	assert.Equal(t, dumpConf.Meta.ChainId, conf.Meta.ChainId)
-	assert.Equal(t, dumpConf.Consensus.Dpos.Dynasty, conf.Consensus.Dpos.Dynasty)
+	assert.Equal(t, dumpConf.Consensus.GetDpos().Dynasty, conf.Consensus.GetDpos().Dynasty)
 	assert.Equal(t, dumpConf.TokenDistribution, conf.TokenDistribution)
 }
 
@@ -148,14 +151,14 @@ func TestCheckGenesisAndDBConsense(t *testing.T) {
 	assert.Equal(t, err, core.ErrGenesisNotEqualTokenInDB)
 
 	conf1 := MockGenesisConf()
-	conf1.Consensus.Dpos.Dynasty = nil
+	conf1.Consensus.GetDpos().Dynasty = nil
 	// fmt.Printf("conf1:%v\n", conf1)
 	err = core.CheckGenesisConfByDB(genesisDB, conf1)
 	assert.NotNil(t, err)
 	assert.Equal(t, err, core.ErrGenesisNotEqualDynastyLenInDB)
 
 	conf2 := MockGenesisConf()
-	conf2.Consensus.Dpos.Dynasty[0] = "12b"
+	conf2.Consensus.GetDpos().Dynasty[0] = "12b"
 	err = core.CheckGenesisConfByDB(genesisDB, conf2)
 	assert.NotNil(t, err)
 	assert.Equal(t, err, core.ErrGenesisNotEqualDynastyInDB)
@@ -166,4 +169,200 @@ func TestCheckGenesisAndDBConsense(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Equal(t, err, core.ErrGenesisNotEqualTokenLenInDB)
 
+	conf5 := MockGenesisConf()
+	conf5.Consensus.GetDpos().BlockIntervalInMs = 5000
+	err = core.CheckGenesisConfByDB(genesisDB, conf5)
+	assert.Equal(t, core.ErrGenesisNotEqualParamsInDB, err)
+
+	conf6 := MockGenesisConf()
+	conf6.Consensus.GetDpos().DynastySize = 6
+	err = core.CheckGenesisConfByDB(genesisDB, conf6)
+	assert.Equal(t, core.ErrGenesisNotEqualParamsInDB, err)
+
+	conf7 := MockGenesisConf()
+	conf7.Nr = &corepb.GenesisNr{Window: 100}
+	err = core.CheckGenesisConfByDB(genesisDB, conf7)
+	assert.Equal(t, core.ErrGenesisNotEqualParamsInDB, err)
+
+}
+
+func TestElectDynasty(t *testing.T) {
+	neb := mockNeb(t)
+	tail := neb.chain.TailBlock()
+	ws, err := tail.WorldState().Clone()
+	assert.Nil(t, err)
+	election, err := ws.ElectionState()
+	assert.Nil(t, err)
+
+	priv := secp256k1.GeneratePrivateKey()
+	pub, err := priv.PublicKey().Encoded()
+	assert.Nil(t, err)
+	outsider, err := core.NewAddressFromPublicKey(pub)
+	assert.Nil(t, err)
+	member, err := core.AddressParse(DefaultOpenDynasty[1])
+	assert.Nil(t, err)
+	voter1, err := core.AddressParse("n1FF1nz6tarkDVwWQkMnnwFPuPKUaQTdptE")
+	assert.Nil(t, err)
+	voter2, err := core.AddressParse("n1H4MYms9F55ehcvygwWE71J8tJC4CRr2so")
+	assert.Nil(t, err)
+
+	// no candidates, the dynasty is kept.
+	next, err := ws.NextConsensusState(DynastyIntervalInMs / SecondInMs)
+	assert.Nil(t, err)
+	assert.Equal(t, tail.WorldState().DynastyRoot(), next.DynastyRoot())
+
+	assert.Nil(t, election.AddCandidate(outsider.Bytes()))
+	assert.Nil(t, election.AddCandidate(member.Bytes()))
+	isCandidate, err := election.IsCandidate(outsider.Bytes())
+	assert.Nil(t, err)
+	assert.True(t, isCandidate)
+	assert.Nil(t, election.Vote(voter1.Bytes(), outsider.Bytes()))
+	assert.Nil(t, election.Vote(voter2.Bytes(), member.Bytes()))
+	vote, err := election.VoteOf(voter1.Bytes())
+	assert.Nil(t, err)
+	assert.Equal(t, outsider.Bytes(), []byte(vote))
+
+	// not a new dynasty interval yet.
+	next, err = ws.NextConsensusState(BlockIntervalInMs / SecondInMs)
+	assert.Nil(t, err)
+	assert.Equal(t, tail.WorldState().DynastyRoot(), next.DynastyRoot())
+
+	next, err = ws.NextConsensusState(DynastyIntervalInMs / SecondInMs)
+	assert.Nil(t, err)
+	dynasty, err := next.Dynasty()
+	assert.Nil(t, err)
+	assert.Equal(t, DynastySize, len(dynasty))
+	assert.Contains(t, dynasty, byteutils.Hash(outsider.Bytes()))
+	assert.Contains(t, dynasty, byteutils.Hash(member.Bytes()))
+
+	// the votes are withdrawn, the dynasty is kept.
+	assert.Nil(t, election.Unvote(voter1.Bytes()))
+	assert.Nil(t, election.Unvote(voter2.Bytes()))
+	vote, err = election.VoteOf(voter1.Bytes())
+	assert.Nil(t, err)
+	assert.Nil(t, vote)
+	next, err = ws.NextConsensusState(DynastyIntervalInMs / SecondInMs)
+	assert.Nil(t, err)
+	assert.Equal(t, tail.WorldState().DynastyRoot(), next.DynastyRoot())
+
+	assert.Nil(t, election.DelCandidate(outsider.Bytes()))
+	candidates, err := election.Candidates()
+	assert.Nil(t, err)
+	assert.Equal(t, []byteutils.Hash{member.Bytes()}, candidates)
+}
+
+func mockElectionTx(t *testing.T, neb *Neb, from *core.Address, payloadType string, payload core.TxPayload) *core.Transaction {
+	data, err := payload.ToBytes()
+	assert.Nil(t, err)
+	gasLimit, _ := util.NewUint128FromInt(200000)
+	tx, err := core.NewTransaction(neb.chain.ChainID(), from, from, util.NewUint128(), 1,
+		payloadType, data, core.TransactionGasPrice, gasLimit)
+	assert.Nil(t, err)
+	return tx
+}
+
+func TestCandidatePayload_Execute(t *testing.T) {
+	neb := mockNeb(t)
+	tail := neb.chain.TailBlock()
+	candidate, err := core.AddressParse("n1FF1nz6tarkDVwWQkMnnwFPuPKUaQTdptE")
+	assert.Nil(t, err)
+	block, err := core.NewBlock(neb.chain.ChainID(), candidate, tail)
+	assert.Nil(t, err)
+	ws := block.WorldState()
+	election, err := ws.ElectionState()
+	assert.Nil(t, err)
+	gasLimit, _ := util.NewUint128FromInt(200000)
+
+	login, err := core.NewCandidatePayload(core.LoginAction)
+	assert.Nil(t, err)
+	logout, err := core.NewCandidatePayload(core.LogoutAction)
+	assert.Nil(t, err)
+	loginTx := mockElectionTx(t, neb, candidate, core.TxPayloadCandidateType, login)
+	logoutTx := mockElectionTx(t, neb, candidate, core.TxPayloadCandidateType, logout)
+
+	// logout before login.
+	_, _, err = logout.Execute(gasLimit, logoutTx, block, ws)
+	assert.Equal(t, core.ErrInvalidLogoutFromNonCandidate, err)
+
+	_, _, err = login.Execute(gasLimit, loginTx, block, ws)
+	assert.Nil(t, err)
+	registered, err := election.IsCandidate(candidate.Bytes())
+	assert.Nil(t, err)
+	assert.True(t, registered)
+
+	_, _, err = login.Execute(gasLimit, loginTx, block, ws)
+	assert.Equal(t, core.ErrDuplicatedCandidate, err)
+
+	_, _, err = logout.Execute(gasLimit, logoutTx, block, ws)
+	assert.Nil(t, err)
+	registered, err = election.IsCandidate(candidate.Bytes())
+	assert.Nil(t, err)
+	assert.False(t, registered)
+}
+
+func TestDelegatePayload_Execute(t *testing.T) {
+	neb := mockNeb(t)
+	tail := neb.chain.TailBlock()
+	voter, err := core.AddressParse("n1GmkKH6nBMw4rrjt16RrJ9WcgvKUtAZP1s")
+	assert.Nil(t, err)
+	candidate, err := core.AddressParse("n1FF1nz6tarkDVwWQkMnnwFPuPKUaQTdptE")
+	assert.Nil(t, err)
+	other, err := core.AddressParse("n1H4MYms9F55ehcvygwWE71J8tJC4CRr2so")
+	assert.Nil(t, err)
+	block, err := core.NewBlock(neb.chain.ChainID(), voter, tail)
+	assert.Nil(t, err)
+	ws := block.WorldState()
+	election, err := ws.ElectionState()
+	assert.Nil(t, err)
+	gasLimit, _ := util.NewUint128FromInt(200000)
+
+	do, err := core.NewDelegatePayload(core.DelegateAction, candidate.String())
+	assert.Nil(t, err)
+	undo, err := core.NewDelegatePayload(core.UnDelegateAction, candidate.String())
+	assert.Nil(t, err)
+	doOther, err := core.NewDelegatePayload(core.DelegateAction, other.String())
+	assert.Nil(t, err)
+	undoOther, err := core.NewDelegatePayload(core.UnDelegateAction, other.String())
+	assert.Nil(t, err)
+	doTx := mockElectionTx(t, neb, voter, core.TxPayloadDelegateType, do)
+	undoTx := mockElectionTx(t, neb, voter, core.TxPayloadDelegateType, undo)
+	doOtherTx := mockElectionTx(t, neb, voter, core.TxPayloadDelegateType, doOther)
+	undoOtherTx := mockElectionTx(t, neb, voter, core.TxPayloadDelegateType, undoOther)
+
+	// vote for a non-candidate.
+	_, _, err = do.Execute(gasLimit, doTx, block, ws)
+	assert.Equal(t, core.ErrInvalidDelegateToNonCandidate, err)
+	// undo without any vote.
+	_, _, err = undo.Execute(gasLimit, undoTx, block, ws)
+	assert.Equal(t, core.ErrInvalidUnDelegateFromNonDelegatee, err)
+
+	assert.Nil(t, election.AddCandidate(candidate.Bytes()))
+	_, _, err = do.Execute(gasLimit, doTx, block, ws)
+	assert.Nil(t, err)
+	vote, err := election.VoteOf(voter.Bytes())
+	assert.Nil(t, err)
+	assert.Equal(t, candidate.Bytes(), []byte(vote))
+
+	// other is still a non-candidate, the vote is kept.
+	_, _, err = doOther.Execute(gasLimit, doOtherTx, block, ws)
+	assert.Equal(t, core.ErrInvalidDelegateToNonCandidate, err)
+	// undo on an address the sender never voted for.
+	_, _, err = undoOther.Execute(gasLimit, undoOtherTx, block, ws)
+	assert.Equal(t, core.ErrInvalidUnDelegateFromNonDelegatee, err)
+
+	// voting again moves the vote.
+	assert.Nil(t, election.AddCandidate(other.Bytes()))
+	_, _, err = doOther.Execute(gasLimit, doOtherTx, block, ws)
+	assert.Nil(t, err)
+	vote, err = election.VoteOf(voter.Bytes())
+	assert.Nil(t, err)
+	assert.Equal(t, other.Bytes(), []byte(vote))
+	_, _, err = undo.Execute(gasLimit, undoTx, block, ws)
+	assert.Equal(t, core.ErrInvalidUnDelegateFromNonDelegatee, err)
+
+	_, _, err = undoOther.Execute(gasLimit, undoOtherTx, block, ws)
+	assert.Nil(t, err)
+	vote, err = election.VoteOf(voter.Bytes())
+	assert.Nil(t, err)
+	assert.Nil(t, vote)
 }
@@ -0,0 +1,265 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package dpos
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/consensus/pb"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/state"
+	metrics "github.com/nebulasio/go-nebulas/metrics"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// Errors in miner stats
+var (
+	ErrMissingStatsBlock = errors.New("cannot find the block to record miner stats")
+)
+
+var (
+	// statsLatestKey the key of the hash of the latest block recorded in miner stats.
+	statsLatestKey = []byte("latest")
+)
+
+// MissedSlotEvent the data of TopicMissedSlot event
+type MissedSlotEvent struct {
+	Miner     string `json:"miner"`
+	Dynasty   int64  `json:"dynasty"`
+	Timestamp int64  `json:"timestamp"`
+	Height    uint64 `json:"height"` // the height of the first block minted after the slot
+}
+
+func (dpos *Dpos) dynastyOf(timestamp int64) int64 {
+	return timestamp * SecondInMs / dpos.params.DynastyIntervalInMs
+}
+
+func minerStatsKey(dynasty int64, miner byteutils.Hash) []byte {
+	return append(byteutils.FromInt64(dynasty), miner...)
+}
+
+// MinerStats return the dynasty containing timestamp and the produced and missed blocks
+// of its miners, recorded up to the latest irreversible block.
+func (dpos *Dpos) MinerStats(timestamp int64) (int64, []*consensuspb.MinerStats, error) {
+	dynasty := dpos.dynastyOf(timestamp)
+	stats, err := dpos.loadStats(dynasty)
+	if err != nil {
+		return 0, nil, err
+	}
+	return dynasty, stats, nil
+}
+
+func (dpos *Dpos) loadStats(dynasty int64) ([]*consensuspb.MinerStats, error) {
+	iter, err := dpos.stats.NewIterator(byteutils.FromInt64(dynasty))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Release()
+
+	stats := []*consensuspb.MinerStats{}
+	for {
+		exist, err := iter.Next()
+		if err != nil {
+			return nil, err
+		}
+		if !exist {
+			break
+		}
+		pbStats := new(consensuspb.MinerStats)
+		if err := proto.Unmarshal(iter.Value(), pbStats); err != nil {
+			return nil, err
+		}
+		stats = append(stats, pbStats)
+	}
+	return stats, nil
+}
+
+// statsRecorder accumulate the stats changed by a batch of blocks.
+type statsRecorder struct {
+	stor    storage.Storage
+	changed map[string]*consensuspb.MinerStats
+	missed  []*MissedSlotEvent
+}
+
+func (r *statsRecorder) get(dynasty int64, miner byteutils.Hash) (*consensuspb.MinerStats, error) {
+	key := minerStatsKey(dynasty, miner)
+	if stats, ok := r.changed[string(key)]; ok {
+		return stats, nil
+	}
+	stats := &consensuspb.MinerStats{Miner: miner, Dynasty: dynasty}
+	value, err := r.stor.Get(key)
+	if err != nil && err != storage.ErrKeyNotFound {
+		return nil, err
+	}
+	if err == nil {
+		if err := proto.Unmarshal(value, stats); err != nil {
+			return nil, err
+		}
+	}
+	r.changed[string(key)] = stats
+	return stats, nil
+}
+
+// recordStats count the blocks produced and the slots missed by miners from the latest
+// recorded block up to lib. Only irreversible blocks are counted, so the stats are never
+// reverted. The first record starts from the previous lib.
+func (dpos *Dpos) recordStats(prevLIB *core.Block, lib *core.Block) error {
+	latest, err := dpos.stats.Get(statsLatestKey)
+	if err != nil {
+		if err != storage.ErrKeyNotFound {
+			return err
+		}
+		latest = prevLIB.Hash()
+	}
+
+	blocks := []*core.Block{}
+	parent := lib
+	for !parent.Hash().Equals(latest) && !core.CheckGenesisBlock(parent) {
+		blocks = append(blocks, parent)
+		parent = dpos.chain.GetBlock(parent.ParentHash())
+		if parent == nil {
+			return ErrMissingStatsBlock
+		}
+	}
+	if len(blocks) == 0 {
+		return nil
+	}
+
+	recorder := &statsRecorder{
+		stor:    dpos.stats,
+		changed: make(map[string]*consensuspb.MinerStats),
+	}
+	for i := len(blocks) - 1; i >= 0; i-- {
+		block := blocks[i]
+		// the slots before the first block are not counted.
+		if !core.CheckGenesisBlock(parent) {
+			if err := dpos.recordMissedSlots(recorder, parent, block); err != nil {
+				return err
+			}
+		}
+		stats, err := recorder.get(dpos.dynastyOf(block.Timestamp()), block.ConsensusRoot().Proposer)
+		if err != nil {
+			return err
+		}
+		stats.Produced++
+		parent = block
+	}
+
+	for key, stats := range recorder.changed {
+		value, err := proto.Marshal(stats)
+		if err != nil {
+			return err
+		}
+		if err := dpos.stats.Put([]byte(key), value); err != nil {
+			return err
+		}
+	}
+	if err := dpos.stats.Put(statsLatestKey, lib.Hash()); err != nil {
+		return err
+	}
+
+	for _, missed := range recorder.missed {
+		data, err := json.Marshal(missed)
+		if err != nil {
+			return err
+		}
+		dpos.chain.EventEmitter().Trigger(&state.Event{
+			Topic: core.TopicMissedSlot,
+			Data:  string(data),
+		})
+	}
+	return dpos.updateStatsMetrics(dpos.dynastyOf(lib.Timestamp()))
+}
+
+// recordMissedSlots count the slots between parent and block as missed by their proposers,
+// the empty seats of a dynasty shrunk by disqualified members are missed by nobody.
+func (dpos *Dpos) recordMissedSlots(recorder *statsRecorder, parent *core.Block, block *core.Block) error {
+	interval := dpos.params.BlockIntervalInMs / SecondInMs
+	dynasties := make(map[int64][]byteutils.Hash)
+	for slot := parent.Timestamp() + interval; slot < block.Timestamp(); slot += interval {
+		dynasty := dpos.dynastyOf(slot)
+		miners, ok := dynasties[dynasty]
+		if !ok {
+			// the dynasty elected at the slot, the same as the parent's in the same dynasty interval.
+			consensusState, err := parent.WorldState().NextConsensusState(slot - parent.Timestamp())
+			if err != nil {
+				return err
+			}
+			if miners, err = consensusState.Dynasty(); err != nil {
+				return err
+			}
+			dynasties[dynasty] = miners
+		}
+		proposer, err := FindProposer(slot, miners, dpos.params)
+		if err == ErrFoundNilProposer {
+			// the seat is empty in a shrunk dynasty, nobody misses it.
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		stats, err := recorder.get(dynasty, proposer)
+		if err != nil {
+			return err
+		}
+		stats.Missed++
+
+		miner, err := core.AddressParseFromBytes(proposer)
+		if err != nil {
+			return err
+		}
+		recorder.missed = append(recorder.missed, &MissedSlotEvent{
+			Miner:     miner.String(),
+			Dynasty:   dynasty,
+			Timestamp: slot,
+			Height:    block.Height(),
+		})
+	}
+	return nil
+}
+
+// updateStatsMetrics update the gauges of the miners in dynasty, and reset the ones
+// of the miners in previous dynasty.
+func (dpos *Dpos) updateStatsMetrics(dynasty int64) error {
+	stats, err := dpos.loadStats(dynasty)
+	if err != nil {
+		return err
+	}
+	gauged := make(map[string]bool)
+	for _, s := range stats {
+		miner, err := core.AddressParseFromBytes(s.Miner)
+		if err != nil {
+			return err
+		}
+		metrics.NewGauge("neb.miner.produced." + miner.String()).Update(int64(s.Produced))
+		metrics.NewGauge("neb.miner.missed." + miner.String()).Update(int64(s.Missed))
+		gauged[miner.String()] = true
+	}
+	for miner := range dpos.statsMetrics {
+		if !gauged[miner] {
+			metrics.NewGauge("neb.miner.produced." + miner).Update(0)
+			metrics.NewGauge("neb.miner.missed." + miner).Update(0)
+		}
+	}
+	dpos.statsMetrics = gauged
+	return nil
+}
@@ -0,0 +1,159 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package dpos
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/consensus/pb"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockSlotBlock mint a block on tail after slots, signed by the proposer of its slot.
+func mockSlotBlock(t *testing.T, neb *Neb, slots int64) *core.Block {
+	tail := neb.chain.TailBlock()
+	consensusState, err := tail.WorldState().NextConsensusState(slots * BlockIntervalInMs / SecondInMs)
+	assert.Nil(t, err)
+	proposer, err := core.AddressParseFromBytes(consensusState.Proposer())
+	assert.Nil(t, err)
+	miner := GetUnlockAddress(t, neb.am, proposer.String())
+
+	block, err := neb.chain.NewBlock(miner)
+	assert.Nil(t, err)
+	block.WorldState().SetConsensusState(consensusState)
+	block.SetTimestamp(consensusState.TimeStamp())
+	assert.Nil(t, block.Seal())
+	assert.Nil(t, neb.am.SignBlock(miner, block))
+	assert.Nil(t, neb.chain.BlockPool().Push(block))
+	assert.Equal(t, block.Hash(), neb.chain.TailBlock().Hash())
+	return block
+}
+
+func statsOf(stats []*consensuspb.MinerStats) map[string]*consensuspb.MinerStats {
+	result := make(map[string]*consensuspb.MinerStats)
+	for _, s := range stats {
+		result[byteutils.Hex(s.Miner)] = s
+	}
+	return result
+}
+
+func TestRecordStats(t *testing.T) {
+	neb := mockNeb(t)
+	dpos := neb.consensus.(*Dpos)
+	genesis := neb.chain.LIB()
+
+	sub := core.NewEventSubscriber(128, []string{core.TopicMissedSlot})
+	neb.emitter.Register(sub)
+	defer neb.emitter.Deregister(sub)
+
+	// the slot between block1 and block2 is missed.
+	block1 := mockSlotBlock(t, neb, 1)
+	block2 := mockSlotBlock(t, neb, 2)
+	block3 := mockSlotBlock(t, neb, 1)
+	missedSlot := block1.Timestamp() + BlockIntervalInMs/SecondInMs
+	missedConsensus, err := block1.WorldState().NextConsensusState(BlockIntervalInMs / SecondInMs)
+	assert.Nil(t, err)
+	missedMiner := missedConsensus.Proposer()
+
+	assert.Nil(t, dpos.recordStats(genesis, block3))
+	dynasty, stats, err := dpos.MinerStats(block3.Timestamp())
+	assert.Nil(t, err)
+	assert.Equal(t, block3.Timestamp()*SecondInMs/DynastyIntervalInMs, dynasty)
+	assert.Equal(t, 4, len(stats))
+	byMiner := statsOf(stats)
+	for _, block := range []*core.Block{block1, block2, block3} {
+		s := byMiner[byteutils.Hex(block.ConsensusRoot().Proposer)]
+		assert.Equal(t, uint64(1), s.Produced)
+		assert.Equal(t, uint64(0), s.Missed)
+	}
+	assert.Equal(t, uint64(0), byMiner[byteutils.Hex(missedMiner)].Produced)
+	assert.Equal(t, uint64(1), byMiner[byteutils.Hex(missedMiner)].Missed)
+
+	select {
+	case e := <-sub.EventChan():
+		event := new(MissedSlotEvent)
+		assert.Nil(t, json.Unmarshal([]byte(e.Data), event))
+		miner, err := core.AddressParseFromBytes(missedMiner)
+		assert.Nil(t, err)
+		assert.Equal(t, miner.String(), event.Miner)
+		assert.Equal(t, missedSlot, event.Timestamp)
+		assert.Equal(t, block2.Height(), event.Height)
+	case <-time.After(time.Second):
+		t.Error("missed slot event is not triggered")
+	}
+
+	// recorded blocks are not counted again.
+	assert.Nil(t, dpos.recordStats(block1, block3))
+	_, stats, err = dpos.MinerStats(block3.Timestamp())
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), statsOf(stats)[byteutils.Hex(missedMiner)].Missed)
+
+	// the missed slots are counted in the dynasties they belong to.
+	slotsInDynasty := DynastyIntervalInMs / BlockIntervalInMs
+	block4 := mockSlotBlock(t, neb, slotsInDynasty)
+	assert.Nil(t, dpos.recordStats(block3, block4))
+	next, nextStats, err := dpos.MinerStats(block4.Timestamp())
+	assert.Nil(t, err)
+	assert.Equal(t, dynasty+1, next)
+	assert.Equal(t, uint64(1), statsOf(nextStats)[byteutils.Hex(block4.ConsensusRoot().Proposer)].Produced)
+	_, stats, err = dpos.MinerStats(block3.Timestamp())
+	assert.Nil(t, err)
+	missed := uint64(0)
+	for _, s := range append(stats, nextStats...) {
+		missed += s.Missed
+	}
+	assert.Equal(t, uint64(slotsInDynasty), missed)
+}
+
+func TestRecordStatsEmptySeat(t *testing.T) {
+	height := core.ElectionAvailableHeight
+	core.ElectionAvailableHeight = core.LocalElectionAvailableHeight
+	defer func() { core.ElectionAvailableHeight = height }()
+
+	neb := mockNeb(t)
+	dpos := neb.consensus.(*Dpos)
+	genesis := neb.chain.LIB()
+
+	// the proposer of the second slot is disqualified, the next dynasty shrinks.
+	second, err := genesis.WorldState().NextConsensusState(2 * BlockIntervalInMs / SecondInMs)
+	assert.Nil(t, err)
+	proposer, err := core.AddressParseFromBytes(second.Proposer())
+	assert.Nil(t, err)
+	mockEvidenceBlock(t, neb, GetUnlockAddress(t, neb.am, proposer.String()))
+
+	// all the seats of the next dynasty are missed once, up to its empty last seat.
+	slotsInDynasty := DynastyIntervalInMs / BlockIntervalInMs
+	block := mockSlotBlock(t, neb, slotsInDynasty+DynastySize-1)
+	members, err := block.WorldState().Dynasty()
+	assert.Nil(t, err)
+	assert.Equal(t, DynastySize-1, len(members))
+
+	// nobody misses the empty seat.
+	assert.Nil(t, dpos.recordStats(genesis, block))
+	_, stats, err := dpos.MinerStats(block.Timestamp())
+	assert.Nil(t, err)
+	assert.Equal(t, DynastySize-1, len(stats))
+	for _, s := range stats {
+		assert.Equal(t, uint64(1), s.Missed)
+	}
+}
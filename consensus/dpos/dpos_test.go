@@ -179,8 +179,10 @@ func MockGenesisConf() *corepb.Genesis {
 	return &corepb.Genesis{
 		Meta: &corepb.GenesisMeta{ChainId: 0},
 		Consensus: &corepb.GenesisConsensus{
-			Dpos: &corepb.GenesisConsensusDpos{
-				Dynasty: dynasty,
+			Engine: &corepb.GenesisConsensus_Dpos{
+				Dpos: &corepb.GenesisConsensusDpos{
+					Dynasty: dynasty,
+				},
 			},
 		},
 		TokenDistribution: []*corepb.GenesisTokenDistribution{
@@ -0,0 +1,172 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package finality
+
+import (
+	"github.com/gogo/protobuf/proto"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// const
+const (
+	evidenceCacheSize = 128
+)
+
+var (
+	// evidenceGasLimit covers the base gas of tx and the largest evidence payload.
+	evidenceGasLimit, _ = util.NewUint128FromInt(200000)
+)
+
+// Evidences gossips the double mint evidences and submits them on chain from the signer.
+type Evidences struct {
+	engine Engine
+
+	chain *core.BlockChain
+	ns    net.Service
+	am    core.AccountManager
+
+	evidences *lru.Cache
+}
+
+// NewEvidences create Evidences instance.
+func NewEvidences(engine Engine, neblet core.Neblet) (*Evidences, error) {
+	evidences, err := lru.New(evidenceCacheSize)
+	if err != nil {
+		return nil, err
+	}
+	return &Evidences{
+		engine:    engine,
+		chain:     neblet.BlockChain(),
+		ns:        neblet.NetService(),
+		am:        neblet.AccountManager(),
+		evidences: evidences,
+	}, nil
+}
+
+// Known return whether the evidence has been handled.
+func (e *Evidences) Known(hash byteutils.Hash) bool {
+	return e.evidences.Contains(hash.Hex())
+}
+
+// ReportDoubleMint capture the evidence of two blocks minted in the same slot.
+func (e *Evidences) ReportDoubleMint(first *core.Block, second *core.Block) {
+	evidence, err := core.NewDoubleMintEvidence(first, second)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"first":  first,
+			"second": second,
+			"err":    err,
+		}).Debug("Failed to create double mint evidence.")
+		return
+	}
+	if err := e.HandleEvidence(evidence); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"first":  first,
+			"second": second,
+			"err":    err,
+		}).Debug("Failed to handle double mint evidence.")
+	}
+}
+
+// OnEvidenceMessage handle the evidence received from network.
+func (e *Evidences) OnEvidenceMessage(msg net.Message) {
+	pbEvidence := new(corepb.DoubleMintEvidence)
+	if err := proto.Unmarshal(msg.Data(), pbEvidence); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"msgType": msg.MessageType(),
+			"msg":     msg,
+			"err":     err,
+		}).Debug("Failed to unmarshal data.")
+		return
+	}
+	evidence := new(core.DoubleMintEvidence)
+	if err := evidence.FromProto(pbEvidence); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"msgType": msg.MessageType(),
+			"msg":     msg,
+			"err":     err,
+		}).Debug("Failed to recover an evidence from proto data.")
+		return
+	}
+	if err := e.HandleEvidence(evidence); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"msgType": msg.MessageType(),
+			"msg":     msg,
+			"err":     err,
+		}).Debug("Failed to handle double mint evidence.")
+	}
+}
+
+// HandleEvidence verify the evidence, gossip it and submit it on chain if mining.
+func (e *Evidences) HandleEvidence(evidence *core.DoubleMintEvidence) error {
+	if e.evidences.Contains(evidence.Hash().Hex()) {
+		return nil
+	}
+	miner, err := evidence.Verify(e.chain.ChainID())
+	if err != nil {
+		return err
+	}
+	e.evidences.Add(evidence.Hash().Hex(), evidence)
+
+	logging.CLog().WithFields(logrus.Fields{
+		"miner":     miner,
+		"timestamp": evidence.Timestamp(),
+		"blocks":    evidence.Blocks(),
+	}).Warn("Found double mint evidence.")
+
+	e.ns.Relay(core.MessageTypeDoubleMintEvidence, evidence, net.MessagePriorityNormal)
+
+	if signer := e.engine.Signer(); signer != nil {
+		return e.submitEvidence(signer, evidence)
+	}
+	return nil
+}
+
+// submitEvidence send an evidence tx from the signer, after its txs pending in pool.
+func (e *Evidences) submitEvidence(signer *core.Address, evidence *core.DoubleMintEvidence) error {
+	payload, err := core.NewEvidencePayload(evidence)
+	if err != nil {
+		return err
+	}
+	data, err := payload.ToBytes()
+	if err != nil {
+		return err
+	}
+	acc, err := e.chain.TailBlock().GetAccount(signer.Bytes())
+	if err != nil {
+		return err
+	}
+	pool := e.chain.TransactionPool()
+	tx, err := core.NewTransaction(e.chain.ChainID(), signer, signer, util.NewUint128(), pool.NextNonce(signer, acc.Nonce()),
+		core.TxPayloadEvidenceType, data, core.TransactionGasPrice, evidenceGasLimit)
+	if err != nil {
+		return err
+	}
+	if err := e.am.SignTransaction(signer, tx); err != nil {
+		return err
+	}
+	return pool.PushAndBroadcast(tx)
+}
@@ -0,0 +1,374 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package finality
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// const
+const (
+	votesCacheSize = 128
+)
+
+// Errors in finality
+var (
+	ErrUnknownPreCommitBlock = errors.New("the block of pre-commit is not found")
+	ErrInvalidPreCommitVoter = errors.New("the voter of pre-commit is not a member of block's dynasty")
+)
+
+// Engine is the consensus engine finalizing blocks by pre-commits.
+type Engine interface {
+	// Signer return the miner signing pre-commits and evidences on this node, nil if none.
+	Signer() *core.Address
+
+	// FinalitySize return the number of pre-commits from the dynasty to finalize a block.
+	FinalitySize(dynasty []byteutils.Hash) int
+
+	// UpdateLIB update the latest irreversible block.
+	UpdateLIB()
+}
+
+// FinalitySizeOf return the pre-commits needed to finalize a block among the dynasty,
+// more than 2/3 of the members.
+func FinalitySizeOf(dynasty int) int {
+	return dynasty*2/3 + 1
+}
+
+// SignPreCommit return the pre-commit of block signed by the signer.
+func SignPreCommit(am core.AccountManager, signer *core.Address, blockHash byteutils.Hash) (*core.PreCommit, error) {
+	sign, err := am.SignHash(signer, core.PreCommitHash(blockHash), keystore.SECP256K1)
+	if err != nil {
+		return nil, err
+	}
+	vote := core.NewPreCommit(blockHash)
+	vote.SetSignature(keystore.SECP256K1, sign)
+	return vote, nil
+}
+
+// IsMember return whether the addr is a member of the dynasty.
+func IsMember(dynasty []byteutils.Hash, addr *core.Address) bool {
+	for _, member := range dynasty {
+		if member.Equals(addr.Bytes()) {
+			return true
+		}
+	}
+	return false
+}
+
+// Finality collects the pre-commits of dynasty members, a block is finalized
+// once the engine's finality size of its dynasty signed it.
+type Finality struct {
+	engine Engine
+
+	chain *core.BlockChain
+	ns    net.Service
+	am    core.AccountManager
+
+	votes        *lru.Cache
+	pendingVotes *lru.Cache
+	votesLock    sync.Mutex
+	preCommits   storage.Storage
+}
+
+// NewFinality create Finality instance.
+func NewFinality(engine Engine, neblet core.Neblet) (*Finality, error) {
+	votes, err := lru.New(votesCacheSize)
+	if err != nil {
+		return nil, err
+	}
+	pendingVotes, err := lru.New(votesCacheSize)
+	if err != nil {
+		return nil, err
+	}
+	preCommits, err := storage.OpenKeyspace(neblet.Storage(), storage.PreCommitKeyspace)
+	if err != nil {
+		return nil, err
+	}
+	return &Finality{
+		engine:       engine,
+		chain:        neblet.BlockChain(),
+		ns:           neblet.NetService(),
+		am:           neblet.AccountManager(),
+		votes:        votes,
+		pendingVotes: pendingVotes,
+		preCommits:   preCommits,
+	}, nil
+}
+
+// LastPreCommitHeight return the height of the latest block pre-committed by the miner on this node, 0 if none.
+func (f *Finality) LastPreCommitHeight(miner *core.Address) (uint64, error) {
+	value, err := f.preCommits.Get(miner.Bytes())
+	if err != nil {
+		if err == storage.ErrKeyNotFound {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return byteutils.Uint64(value), nil
+}
+
+// PreCommitCount return the number of pre-commits collected for the block.
+func (f *Finality) PreCommitCount(blockHash byteutils.Hash) int {
+	return f.count(f.votes, blockHash)
+}
+
+// PendingPreCommitCount return the number of pre-commits kept for the unknown block.
+func (f *Finality) PendingPreCommitCount(blockHash byteutils.Hash) int {
+	return f.count(f.pendingVotes, blockHash)
+}
+
+func (f *Finality) count(cache *lru.Cache, blockHash byteutils.Hash) int {
+	f.votesLock.Lock()
+	defer f.votesLock.Unlock()
+
+	if v, ok := cache.Get(blockHash.Hex()); ok {
+		return len(v.(map[byteutils.HexHash]*core.PreCommit))
+	}
+	return 0
+}
+
+// PreCommit sign and gossip the pre-commit of block if the signer is a member of its dynasty.
+// A miner never signs two blocks at the same height, the height is recorded before signing
+// so that it survives restarts.
+func (f *Finality) PreCommit(block *core.Block) {
+	miner := f.engine.Signer()
+	if miner == nil {
+		return
+	}
+	last, err := f.LastPreCommitHeight(miner)
+	if err != nil || block.Height() <= last {
+		return
+	}
+	dynasty, err := block.WorldState().Dynasty()
+	if err != nil || !IsMember(dynasty, miner) {
+		return
+	}
+	if err := f.preCommits.Put(miner.Bytes(), byteutils.FromUint64(block.Height())); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"block": block,
+			"err":   err,
+		}).Debug("Failed to record the pre-committed height.")
+		return
+	}
+
+	vote, err := SignPreCommit(f.am, miner, block.Hash())
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"block": block,
+			"err":   err,
+		}).Debug("Failed to sign pre-commit.")
+		return
+	}
+
+	if err := f.HandlePreCommit(vote); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"block": block,
+			"err":   err,
+		}).Debug("Failed to handle pre-commit.")
+	}
+}
+
+// OnPreCommitMessage handle the pre-commit received from network.
+func (f *Finality) OnPreCommitMessage(msg net.Message) {
+	pbVote := new(corepb.PreCommit)
+	if err := proto.Unmarshal(msg.Data(), pbVote); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"msgType": msg.MessageType(),
+			"msg":     msg,
+			"err":     err,
+		}).Debug("Failed to unmarshal data.")
+		return
+	}
+	vote := new(core.PreCommit)
+	if err := vote.FromProto(pbVote); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"msgType": msg.MessageType(),
+			"msg":     msg,
+			"err":     err,
+		}).Debug("Failed to recover a pre-commit from proto data.")
+		return
+	}
+	if err := f.HandlePreCommit(vote); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"msgType": msg.MessageType(),
+			"msg":     msg,
+			"err":     err,
+		}).Debug("Failed to handle pre-commit.")
+	}
+}
+
+// HandlePreCommit collect the pre-commit, the block is finalized once finality size members signed it.
+// The pre-commits of unknown block are kept until the block arrives.
+func (f *Finality) HandlePreCommit(vote *core.PreCommit) error {
+	signer, err := vote.Signer()
+	if err != nil {
+		return err
+	}
+	block := f.chain.GetBlock(vote.BlockHash())
+	if block == nil {
+		return f.holdPreCommit(vote, signer)
+	}
+	dynasty, err := block.WorldState().Dynasty()
+	if err != nil {
+		return err
+	}
+	if !IsMember(dynasty, signer) {
+		return ErrInvalidPreCommitVoter
+	}
+
+	f.votesLock.Lock()
+	var votes map[byteutils.HexHash]*core.PreCommit
+	if v, ok := f.votes.Get(vote.BlockHash().Hex()); ok {
+		votes = v.(map[byteutils.HexHash]*core.PreCommit)
+	} else {
+		votes = make(map[byteutils.HexHash]*core.PreCommit)
+		f.votes.Add(vote.BlockHash().Hex(), votes)
+	}
+	voter := byteutils.Hash(signer.Bytes()).Hex()
+	if _, ok := votes[voter]; ok {
+		f.votesLock.Unlock()
+		return nil
+	}
+	votes[voter] = vote
+	var collected []*core.PreCommit
+	if len(votes) == f.engine.FinalitySize(dynasty) {
+		for _, v := range votes {
+			collected = append(collected, v)
+		}
+	}
+	f.votesLock.Unlock()
+
+	f.ns.Relay(core.MessageTypePreCommit, vote, net.MessagePriorityNormal)
+
+	if collected == nil {
+		return nil
+	}
+	proof, err := core.NewFinalityProof(block.Hash(), collected)
+	if err != nil {
+		return err
+	}
+	if err := f.chain.StoreFinalityProof(proof); err != nil {
+		return err
+	}
+	logging.VLog().WithFields(logrus.Fields{
+		"block": block,
+		"votes": len(collected),
+	}).Info("Block is finalized by pre-commits.")
+
+	f.engine.UpdateLIB()
+	return nil
+}
+
+// holdPreCommit keep the pre-commit of unknown block, at most as many voters
+// as the dynasty of tail for each block.
+func (f *Finality) holdPreCommit(vote *core.PreCommit, signer *core.Address) error {
+	dynasty, err := f.chain.TailBlock().WorldState().Dynasty()
+	if err != nil {
+		return err
+	}
+
+	f.votesLock.Lock()
+	defer f.votesLock.Unlock()
+
+	var votes map[byteutils.HexHash]*core.PreCommit
+	if v, ok := f.pendingVotes.Get(vote.BlockHash().Hex()); ok {
+		votes = v.(map[byteutils.HexHash]*core.PreCommit)
+	} else {
+		votes = make(map[byteutils.HexHash]*core.PreCommit)
+		f.pendingVotes.Add(vote.BlockHash().Hex(), votes)
+	}
+	voter := byteutils.Hash(signer.Bytes()).Hex()
+	if _, ok := votes[voter]; !ok && len(votes) >= len(dynasty) {
+		return ErrUnknownPreCommitBlock
+	}
+	votes[voter] = vote
+	return nil
+}
+
+// ReplayPreCommits handle the pending pre-commits whose blocks have arrived.
+func (f *Finality) ReplayPreCommits() {
+	var replay []*core.PreCommit
+	f.votesLock.Lock()
+	for _, key := range f.pendingVotes.Keys() {
+		hash, err := byteutils.FromHex(string(key.(byteutils.HexHash)))
+		if err != nil || f.chain.GetBlock(hash) == nil {
+			continue
+		}
+		if v, ok := f.pendingVotes.Get(key); ok {
+			for _, vote := range v.(map[byteutils.HexHash]*core.PreCommit) {
+				replay = append(replay, vote)
+			}
+		}
+		f.pendingVotes.Remove(key)
+	}
+	f.votesLock.Unlock()
+
+	for _, vote := range replay {
+		if err := f.HandlePreCommit(vote); err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"block": vote.BlockHash(),
+				"err":   err,
+			}).Debug("Failed to handle pending pre-commit.")
+		}
+	}
+}
+
+// NextLIB find the highest block on canonical chain with finality proof above the LIB
+// and store it as the LIB, the engine sets it to chain. Return nil if none.
+func (f *Finality) NextLIB() *core.Block {
+	lib := f.chain.LIB()
+	tail := f.chain.TailBlock()
+	cur := tail
+	for !cur.Hash().Equals(lib.Hash()) && cur.Height() > lib.Height() {
+		if _, err := f.chain.GetFinalityProof(cur.Hash()); err == nil {
+			if err := f.chain.StoreLIBHashToStorage(cur); err != nil {
+				logging.VLog().WithFields(logrus.Fields{
+					"tail": tail,
+					"lib":  cur,
+				}).Debug("Failed to store latest irreversible block.")
+				return nil
+			}
+			logging.CLog().WithFields(logrus.Fields{
+				"lib.new": cur,
+				"lib.old": lib,
+				"tail":    tail,
+			}).Info("Succeed to update latest irreversible block.")
+			return cur
+		}
+
+		cur = f.chain.GetBlock(cur.ParentHash())
+		if cur == nil || core.CheckGenesisBlock(cur) {
+			return nil
+		}
+	}
+	return nil
+}
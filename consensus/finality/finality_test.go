@@ -0,0 +1,103 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package finality
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockMember(t *testing.T) (*core.Address, keystore.PrivateKey) {
+	priv := secp256k1.GeneratePrivateKey()
+	pub, err := priv.PublicKey().Encoded()
+	assert.Nil(t, err)
+	addr, err := core.NewAddressFromPublicKey(pub)
+	assert.Nil(t, err)
+	return addr, priv
+}
+
+func mockPreCommit(t *testing.T, priv keystore.PrivateKey, blockHash byteutils.Hash) *core.PreCommit {
+	signature, err := crypto.NewSignature(keystore.SECP256K1)
+	assert.Nil(t, err)
+	assert.Nil(t, signature.InitSign(priv))
+	sign, err := signature.Sign(core.PreCommitHash(blockHash))
+	assert.Nil(t, err)
+	vote := core.NewPreCommit(blockHash)
+	vote.SetSignature(keystore.SECP256K1, sign)
+	return vote
+}
+
+func TestFinalitySizeOf(t *testing.T) {
+	tests := []struct {
+		dynasty int
+		size    int
+	}{
+		{1, 1},
+		{3, 3},
+		{4, 3},
+		{6, 5},
+		{7, 5},
+		{21, 15},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.size, FinalitySizeOf(tt.dynasty), "dynasty %d", tt.dynasty)
+	}
+}
+
+func TestVerifyFinalityProof(t *testing.T) {
+	blockHash := hash.Sha3256([]byte("block"))
+	dynasty := []byteutils.Hash{}
+	votes := []*core.PreCommit{}
+	for i := 0; i < 4; i++ {
+		addr, priv := mockMember(t)
+		assert.False(t, IsMember(dynasty, addr))
+		dynasty = append(dynasty, addr.Bytes())
+		assert.True(t, IsMember(dynasty, addr))
+		votes = append(votes, mockPreCommit(t, priv, blockHash))
+	}
+	size := FinalitySizeOf(len(dynasty))
+
+	proof, err := core.NewFinalityProof(blockHash, votes[:size])
+	assert.Nil(t, err)
+	assert.Nil(t, core.VerifyFinalityProof(proof, dynasty, size))
+	assert.Equal(t, core.ErrInvalidFinalityProof, core.VerifyFinalityProof(proof, dynasty, size+1))
+
+	// the votes of the same member are counted once.
+	proof, err = core.NewFinalityProof(blockHash, []*core.PreCommit{votes[0], votes[0], votes[1]})
+	assert.Nil(t, err)
+	assert.Equal(t, core.ErrInvalidFinalityProof, core.VerifyFinalityProof(proof, dynasty, size))
+
+	// the votes of outsiders fail the proof.
+	_, priv := mockMember(t)
+	proof, err = core.NewFinalityProof(blockHash, append(votes[:size:size], mockPreCommit(t, priv, blockHash)))
+	assert.Nil(t, err)
+	assert.Equal(t, core.ErrInvalidFinalityProof, core.VerifyFinalityProof(proof, dynasty, size))
+
+	// the votes of other blocks are not aggregated.
+	other := mockPreCommit(t, priv, hash.Sha3256([]byte("other")))
+	_, err = core.NewFinalityProof(blockHash, append(votes[:size:size], other))
+	assert.Equal(t, core.ErrInvalidFinalityProof, err)
+}
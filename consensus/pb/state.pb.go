@@ -9,6 +9,7 @@ It is generated from these files:
 
 It has these top-level messages:
 	ConsensusRoot
+	MinerStats
 */
 package consensuspb
 
@@ -28,9 +29,15 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 type ConsensusRoot struct {
-	Timestamp   int64  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Proposer    []byte `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
-	DynastyRoot []byte `protobuf:"bytes,3,opt,name=dynasty_root,json=dynastyRoot,proto3" json:"dynasty_root,omitempty"`
+	Timestamp        int64  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Proposer         []byte `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	DynastyRoot      []byte `protobuf:"bytes,3,opt,name=dynasty_root,json=dynastyRoot,proto3" json:"dynasty_root,omitempty"`
+	CandidateRoot    []byte `protobuf:"bytes,4,opt,name=candidate_root,json=candidateRoot,proto3" json:"candidate_root,omitempty"`
+	VoteRoot         []byte `protobuf:"bytes,5,opt,name=vote_root,json=voteRoot,proto3" json:"vote_root,omitempty"`
+	DisqualifiedRoot []byte `protobuf:"bytes,6,opt,name=disqualified_root,json=disqualifiedRoot,proto3" json:"disqualified_root,omitempty"`
+	ProposalRoot     []byte `protobuf:"bytes,7,opt,name=proposal_root,json=proposalRoot,proto3" json:"proposal_root,omitempty"`
+	DepositRoot      []byte `protobuf:"bytes,8,opt,name=deposit_root,json=depositRoot,proto3" json:"deposit_root,omitempty"`
+	UnbondingRoot    []byte `protobuf:"bytes,9,opt,name=unbonding_root,json=unbondingRoot,proto3" json:"unbonding_root,omitempty"`
 }
 
 func (m *ConsensusRoot) Reset()                    { *m = ConsensusRoot{} }
@@ -59,21 +66,113 @@ func (m *ConsensusRoot) GetDynastyRoot() []byte {
 	return nil
 }
 
+func (m *ConsensusRoot) GetCandidateRoot() []byte {
+	if m != nil {
+		return m.CandidateRoot
+	}
+	return nil
+}
+
+func (m *ConsensusRoot) GetVoteRoot() []byte {
+	if m != nil {
+		return m.VoteRoot
+	}
+	return nil
+}
+
+func (m *ConsensusRoot) GetDisqualifiedRoot() []byte {
+	if m != nil {
+		return m.DisqualifiedRoot
+	}
+	return nil
+}
+
+func (m *ConsensusRoot) GetProposalRoot() []byte {
+	if m != nil {
+		return m.ProposalRoot
+	}
+	return nil
+}
+
+func (m *ConsensusRoot) GetDepositRoot() []byte {
+	if m != nil {
+		return m.DepositRoot
+	}
+	return nil
+}
+
+func (m *ConsensusRoot) GetUnbondingRoot() []byte {
+	if m != nil {
+		return m.UnbondingRoot
+	}
+	return nil
+}
+
+type MinerStats struct {
+	Miner    []byte `protobuf:"bytes,1,opt,name=miner,proto3" json:"miner,omitempty"`
+	Dynasty  int64  `protobuf:"varint,2,opt,name=dynasty,proto3" json:"dynasty,omitempty"`
+	Produced uint64 `protobuf:"varint,3,opt,name=produced,proto3" json:"produced,omitempty"`
+	Missed   uint64 `protobuf:"varint,4,opt,name=missed,proto3" json:"missed,omitempty"`
+}
+
+func (m *MinerStats) Reset()                    { *m = MinerStats{} }
+func (m *MinerStats) String() string            { return proto.CompactTextString(m) }
+func (*MinerStats) ProtoMessage()               {}
+func (*MinerStats) Descriptor() ([]byte, []int) { return fileDescriptorState, []int{1} }
+
+func (m *MinerStats) GetMiner() []byte {
+	if m != nil {
+		return m.Miner
+	}
+	return nil
+}
+
+func (m *MinerStats) GetDynasty() int64 {
+	if m != nil {
+		return m.Dynasty
+	}
+	return 0
+}
+
+func (m *MinerStats) GetProduced() uint64 {
+	if m != nil {
+		return m.Produced
+	}
+	return 0
+}
+
+func (m *MinerStats) GetMissed() uint64 {
+	if m != nil {
+		return m.Missed
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ConsensusRoot)(nil), "consensuspb.ConsensusRoot")
+	proto.RegisterType((*MinerStats)(nil), "consensuspb.MinerStats")
 }
 
 func init() { proto.RegisterFile("state.proto", fileDescriptorState) }
 
 var fileDescriptorState = []byte{
-	// 134 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x2e, 0x2e, 0x49, 0x2c,
-	0x49, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x4e, 0xce, 0xcf, 0x2b, 0x4e, 0xcd, 0x2b,
-	0x2e, 0x2d, 0x2e, 0x48, 0x52, 0xca, 0xe1, 0xe2, 0x75, 0x86, 0x71, 0x83, 0xf2, 0xf3, 0x4b, 0x84,
-	0x64, 0xb8, 0x38, 0x4b, 0x32, 0x73, 0x53, 0x8b, 0x4b, 0x12, 0x73, 0x0b, 0x24, 0x18, 0x15, 0x18,
-	0x35, 0x98, 0x83, 0x10, 0x02, 0x42, 0x52, 0x5c, 0x1c, 0x05, 0x45, 0xf9, 0x05, 0xf9, 0xc5, 0xa9,
-	0x45, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0x3c, 0x41, 0x70, 0xbe, 0x90, 0x22, 0x17, 0x4f, 0x4a, 0x65,
-	0x5e, 0x62, 0x71, 0x49, 0x65, 0x7c, 0x51, 0x7e, 0x7e, 0x89, 0x04, 0x33, 0x58, 0x9e, 0x1b, 0x2a,
-	0x06, 0x32, 0x3c, 0x89, 0x0d, 0xec, 0x02, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x27, 0xd5,
-	0xcf, 0x41, 0x90, 0x00, 0x00, 0x00,
+	// 285 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xdf, 0x4a, 0xf3, 0x40,
+	0x10, 0xc5, 0xe9, 0xff, 0x76, 0x9a, 0x7e, 0x7c, 0x2e, 0x22, 0x41, 0xbd, 0xa8, 0x15, 0xa1, 0x20,
+	0x78, 0xe3, 0x23, 0x78, 0xed, 0xcd, 0xfa, 0x00, 0xb2, 0xcd, 0xae, 0xb2, 0xd0, 0xec, 0xac, 0x99,
+	0x49, 0xa1, 0xef, 0xe8, 0x43, 0x49, 0x66, 0x37, 0xd1, 0xcb, 0xdf, 0x99, 0x1f, 0xc9, 0xe1, 0x2c,
+	0xac, 0x89, 0x0d, 0xbb, 0xa7, 0xd8, 0x20, 0xa3, 0x5a, 0x57, 0x18, 0xc8, 0x05, 0x6a, 0x29, 0x1e,
+	0x76, 0xdf, 0x63, 0xd8, 0xbc, 0xf4, 0xac, 0x11, 0x59, 0xdd, 0xc2, 0x8a, 0x7d, 0xed, 0x88, 0x4d,
+	0x1d, 0xcb, 0xd1, 0x76, 0xb4, 0x9f, 0xe8, 0xdf, 0x40, 0x5d, 0xc3, 0x32, 0x36, 0x18, 0x91, 0x5c,
+	0x53, 0x8e, 0xb7, 0xa3, 0x7d, 0xa1, 0x07, 0x56, 0x77, 0x50, 0xd8, 0x73, 0x30, 0xc4, 0xe7, 0xf7,
+	0x06, 0x91, 0xcb, 0x89, 0xdc, 0xd7, 0x39, 0x93, 0x8f, 0x3f, 0xc0, 0xbf, 0xca, 0x04, 0xeb, 0xad,
+	0x61, 0x97, 0xa4, 0xa9, 0x48, 0x9b, 0x21, 0x15, 0xed, 0x06, 0x56, 0x27, 0xec, 0x8d, 0x59, 0xfa,
+	0xcd, 0x09, 0xf3, 0xf1, 0x11, 0x2e, 0xac, 0xa7, 0xaf, 0xd6, 0x1c, 0xfd, 0x87, 0x77, 0x36, 0x49,
+	0x73, 0x91, 0xfe, 0xff, 0x3d, 0x88, 0x7c, 0x0f, 0x9b, 0xd4, 0xcf, 0x1c, 0x93, 0xb8, 0x10, 0xb1,
+	0xe8, 0x43, 0x91, 0xba, 0xe2, 0x2e, 0x22, 0x79, 0x4e, 0xce, 0x32, 0x17, 0x4f, 0x59, 0x5f, 0xbc,
+	0x0d, 0x07, 0x0c, 0xd6, 0x87, 0xcf, 0x24, 0xad, 0x52, 0xf1, 0x21, 0xed, 0xb4, 0x5d, 0x04, 0x78,
+	0xf5, 0xc1, 0x35, 0x6f, 0x6c, 0x98, 0xd4, 0x25, 0xcc, 0xea, 0x8e, 0x64, 0xc6, 0x42, 0x27, 0x50,
+	0x25, 0x2c, 0xf2, 0x24, 0xb2, 0xe0, 0x44, 0xf7, 0x98, 0xc7, 0xb5, 0x6d, 0xe5, 0xac, 0x8c, 0x37,
+	0xd5, 0x03, 0xab, 0x2b, 0x98, 0xd7, 0x9e, 0xc8, 0x59, 0x59, 0x6c, 0xaa, 0x33, 0x1d, 0xe6, 0xf2,
+	0xa8, 0xcf, 0x3f, 0x03, 0x00, 0x5f, 0x54, 0x1c, 0xc5, 0xe3, 0x01, 0x00, 0x00,
 }
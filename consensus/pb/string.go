@@ -26,9 +26,11 @@ import (
 
 // ToString return a string of consensus root
 func (m *ConsensusRoot) ToString() string {
-	return fmt.Sprintf(`{"proposer": %s, "timestamp": "%d", "dynasty": "%s"}`,
+	return fmt.Sprintf(`{"proposer": %s, "timestamp": "%d", "dynasty": "%s", "candidate": "%s", "vote": "%s"}`,
 		byteutils.Hex(m.Proposer),
 		m.Timestamp,
 		byteutils.Hex(m.DynastyRoot),
+		byteutils.Hex(m.CandidateRoot),
+		byteutils.Hex(m.VoteRoot),
 	)
 }
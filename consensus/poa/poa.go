@@ -0,0 +1,471 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package poa
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/nebulasio/go-nebulas/consensus/finality"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// const
+const (
+	DefaultMaxUnlockDuration time.Duration = 1<<63 - 1
+
+	slotCacheSize = 128
+)
+
+// Errors in PoA Consensus
+var (
+	ErrInvalidBlockTimestamp      = errors.New("invalid block timestamp, should be same as consensus's timestamp")
+	ErrInvalidBlockInterval       = errors.New("invalid block interval")
+	ErrInvalidBlockProposer       = errors.New("invalid block proposer")
+	ErrCannotMintWhenPending      = errors.New("cannot mint block now, waiting for cancel pending again")
+	ErrCannotMintWhenDisable      = errors.New("cannot mint block now, waiting for enable it again")
+	ErrWaitingForNextSlot         = errors.New("cannot mint block now, waiting for next slot")
+	ErrBlockMintedInNextSlot      = errors.New("cannot mint block now, there is a block minted in current slot")
+	ErrGenerateNextConsensusState = errors.New("Failed to generate next consensus state")
+	ErrAppendNewBlockFailed       = errors.New("failed to append new block to real chain")
+)
+
+// Poa Proof-of-Authority, a fixed set of validators managed by governance transactions
+// take turns to propose blocks, and blocks are finalized by the pre-commits of validators.
+type Poa struct {
+	quitCh chan bool
+
+	chain *core.BlockChain
+	ns    net.Service
+	am    core.AccountManager
+
+	params            *Params
+	genesisValidators uint64
+
+	coinbase *core.Address
+	miner    *core.Address
+
+	slot      *lru.Cache
+	messageCh chan net.Message
+
+	finality *finality.Finality
+	libLock  sync.Mutex
+
+	enable  bool
+	pending bool
+}
+
+// NewPoa create Poa instance.
+func NewPoa() *Poa {
+	poa := &Poa{
+		quitCh:    make(chan bool, 5),
+		messageCh: make(chan net.Message, 128),
+		params:    DefaultParams(),
+		enable:    false,
+		pending:   true,
+	}
+	return poa
+}
+
+// Setup a poa consensus handler
+func (poa *Poa) Setup(neblet core.Neblet) error {
+	poa.chain = neblet.BlockChain()
+	poa.ns = neblet.NetService()
+	poa.am = neblet.AccountManager()
+
+	params, err := LoadParams(neblet.Genesis().GetConsensus().GetPoa())
+	if err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Error("Failed to load poa params from genesis.")
+		return err
+	}
+	poa.params = params
+	poa.genesisValidators = uint64(len(neblet.Genesis().GetConsensus().GetPoa().Validators))
+
+	chainConfig := neblet.Config().Chain
+	if chainConfig.StartMine {
+		coinbase, err := core.AddressParse(chainConfig.Coinbase)
+		if err != nil {
+			logging.CLog().WithFields(logrus.Fields{
+				"address": chainConfig.Coinbase,
+				"err":     err,
+			}).Error("Failed to parse coinbase address.")
+			return err
+		}
+		miner, err := core.AddressParse(chainConfig.Miner)
+		if err != nil {
+			logging.CLog().WithFields(logrus.Fields{
+				"address": chainConfig.Miner,
+				"err":     err,
+			}).Error("Failed to parse miner address.")
+			return err
+		}
+		poa.coinbase = coinbase
+		poa.miner = miner
+	}
+
+	slot, err := lru.New(slotCacheSize)
+	if err != nil {
+		return err
+	}
+	poa.slot = slot
+
+	if poa.finality, err = finality.NewFinality(poa, neblet); err != nil {
+		return err
+	}
+	poa.RegisterInNetwork(poa.ns)
+	return nil
+}
+
+// Start start poa service.
+func (poa *Poa) Start() {
+	logging.CLog().Info("Starting Poa Mining...")
+	go poa.blockLoop()
+}
+
+// Stop stop poa service.
+func (poa *Poa) Stop() {
+	logging.CLog().Info("Stopping Poa Mining...")
+	poa.DisableMining()
+	poa.quitCh <- true
+}
+
+// EnableMining start the consensus
+func (poa *Poa) EnableMining(passphrase string) error {
+	if err := poa.am.Unlock(poa.miner, []byte(passphrase), DefaultMaxUnlockDuration); err != nil {
+		return err
+	}
+	poa.enable = true
+	logging.CLog().Info("Enabled Poa Mining...")
+	return nil
+}
+
+// DisableMining stop the consensus
+func (poa *Poa) DisableMining() error {
+	if err := poa.am.Lock(poa.miner); err != nil {
+		return err
+	}
+	poa.enable = false
+	logging.CLog().Info("Disable Poa Mining...")
+	return nil
+}
+
+// Enable returns is mining
+func (poa *Poa) Enable() bool {
+	return poa.enable
+}
+
+// Pending return if consensus can do mining now
+func (poa *Poa) Pending() bool {
+	return poa.pending
+}
+
+// SuspendMining pend poa mining
+func (poa *Poa) SuspendMining() {
+	logging.CLog().Info("Suspended Poa Mining.")
+	poa.pending = true
+}
+
+// ResumeMining continue poa mining
+func (poa *Poa) ResumeMining() {
+	logging.CLog().Info("Resumed Poa Mining.")
+	poa.pending = false
+}
+
+func less(a *core.Block, b *core.Block) bool {
+	if a.Height() != b.Height() {
+		return a.Height() < b.Height()
+	}
+	return byteutils.Less(a.Hash(), b.Hash())
+}
+
+// ForkChoice select the highest block as new tail, and pre-commit it if the miner is a validator
+func (poa *Poa) ForkChoice() error {
+	// the pre-commits arrived before their blocks are counted once the blocks are linked.
+	poa.finality.ReplayPreCommits()
+
+	bc := poa.chain
+	tailBlock := bc.TailBlock()
+	newTailBlock := tailBlock
+	for _, v := range bc.DetachedTailBlocks() {
+		if less(newTailBlock, v) {
+			newTailBlock = v
+		}
+	}
+
+	if newTailBlock.Hash().Equals(tailBlock.Hash()) {
+		logging.VLog().WithFields(logrus.Fields{
+			"old tail": tailBlock,
+			"new tail": newTailBlock,
+		}).Debug("Current tail is best, no need to change.")
+		return nil
+	}
+
+	if err := bc.SetTailBlock(newTailBlock); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"new tail": newTailBlock,
+			"old tail": tailBlock,
+			"err":      err,
+		}).Debug("Failed to set new tail block.")
+		return err
+	}
+
+	logging.VLog().WithFields(logrus.Fields{
+		"new tail": newTailBlock,
+		"old tail": tailBlock,
+	}).Info("change to new tail.")
+
+	poa.finality.PreCommit(newTailBlock)
+	return nil
+}
+
+// UpdateLIB set the LIB to the highest block on canonical chain with finality proof
+func (poa *Poa) UpdateLIB() {
+	poa.libLock.Lock()
+	defer poa.libLock.Unlock()
+
+	if lib := poa.finality.NextLIB(); lib != nil {
+		poa.setLIB(lib)
+	}
+}
+
+// VerifyBlock verify the block is signed by the proposer of its slot, the proposer
+// itself is checked against the validators when the consensus root is verified.
+func (poa *Poa) VerifyBlock(block *core.Block) error {
+	if block.Timestamp() != block.ConsensusRoot().Timestamp {
+		return ErrInvalidBlockTimestamp
+	}
+	elapsedSecondInMs := block.Timestamp() * SecondInMs
+	if elapsedSecondInMs <= 0 || elapsedSecondInMs%poa.params.BlockIntervalInMs != 0 {
+		return ErrInvalidBlockInterval
+	}
+	proposer, err := core.AddressParseFromBytes(block.ConsensusRoot().Proposer)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"proposer": block.ConsensusRoot().Proposer,
+			"err":      err,
+			"block":    block,
+		}).Debug("Failed to parse proposer.")
+		return ErrInvalidBlockProposer
+	}
+	signer, err := core.RecoverSignerFromSignature(block.Alg(), block.Hash(), block.Signature())
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err":   err,
+			"block": block,
+		}).Debug("Failed to recover block's miner.")
+		return err
+	}
+	if !proposer.Equals(signer) {
+		logging.VLog().WithFields(logrus.Fields{
+			"signer":   signer,
+			"proposer": proposer,
+			"block":    block,
+		}).Debug("Failed to verify block's sign.")
+		return ErrInvalidBlockProposer
+	}
+
+	if block.Height() >= core.RandomAvailableHeight && !block.HasRandomSeed() {
+		logging.VLog().WithFields(logrus.Fields{
+			"blockHeight":      block.Height(),
+			"compatibleHeight": core.RandomAvailableHeight,
+		}).Debug("No random found in block header.")
+		return core.ErrInvalidBlockRandom
+	}
+
+	poa.slot.Add(block.Timestamp(), block)
+	return nil
+}
+
+// CheckDoubleMint if double mint exists
+func (poa *Poa) CheckDoubleMint(block *core.Block) bool {
+	if preBlock, exist := poa.slot.Get(block.Timestamp()); exist {
+		if !preBlock.(*core.Block).Hash().Equals(block.Hash()) {
+			logging.VLog().WithFields(logrus.Fields{
+				"curBlock": block,
+				"preBlock": preBlock.(*core.Block),
+			}).Warn("Found someone minted multiple blocks at same time.")
+			return true
+		}
+	}
+	return false
+}
+
+// NumberOfBlocksInDynasty number of blocks in one round of the genesis validators,
+// it's fixed so that the random seed input of a block never changes with the validators.
+func (poa *Poa) NumberOfBlocksInDynasty() uint64 {
+	return poa.genesisValidators
+}
+
+func (poa *Poa) nextSlot(nowInMs int64) int64 {
+	blockIntervalInMs := poa.params.BlockIntervalInMs
+	return int64((nowInMs+blockIntervalInMs-SecondInMs)/blockIntervalInMs) * blockIntervalInMs
+}
+
+func (poa *Poa) checkProposer(tail *core.Block, slotInMs int64) (state.ConsensusState, error) {
+	elapsedInMs := slotInMs - tail.Timestamp()*SecondInMs
+	consensusState, err := tail.WorldState().NextConsensusState(elapsedInMs / SecondInMs)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"tail":    tail,
+			"elapsed": elapsedInMs,
+			"err":     err,
+		}).Debug("Failed to generate next consensus state.")
+		return nil, ErrGenerateNextConsensusState
+	}
+	if !consensusState.Proposer().Equals(poa.miner.Bytes()) {
+		logging.VLog().WithFields(logrus.Fields{
+			"tail":     tail,
+			"slot":     slotInMs,
+			"expected": consensusState.Proposer().Base58(),
+			"actual":   poa.miner,
+		}).Debug("Not my turn, waiting...")
+		return nil, ErrInvalidBlockProposer
+	}
+	return consensusState, nil
+}
+
+func (poa *Poa) newBlock(tail *core.Block, consensusState state.ConsensusState, deadlineInMs int64) (*core.Block, error) {
+	block, err := core.NewBlock(poa.chain.ChainID(), poa.coinbase, tail)
+	if err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"tail":     tail,
+			"coinbase": poa.coinbase,
+			"chainid":  poa.chain.ChainID(),
+			"err":      err,
+		}).Error("Failed to create new block")
+		return nil, err
+	}
+	if block.Height() >= core.RandomAvailableHeight {
+		ancestorHash, parentSeed, err := poa.chain.GetInputForVRFSigner(block.ParentHash(), block.Height())
+		if err != nil {
+			return nil, err
+		}
+		vrfSeed, vrfProof, err := poa.am.GenerateRandomSeed(poa.miner, ancestorHash, parentSeed)
+		if err != nil {
+			return nil, err
+		}
+		block.SetRandomSeed(vrfSeed, vrfProof)
+	}
+
+	block.WorldState().SetConsensusState(consensusState)
+	block.SetTimestamp(consensusState.TimeStamp())
+	block.CollectTransactions(deadlineInMs)
+	if err := block.Seal(); err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"block": block,
+			"err":   err,
+		}).Error("Failed to seal new block")
+		go block.ReturnTransactions()
+		return nil, err
+	}
+	if err := poa.am.SignBlock(poa.miner, block); err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"miner": poa.miner,
+			"block": block,
+			"err":   err,
+		}).Error("Failed to sign new block")
+		go block.ReturnTransactions()
+		return nil, err
+	}
+	return block, nil
+}
+
+// mintBlock pack txs until the next slot if the miner is its proposer, and push the block at the slot.
+func (poa *Poa) mintBlock(now int64) error {
+	if !poa.enable {
+		return ErrCannotMintWhenDisable
+	}
+	if poa.pending {
+		return ErrCannotMintWhenPending
+	}
+
+	nowInMs := now * SecondInMs
+	slotInMs := poa.nextSlot(nowInMs)
+	tail := poa.chain.TailBlock()
+	if tail.Timestamp()*SecondInMs >= slotInMs {
+		return ErrBlockMintedInNextSlot
+	}
+	if slotInMs-nowInMs > poa.params.MintDurationInMs {
+		return ErrWaitingForNextSlot
+	}
+	consensusState, err := poa.checkProposer(tail, slotInMs)
+	if err != nil {
+		return err
+	}
+
+	logging.CLog().WithFields(logrus.Fields{
+		"tail":     tail,
+		"start":    nowInMs,
+		"deadline": slotInMs,
+		"miner":    poa.miner,
+	}).Info("My turn to mint block")
+
+	block, err := poa.newBlock(tail, consensusState, slotInMs)
+	if err != nil {
+		return err
+	}
+	if currentInMs := time.Now().Unix() * SecondInMs; slotInMs > currentInMs {
+		<-time.NewTimer(time.Duration(slotInMs-currentInMs) * time.Millisecond).C
+	}
+
+	if err := poa.chain.BlockPool().PushAndBroadcast(block); err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"tail":  tail,
+			"block": block,
+			"err":   err,
+		}).Error("Failed to push new minted block into block pool")
+		go block.ReturnTransactions()
+		return err
+	}
+	if !poa.chain.TailBlock().Hash().Equals(block.Hash()) {
+		return ErrAppendNewBlockFailed
+	}
+
+	logging.CLog().WithFields(logrus.Fields{
+		"tail":  tail,
+		"block": block,
+		"txs":   len(block.Transactions()),
+	}).Info("Minted new block")
+	return nil
+}
+
+func (poa *Poa) blockLoop() {
+	logging.CLog().Info("Started Poa Mining.")
+	timeChan := time.NewTicker(time.Second).C
+	for {
+		select {
+		case now := <-timeChan:
+			poa.mintBlock(now.Unix())
+		case msg := <-poa.messageCh:
+			poa.onMessage(msg)
+		case <-poa.quitCh:
+			logging.CLog().Info("Stopped Poa Mining.")
+			return
+		}
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package poa
+
+import (
+	"github.com/nebulasio/go-nebulas/consensus/finality"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// RegisterInNetwork register the pre-commit subscriber in network.
+func (poa *Poa) RegisterInNetwork(ns net.Service) {
+	ns.Register(net.NewSubscriber(poa, poa.messageCh, true, core.MessageTypePreCommit, net.MessageWeightZero))
+}
+
+func (poa *Poa) onMessage(msg net.Message) {
+	switch msg.MessageType() {
+	case core.MessageTypePreCommit:
+		poa.finality.OnPreCommitMessage(msg)
+	}
+}
+
+// Signer return the miner signing pre-commits, nil if the node doesn't mint.
+func (poa *Poa) Signer() *core.Address {
+	if !poa.enable {
+		return nil
+	}
+	return poa.miner
+}
+
+// FinalitySize return the pre-commits needed to finalize a block among the validators.
+func (poa *Poa) FinalitySize(validators []byteutils.Hash) int {
+	return finality.FinalitySizeOf(len(validators))
+}
+
+func (poa *Poa) setLIB(lib *core.Block) {
+	poa.chain.SetLIB(lib)
+
+	e := &state.Event{
+		Topic: core.TopicLibBlock,
+		Data:  poa.chain.LIB().String(),
+	}
+	poa.chain.EventEmitter().Trigger(e)
+}
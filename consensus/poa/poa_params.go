@@ -0,0 +1,94 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package poa
+
+import (
+	"errors"
+
+	"github.com/nebulasio/go-nebulas/core/pb"
+)
+
+// Consensus Related Constants, the defaults of Params
+const (
+	SecondInMs               = int64(1000)
+	BlockIntervalInMs        = int64(5000)
+	AcceptedNetWorkDelayInMs = int64(1250)
+	MintDurationInMs         = int64(1750)
+)
+
+// Errors in poa params
+var (
+	ErrMissingConfigForPoa       = errors.New("missing poa configuration in genesis")
+	ErrInvalidBlockIntervalParam = errors.New("invalid block interval in genesis, should be a positive multiple of second")
+	ErrInvalidValidatorsParam    = errors.New("invalid validators in genesis, should be non-empty and distinct")
+	ErrInvalidThresholdParam     = errors.New("invalid threshold in genesis, should not be greater than the number of validators")
+)
+
+// Params carry the timing and approval threshold of poa consensus
+type Params struct {
+	BlockIntervalInMs        int64
+	AcceptedNetWorkDelayInMs int64
+	MintDurationInMs         int64
+
+	// Threshold is the approvals needed to pass a proposal, 0 means more than half of the validators.
+	Threshold int
+}
+
+// DefaultParams return the default params
+func DefaultParams() *Params {
+	return &Params{
+		BlockIntervalInMs:        BlockIntervalInMs,
+		AcceptedNetWorkDelayInMs: AcceptedNetWorkDelayInMs,
+		MintDurationInMs:         MintDurationInMs,
+		Threshold:                0,
+	}
+}
+
+// LoadParams read the params from genesis conf, the network delay and mint duration
+// are derived from block interval in the same proportion as the defaults.
+func LoadParams(conf *corepb.GenesisConsensusPoa) (*Params, error) {
+	if conf == nil {
+		return nil, ErrMissingConfigForPoa
+	}
+	params := DefaultParams()
+	if conf.BlockIntervalInMs != 0 {
+		if conf.BlockIntervalInMs < 0 || conf.BlockIntervalInMs%SecondInMs != 0 {
+			return nil, ErrInvalidBlockIntervalParam
+		}
+		params.BlockIntervalInMs = conf.BlockIntervalInMs
+		params.AcceptedNetWorkDelayInMs = conf.BlockIntervalInMs * AcceptedNetWorkDelayInMs / BlockIntervalInMs
+		params.MintDurationInMs = conf.BlockIntervalInMs * MintDurationInMs / BlockIntervalInMs
+	}
+	if len(conf.Validators) == 0 {
+		return nil, ErrInvalidValidatorsParam
+	}
+	if int(conf.Threshold) > len(conf.Validators) {
+		return nil, ErrInvalidThresholdParam
+	}
+	params.Threshold = int(conf.Threshold)
+	return params, nil
+}
+
+// ThresholdOf return the approvals needed to pass a proposal among the validators
+func (p *Params) ThresholdOf(validators int) int {
+	if p.Threshold > 0 {
+		return p.Threshold
+	}
+	return validators/2 + 1
+}
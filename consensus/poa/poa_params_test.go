@@ -0,0 +1,77 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package poa
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadParams(t *testing.T) {
+	validators := []string{"n1FF1nz6tarkDVwWQkMnnwFPuPKUaQTdptE", "n1GmkKH6nBMw4rrjt16RrJ9WcgvKUtAZP1s"}
+	params, err := LoadParams(&corepb.GenesisConsensusPoa{Validators: validators})
+	assert.Nil(t, err)
+	assert.Equal(t, DefaultParams(), params)
+	assert.Equal(t, 2, params.ThresholdOf(2))
+	assert.Equal(t, 3, params.ThresholdOf(5))
+
+	params, err = LoadParams(&corepb.GenesisConsensusPoa{
+		Validators:        validators,
+		Threshold:         1,
+		BlockIntervalInMs: 2000,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2000), params.BlockIntervalInMs)
+	assert.Equal(t, int64(500), params.AcceptedNetWorkDelayInMs)
+	assert.Equal(t, int64(700), params.MintDurationInMs)
+	assert.Equal(t, 1, params.ThresholdOf(5))
+
+	tests := []struct {
+		name string
+		conf *corepb.GenesisConsensusPoa
+		err  error
+	}{
+		{"missing config", nil, ErrMissingConfigForPoa},
+		{"no validators", &corepb.GenesisConsensusPoa{}, ErrInvalidValidatorsParam},
+		{"partial second block interval", &corepb.GenesisConsensusPoa{Validators: validators, BlockIntervalInMs: 1500}, ErrInvalidBlockIntervalParam},
+		{"threshold over validators", &corepb.GenesisConsensusPoa{Validators: validators, Threshold: 3}, ErrInvalidThresholdParam},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadParams(tt.conf)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestFindProposer(t *testing.T) {
+	validators := []byteutils.Hash{{0x01}, {0x02}, {0x03}}
+	for slot := int64(0); slot < 6; slot++ {
+		proposer, err := FindProposer(slot*BlockIntervalInMs/SecondInMs, validators, DefaultParams())
+		assert.Nil(t, err)
+		assert.Equal(t, validators[slot%3], proposer)
+	}
+	_, err := FindProposer(1, validators, DefaultParams())
+	assert.Equal(t, ErrNotBlockForgTime, err)
+	_, err = FindProposer(0, nil, DefaultParams())
+	assert.Equal(t, ErrFoundNilProposer, err)
+}
@@ -0,0 +1,354 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package poa
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/consensus/pb"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Errors in poa state
+var (
+	ErrNotBlockForgTime = errors.New("now is not time to forg block")
+	ErrFoundNilProposer = errors.New("found a nil proposer")
+)
+
+// State carry context in poa consensus
+type State struct {
+	timestamp int64
+	proposer  byteutils.Hash
+
+	validatorTrie *trie.Trie // key: validator, val: validator
+	proposalTrie  *trie.Trie // key: proposal + approver, val: approver
+
+	params *Params
+}
+
+// NewState create a new poa state
+func (poa *Poa) NewState(root *consensuspb.ConsensusRoot, stor storage.Storage, needChangeLog bool) (state.ConsensusState, error) {
+	var validatorRoot, proposalRoot byteutils.Hash
+	if root != nil {
+		validatorRoot = root.DynastyRoot
+		proposalRoot = root.ProposalRoot
+	}
+	validatorTrie, err := trie.NewTrie(validatorRoot, stor, needChangeLog)
+	if err != nil {
+		return nil, err
+	}
+	proposalTrie, err := trie.NewTrie(proposalRoot, stor, needChangeLog)
+	if err != nil {
+		return nil, err
+	}
+	return &State{
+		timestamp: root.Timestamp,
+		proposer:  root.Proposer,
+
+		validatorTrie: validatorTrie,
+		proposalTrie:  proposalTrie,
+
+		params: poa.params,
+	}, nil
+}
+
+// GenesisConsensusState create a new genesis poa state
+func (poa *Poa) GenesisConsensusState(chain *core.BlockChain, conf *corepb.Genesis) (state.ConsensusState, error) {
+	poaConf := conf.GetConsensus().GetPoa()
+	params, err := LoadParams(poaConf)
+	if err != nil {
+		return nil, err
+	}
+	validatorTrie, err := trie.NewTrie(nil, chain.Storage(), false)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range poaConf.Validators {
+		validator, err := core.AddressParse(v)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := validatorTrie.Get(validator.Bytes()); err != storage.ErrKeyNotFound {
+			return nil, ErrInvalidValidatorsParam
+		}
+		if _, err := validatorTrie.Put(validator.Bytes(), validator.Bytes()); err != nil {
+			return nil, err
+		}
+	}
+	proposalTrie, err := trie.NewTrie(nil, chain.Storage(), false)
+	if err != nil {
+		return nil, err
+	}
+	return &State{
+		timestamp: core.GenesisTimestamp,
+		proposer:  nil,
+
+		validatorTrie: validatorTrie,
+		proposalTrie:  proposalTrie,
+
+		params: params,
+	}, nil
+}
+
+// CheckTimeout check whether the block is timeout
+func (poa *Poa) CheckTimeout(block *core.Block) bool {
+	nowInMs := time.Now().Unix() * SecondInMs
+	blockTimeInMs := block.Timestamp() * SecondInMs
+	if nowInMs < blockTimeInMs {
+		logging.VLog().WithFields(logrus.Fields{
+			"block": block,
+			"now":   nowInMs,
+			"diff":  blockTimeInMs - nowInMs,
+			"err":   "timeout - future block",
+		}).Warn("Found a future block.")
+		return false
+	}
+	behindInMs := nowInMs - blockTimeInMs
+	if behindInMs > poa.params.AcceptedNetWorkDelayInMs {
+		logging.VLog().WithFields(logrus.Fields{
+			"block": block,
+			"now":   nowInMs,
+			"diff":  behindInMs,
+			"limit": poa.params.AcceptedNetWorkDelayInMs,
+			"err":   "timeout - expired block",
+		}).Warn("Found a expired block.")
+		return true
+	}
+	return false
+}
+
+func (ps *State) String() string {
+	proposer := ""
+	if ps.proposer != nil {
+		proposer = ps.proposer.String()
+	}
+	return fmt.Sprintf(`{"timestamp": %d, "proposer": "%s", "validator": "%s", "proposal": "%s"}`,
+		ps.timestamp,
+		proposer,
+		byteutils.Hex(ps.validatorTrie.RootHash()),
+		byteutils.Hex(ps.proposalTrie.RootHash()),
+	)
+}
+
+// Replay a poa state
+func (ps *State) Replay(done state.ConsensusState) error {
+	state := done.(*State)
+	if _, err := ps.validatorTrie.Replay(state.validatorTrie); err != nil {
+		return err
+	}
+	if _, err := ps.proposalTrie.Replay(state.proposalTrie); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Clone a poa state
+func (ps *State) Clone() (state.ConsensusState, error) {
+	validatorTrie, err := ps.validatorTrie.Clone()
+	if err != nil {
+		return nil, err
+	}
+	proposalTrie, err := ps.proposalTrie.Clone()
+	if err != nil {
+		return nil, err
+	}
+	return &State{
+		timestamp: ps.timestamp,
+		proposer:  ps.proposer,
+
+		validatorTrie: validatorTrie,
+		proposalTrie:  proposalTrie,
+
+		params: ps.params,
+	}, nil
+}
+
+// RootHash hash poa state
+func (ps *State) RootHash() *consensuspb.ConsensusRoot {
+	return &consensuspb.ConsensusRoot{
+		DynastyRoot:  ps.validatorTrie.RootHash(),
+		ProposalRoot: ps.proposalTrie.RootHash(),
+		Timestamp:    ps.TimeStamp(),
+		Proposer:     ps.Proposer(),
+	}
+}
+
+// Proposer return the current proposer
+func (ps *State) Proposer() byteutils.Hash {
+	return ps.proposer
+}
+
+// TimeStamp return the current timestamp
+func (ps *State) TimeStamp() int64 {
+	return ps.timestamp
+}
+
+// FindProposer return the validator proposing the block at now, the validators take turns slot by slot.
+func FindProposer(now int64, validators []byteutils.Hash, params *Params) (byteutils.Hash, error) {
+	nowInMs := now * SecondInMs
+	if nowInMs%params.BlockIntervalInMs != 0 {
+		return nil, ErrNotBlockForgTime
+	}
+	if len(validators) == 0 {
+		return nil, ErrFoundNilProposer
+	}
+	slot := nowInMs / params.BlockIntervalInMs
+	return validators[slot%int64(len(validators))], nil
+}
+
+// NextConsensusState return the new state after some seconds elapsed
+func (ps *State) NextConsensusState(elapsedSecond int64, worldState state.WorldState) (state.ConsensusState, error) {
+	elapsedSecondInMs := elapsedSecond * SecondInMs
+	if elapsedSecondInMs <= 0 || elapsedSecondInMs%ps.params.BlockIntervalInMs != 0 {
+		return nil, ErrNotBlockForgTime
+	}
+	validatorTrie, err := ps.validatorTrie.Clone()
+	if err != nil {
+		return nil, err
+	}
+	proposalTrie, err := ps.proposalTrie.Clone()
+	if err != nil {
+		return nil, err
+	}
+	consensusState := &State{
+		timestamp: ps.timestamp + elapsedSecond,
+
+		validatorTrie: validatorTrie,
+		proposalTrie:  proposalTrie,
+
+		params: ps.params,
+	}
+	validators, err := consensusState.Validators()
+	if err != nil {
+		return nil, err
+	}
+	consensusState.proposer, err = FindProposer(consensusState.timestamp, validators, ps.params)
+	if err != nil {
+		return nil, err
+	}
+	return consensusState, nil
+}
+
+// Dynasty return the validators
+func (ps *State) Dynasty() ([]byteutils.Hash, error) {
+	return ps.Validators()
+}
+
+// DynastyRoot return the roothash of validators
+func (ps *State) DynastyRoot() byteutils.Hash {
+	return ps.validatorTrie.RootHash()
+}
+
+// IsValidator return true if the addr is a validator
+func (ps *State) IsValidator(addr byteutils.Hash) (bool, error) {
+	if _, err := ps.validatorTrie.Get(addr); err != nil {
+		if err == storage.ErrKeyNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// AddValidator add the addr into validators
+func (ps *State) AddValidator(addr byteutils.Hash) error {
+	_, err := ps.validatorTrie.Put(addr, addr)
+	return err
+}
+
+// DelValidator remove the validator
+func (ps *State) DelValidator(addr byteutils.Hash) error {
+	_, err := ps.validatorTrie.Del(addr)
+	return err
+}
+
+// Validators return all validators
+func (ps *State) Validators() ([]byteutils.Hash, error) {
+	return traverse(ps.validatorTrie, nil)
+}
+
+// Threshold return the approvals needed to pass a proposal
+func (ps *State) Threshold() (int, error) {
+	validators, err := ps.Validators()
+	if err != nil {
+		return 0, err
+	}
+	return ps.params.ThresholdOf(len(validators)), nil
+}
+
+// Approvals return the validators who approved the proposal
+func (ps *State) Approvals(proposal byteutils.Hash) ([]byteutils.Hash, error) {
+	return traverse(ps.proposalTrie, proposal)
+}
+
+// Approve record the approval of the proposal
+func (ps *State) Approve(proposal byteutils.Hash, approver byteutils.Hash) error {
+	_, err := ps.proposalTrie.Put(approvalKey(proposal, approver), approver)
+	return err
+}
+
+// ClearApprovals remove all approvals of the proposal
+func (ps *State) ClearApprovals(proposal byteutils.Hash) error {
+	approvers, err := ps.Approvals(proposal)
+	if err != nil {
+		return err
+	}
+	for _, approver := range approvers {
+		if _, err := ps.proposalTrie.Del(approvalKey(proposal, approver)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func approvalKey(proposal byteutils.Hash, approver byteutils.Hash) []byte {
+	key := make([]byte, 0, len(proposal)+len(approver))
+	key = append(key, proposal...)
+	return append(key, approver...)
+}
+
+// traverse return the values of all entries with the prefix in the trie
+func traverse(t *trie.Trie, prefix []byte) ([]byteutils.Hash, error) {
+	values := []byteutils.Hash{}
+	iter, err := t.Iterator(prefix)
+	if err != nil && err != storage.ErrKeyNotFound {
+		return nil, err
+	}
+	if err != nil {
+		return values, nil
+	}
+	exist, err := iter.Next()
+	for exist {
+		values = append(values, iter.Value())
+		exist, err = iter.Next()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return values, nil
+}
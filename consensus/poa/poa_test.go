@@ -0,0 +1,351 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package poa
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/account"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testValidators = []string{
+		"n1FF1nz6tarkDVwWQkMnnwFPuPKUaQTdptE",
+		"n1GmkKH6nBMw4rrjt16RrJ9WcgvKUtAZP1s",
+		"n1H4MYms9F55ehcvygwWE71J8tJC4CRr2so",
+		"n1JAy4X6KKLCNiTd7MWMRsVBjgdVq5WCCpf",
+	}
+	testOutsider = "n1LkDi2gGMqPrjYcczUiweyP4RxTB6Go1qS"
+)
+
+type Neb struct {
+	config    *nebletpb.Config
+	chain     *core.BlockChain
+	ns        net.Service
+	am        *account.Manager
+	genesis   *corepb.Genesis
+	storage   storage.Storage
+	consensus core.Consensus
+	emitter   *core.EventEmitter
+	nvm       core.NVM
+}
+
+func mockNeb(t *testing.T) *Neb {
+	storage, _ := storage.NewMemoryStorage()
+	eventEmitter := core.NewEventEmitter(1024)
+	poa := NewPoa()
+	distribution := []*corepb.GenesisTokenDistribution{}
+	for _, v := range append(testValidators, testOutsider) {
+		distribution = append(distribution, &corepb.GenesisTokenDistribution{
+			Address: v,
+			Value:   "5000000000000000000000000",
+		})
+	}
+	neb := &Neb{
+		genesis: &corepb.Genesis{
+			Meta: &corepb.GenesisMeta{ChainId: 0},
+			Consensus: &corepb.GenesisConsensus{
+				Engine: &corepb.GenesisConsensus_Poa{
+					Poa: &corepb.GenesisConsensusPoa{
+						Validators: testValidators,
+					},
+				},
+			},
+			TokenDistribution: distribution,
+		},
+		storage:   storage,
+		emitter:   eventEmitter,
+		consensus: poa,
+		nvm:       nvm.NewNebulasVM(),
+		config: &nebletpb.Config{
+			Chain: &nebletpb.ChainConfig{
+				ChainId:    0,
+				Keydir:     "keydir",
+				StartMine:  true,
+				Coinbase:   testOutsider,
+				Miner:      testValidators[0],
+				Passphrase: "passphrase",
+				Consensus:  "poa",
+			},
+		},
+		ns: mockNetService{},
+	}
+
+	am, _ := account.NewManager(neb)
+	neb.am = am
+
+	chain, err := core.NewBlockChain(neb)
+	assert.Nil(t, err)
+	neb.chain = chain
+	assert.Nil(t, poa.Setup(neb))
+	assert.Nil(t, chain.Setup(neb))
+
+	eventEmitter.Start()
+	return neb
+}
+
+func (n *Neb) Config() *nebletpb.Config {
+	return n.config
+}
+
+func (n *Neb) BlockChain() *core.BlockChain {
+	return n.chain
+}
+
+func (n *Neb) NetService() net.Service {
+	return n.ns
+}
+
+func (n *Neb) IsActiveSyncing() bool {
+	return true
+}
+
+func (n *Neb) AccountManager() core.AccountManager {
+	return n.am
+}
+
+func (n *Neb) Genesis() *corepb.Genesis {
+	return n.genesis
+}
+
+func (n *Neb) SetGenesis(genesis *corepb.Genesis) {
+	n.genesis = genesis
+}
+
+func (n *Neb) Storage() storage.Storage {
+	return n.storage
+}
+
+func (n *Neb) EventEmitter() *core.EventEmitter {
+	return n.emitter
+}
+
+func (n *Neb) Consensus() core.Consensus {
+	return n.consensus
+}
+
+func (n *Neb) Nvm() core.NVM {
+	return n.nvm
+}
+
+func (n *Neb) StartActiveSync() {}
+
+func (n *Neb) StartPprof(string) error { return nil }
+
+type mockNetService struct{}
+
+func (n mockNetService) Start() error { return nil }
+func (n mockNetService) Stop()        {}
+
+func (n mockNetService) Node() *net.Node { return nil }
+
+func (n mockNetService) Sync(net.Serializable) error { return nil }
+
+func (n mockNetService) Register(...*net.Subscriber)   {}
+func (n mockNetService) Deregister(...*net.Subscriber) {}
+
+func (n mockNetService) Broadcast(name string, msg net.Serializable, priority int) {}
+func (n mockNetService) Relay(name string, msg net.Serializable, priority int)     {}
+func (n mockNetService) SendMsg(name string, msg []byte, target string, priority int) error {
+	return nil
+}
+
+func (n mockNetService) SendMessageToPeers(messageName string, data []byte, priority int, filter net.PeerFilterAlgorithm) []string {
+	return make([]string, 0)
+}
+func (n mockNetService) SendMessageToPeer(messageName string, data []byte, priority int, peerID string) error {
+	return nil
+}
+
+func (n mockNetService) ClosePeer(peerID string, reason error) {}
+
+func (n mockNetService) BroadcastNetworkID([]byte) {}
+
+func getUnlockAddress(t *testing.T, am *account.Manager, addr string) *core.Address {
+	address, err := core.AddressParse(addr)
+	assert.Nil(t, err)
+	assert.Nil(t, am.Unlock(address, []byte("passphrase"), time.Second*60*60*24*365))
+	return address
+}
+
+// slotOf return the first slot after tail proposed by the validator
+func slotOf(t *testing.T, tail *core.Block, validator *core.Address) int64 {
+	for i := int64(1); ; i++ {
+		elapsed := i * BlockIntervalInMs / SecondInMs
+		consensusState, err := tail.WorldState().NextConsensusState(elapsed)
+		assert.Nil(t, err)
+		if consensusState.Proposer().Equals(validator.Bytes()) {
+			return tail.Timestamp() + elapsed
+		}
+	}
+}
+
+func mockBlock(t *testing.T, neb *Neb, proposer *core.Address, signer *core.Address, txs ...*core.Transaction) *core.Block {
+	tail := neb.chain.TailBlock()
+	slot := slotOf(t, tail, proposer)
+	consensusState, err := tail.WorldState().NextConsensusState(slot - tail.Timestamp())
+	assert.Nil(t, err)
+	block, err := core.NewBlock(neb.chain.ChainID(), signer, tail)
+	assert.Nil(t, err)
+	block.WorldState().SetConsensusState(consensusState)
+	block.SetTimestamp(slot)
+	for _, tx := range txs {
+		assert.Nil(t, neb.chain.TransactionPool().Push(tx))
+	}
+	block.CollectTransactions((time.Now().Unix() + 1) * SecondInMs)
+	assert.Equal(t, len(txs), len(block.Transactions()))
+	assert.Nil(t, block.Seal())
+	assert.Nil(t, neb.am.SignBlock(signer, block))
+	return block
+}
+
+func TestPoa_New(t *testing.T) {
+	neb := mockNeb(t)
+	miner := neb.config.Chain.Miner
+	neb.config.Chain.Miner += "0"
+	assert.NotNil(t, neb.Consensus().Setup(neb))
+	neb.config.Chain.Miner = miner
+	neb.genesis.Consensus.Engine = &corepb.GenesisConsensus_Dpos{}
+	assert.Equal(t, ErrMissingConfigForPoa, neb.Consensus().Setup(neb))
+}
+
+func TestPoa_MintBlock(t *testing.T) {
+	neb := mockNeb(t)
+	poa := neb.consensus.(*Poa)
+	chain := neb.chain
+	genesis := chain.TailBlock()
+
+	assert.Equal(t, ErrCannotMintWhenDisable, poa.mintBlock(0))
+	assert.Nil(t, poa.EnableMining("passphrase"))
+	assert.Equal(t, ErrCannotMintWhenPending, poa.mintBlock(0))
+	poa.ResumeMining()
+
+	other, err := core.AddressParse(testValidators[1])
+	assert.Nil(t, err)
+	assert.Equal(t, ErrInvalidBlockProposer, poa.mintBlock(slotOf(t, genesis, other)))
+
+	slot := slotOf(t, genesis, poa.miner)
+	assert.Equal(t, ErrWaitingForNextSlot, poa.mintBlock(slot-BlockIntervalInMs/SecondInMs+1))
+	assert.Nil(t, poa.mintBlock(slot))
+	tail := chain.TailBlock()
+	assert.Equal(t, uint64(2), tail.Height())
+	assert.Equal(t, slot, tail.Timestamp())
+	assert.Equal(t, byteutils.Hash(poa.miner.Bytes()), tail.ConsensusRoot().Proposer)
+	assert.Equal(t, ErrBlockMintedInNextSlot, poa.mintBlock(slot))
+}
+
+func TestVerifyBlock(t *testing.T) {
+	neb := mockNeb(t)
+	proposer := getUnlockAddress(t, neb.am, testValidators[1])
+	other := getUnlockAddress(t, neb.am, testValidators[2])
+
+	block := mockBlock(t, neb, proposer, other)
+	assert.Equal(t, ErrInvalidBlockProposer, neb.consensus.VerifyBlock(block))
+
+	block = mockBlock(t, neb, proposer, proposer)
+	assert.Nil(t, neb.consensus.VerifyBlock(block))
+	assert.Nil(t, neb.chain.BlockPool().Push(block))
+	assert.Equal(t, block.Hash(), neb.chain.TailBlock().Hash())
+
+	tail := neb.chain.TailBlock()
+	_, err := tail.WorldState().NextConsensusState(BlockIntervalInMs/SecondInMs + 1)
+	assert.Equal(t, ErrNotBlockForgTime, err)
+}
+
+func TestAuthorityGovernance(t *testing.T) {
+	height := core.AuthorityAvailableHeight
+	core.AuthorityAvailableHeight = core.LocalAuthorityAvailableHeight
+	defer func() { core.AuthorityAvailableHeight = height }()
+
+	neb := mockNeb(t)
+	chain := neb.chain
+	outsider := getUnlockAddress(t, neb.am, testOutsider)
+	approvers := []*core.Address{}
+	for _, v := range testValidators {
+		approvers = append(approvers, getUnlockAddress(t, neb.am, v))
+	}
+	// mint a block so that authority transactions are available.
+	chain.BlockPool().Push(mockBlock(t, neb, approvers[0], approvers[0]))
+
+	mockTx := func(from *core.Address, action string) *core.Transaction {
+		payload, err := core.NewAuthorityPayload(action, testOutsider)
+		assert.Nil(t, err)
+		data, err := payload.ToBytes()
+		assert.Nil(t, err)
+		acc, err := chain.TailBlock().WorldState().GetOrCreateUserAccount(from.Bytes())
+		assert.Nil(t, err)
+		gasLimit, _ := util.NewUint128FromInt(200000)
+		tx, err := core.NewTransaction(chain.ChainID(), from, from, util.NewUint128(), acc.Nonce()+1,
+			core.TxPayloadAuthorityType, data, core.TransactionGasPrice, gasLimit)
+		assert.Nil(t, err)
+		assert.Nil(t, neb.am.SignTransaction(from, tx))
+		return tx
+	}
+	isValidator := func() bool {
+		validators, err := chain.TailBlock().WorldState().Dynasty()
+		assert.Nil(t, err)
+		for _, v := range validators {
+			if v.Equals(outsider.Bytes()) {
+				return true
+			}
+		}
+		return false
+	}
+
+	// a majority of the 4 validators is needed, approvals of outsiders are rejected.
+	block := mockBlock(t, neb, approvers[0], approvers[0],
+		mockTx(approvers[0], core.AddValidatorAction),
+		mockTx(approvers[1], core.AddValidatorAction),
+		mockTx(outsider, core.AddValidatorAction))
+	assert.Nil(t, chain.BlockPool().Push(block))
+	assert.False(t, isValidator())
+	result, err := block.FetchExecutionResultEvent(block.Transactions()[2].Hash())
+	assert.Nil(t, err)
+	assert.Contains(t, result.Data, core.ErrApproveFromNonValidator.Error())
+
+	block = mockBlock(t, neb, approvers[1], approvers[1], mockTx(approvers[2], core.AddValidatorAction))
+	assert.Nil(t, chain.BlockPool().Push(block))
+	assert.True(t, isValidator())
+	validators, err := chain.TailBlock().WorldState().Dynasty()
+	assert.Nil(t, err)
+	assert.Equal(t, len(testValidators)+1, len(validators))
+
+	// the new validator proposes in its turn.
+	block = mockBlock(t, neb, outsider, outsider)
+	assert.Nil(t, chain.BlockPool().Push(block))
+	assert.Equal(t, block.Hash(), chain.TailBlock().Hash())
+
+	// the new validator takes part in the removal of itself.
+	block = mockBlock(t, neb, approvers[2], approvers[2],
+		mockTx(outsider, core.RemoveValidatorAction),
+		mockTx(approvers[0], core.RemoveValidatorAction),
+		mockTx(approvers[3], core.RemoveValidatorAction))
+	assert.Nil(t, chain.BlockPool().Push(block))
+	assert.False(t, isValidator())
+}
@@ -0,0 +1,483 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package pod
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/nebulasio/go-nebulas/consensus/finality"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// const
+const (
+	DefaultMaxUnlockDuration time.Duration = 1<<63 - 1
+
+	slotCacheSize = 128
+)
+
+// Errors in PoD Consensus
+var (
+	ErrInvalidBlockTimestamp      = errors.New("invalid block timestamp, should be same as consensus's timestamp")
+	ErrInvalidBlockInterval       = errors.New("invalid block interval")
+	ErrInvalidBlockProposer       = errors.New("invalid block proposer")
+	ErrCannotMintWhenPending      = errors.New("cannot mint block now, waiting for cancel pending again")
+	ErrCannotMintWhenDisable      = errors.New("cannot mint block now, waiting for enable it again")
+	ErrWaitingForNextSlot         = errors.New("cannot mint block now, waiting for next slot")
+	ErrBlockMintedInNextSlot      = errors.New("cannot mint block now, there is a block minted in current slot")
+	ErrGenerateNextConsensusState = errors.New("Failed to generate next consensus state")
+	ErrAppendNewBlockFailed       = errors.New("failed to append new block to real chain")
+)
+
+// Pod Proof-of-Devotion, the dynasty is elected every dynasty interval from the accounts
+// who locked a deposit, ranked by the Ranker. The members of dynasty take turns to propose
+// blocks, blocks are finalized by their pre-commits, and double minters lose their deposits.
+type Pod struct {
+	quitCh chan bool
+
+	chain *core.BlockChain
+	ns    net.Service
+	am    core.AccountManager
+
+	params *Params
+	ranker Ranker
+
+	coinbase *core.Address
+	miner    *core.Address
+
+	slot      *lru.Cache
+	evidences *finality.Evidences
+	messageCh chan net.Message
+
+	finality *finality.Finality
+	libLock  sync.Mutex
+
+	enable  bool
+	pending bool
+}
+
+// NewPod create Pod instance.
+func NewPod() *Pod {
+	pod := &Pod{
+		quitCh:    make(chan bool, 5),
+		messageCh: make(chan net.Message, 128),
+		params:    DefaultParams(),
+		ranker:    &DepositRanker{},
+		enable:    false,
+		pending:   true,
+	}
+	return pod
+}
+
+// Setup a pod consensus handler
+func (pod *Pod) Setup(neblet core.Neblet) error {
+	pod.chain = neblet.BlockChain()
+	pod.ns = neblet.NetService()
+	pod.am = neblet.AccountManager()
+
+	params, err := LoadParams(neblet.Genesis().GetConsensus().GetPod())
+	if err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Error("Failed to load pod params from genesis.")
+		return err
+	}
+	pod.params = params
+
+	chainConfig := neblet.Config().Chain
+	if chainConfig.StartMine {
+		coinbase, err := core.AddressParse(chainConfig.Coinbase)
+		if err != nil {
+			logging.CLog().WithFields(logrus.Fields{
+				"address": chainConfig.Coinbase,
+				"err":     err,
+			}).Error("Failed to parse coinbase address.")
+			return err
+		}
+		miner, err := core.AddressParse(chainConfig.Miner)
+		if err != nil {
+			logging.CLog().WithFields(logrus.Fields{
+				"address": chainConfig.Miner,
+				"err":     err,
+			}).Error("Failed to parse miner address.")
+			return err
+		}
+		pod.coinbase = coinbase
+		pod.miner = miner
+	}
+
+	slot, err := lru.New(slotCacheSize)
+	if err != nil {
+		return err
+	}
+	pod.slot = slot
+
+	if pod.evidences, err = finality.NewEvidences(pod, neblet); err != nil {
+		return err
+	}
+
+	if pod.finality, err = finality.NewFinality(pod, neblet); err != nil {
+		return err
+	}
+	pod.RegisterInNetwork(pod.ns)
+	return nil
+}
+
+// Start start pod service.
+func (pod *Pod) Start() {
+	logging.CLog().Info("Starting Pod Mining...")
+	go pod.blockLoop()
+}
+
+// Stop stop pod service.
+func (pod *Pod) Stop() {
+	logging.CLog().Info("Stopping Pod Mining...")
+	pod.DisableMining()
+	pod.quitCh <- true
+}
+
+// EnableMining start the consensus
+func (pod *Pod) EnableMining(passphrase string) error {
+	if err := pod.am.Unlock(pod.miner, []byte(passphrase), DefaultMaxUnlockDuration); err != nil {
+		return err
+	}
+	pod.enable = true
+	logging.CLog().Info("Enabled Pod Mining...")
+	return nil
+}
+
+// DisableMining stop the consensus
+func (pod *Pod) DisableMining() error {
+	if err := pod.am.Lock(pod.miner); err != nil {
+		return err
+	}
+	pod.enable = false
+	logging.CLog().Info("Disable Pod Mining...")
+	return nil
+}
+
+// Enable returns is mining
+func (pod *Pod) Enable() bool {
+	return pod.enable
+}
+
+// Pending return if consensus can do mining now
+func (pod *Pod) Pending() bool {
+	return pod.pending
+}
+
+// SuspendMining pend pod mining
+func (pod *Pod) SuspendMining() {
+	logging.CLog().Info("Suspended Pod Mining.")
+	pod.pending = true
+}
+
+// ResumeMining continue pod mining
+func (pod *Pod) ResumeMining() {
+	logging.CLog().Info("Resumed Pod Mining.")
+	pod.pending = false
+}
+
+// SetRanker set the ranker scoring the participants in election, it must be set
+// before the chain is loaded and be the same on all nodes.
+func (pod *Pod) SetRanker(ranker Ranker) {
+	pod.ranker = ranker
+}
+
+func less(a *core.Block, b *core.Block) bool {
+	if a.Height() != b.Height() {
+		return a.Height() < b.Height()
+	}
+	return byteutils.Less(a.Hash(), b.Hash())
+}
+
+// ForkChoice select the highest block as new tail, and pre-commit it if the miner is a member of its dynasty
+func (pod *Pod) ForkChoice() error {
+	// the pre-commits arrived before their blocks are counted once the blocks are linked.
+	pod.finality.ReplayPreCommits()
+
+	bc := pod.chain
+	tailBlock := bc.TailBlock()
+	newTailBlock := tailBlock
+	for _, v := range bc.DetachedTailBlocks() {
+		if less(newTailBlock, v) {
+			newTailBlock = v
+		}
+	}
+
+	if newTailBlock.Hash().Equals(tailBlock.Hash()) {
+		logging.VLog().WithFields(logrus.Fields{
+			"old tail": tailBlock,
+			"new tail": newTailBlock,
+		}).Debug("Current tail is best, no need to change.")
+		return nil
+	}
+
+	if err := bc.SetTailBlock(newTailBlock); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"new tail": newTailBlock,
+			"old tail": tailBlock,
+			"err":      err,
+		}).Debug("Failed to set new tail block.")
+		return err
+	}
+
+	logging.VLog().WithFields(logrus.Fields{
+		"new tail": newTailBlock,
+		"old tail": tailBlock,
+	}).Info("change to new tail.")
+
+	pod.finality.PreCommit(newTailBlock)
+	return nil
+}
+
+// UpdateLIB set the LIB to the highest block on canonical chain with finality proof
+func (pod *Pod) UpdateLIB() {
+	pod.libLock.Lock()
+	defer pod.libLock.Unlock()
+
+	if lib := pod.finality.NextLIB(); lib != nil {
+		pod.setLIB(lib)
+	}
+}
+
+// VerifyBlock verify the block is signed by the proposer of its slot, the proposer
+// itself is checked against the dynasty when the consensus root is verified.
+func (pod *Pod) VerifyBlock(block *core.Block) error {
+	if block.Timestamp() != block.ConsensusRoot().Timestamp {
+		return ErrInvalidBlockTimestamp
+	}
+	elapsedSecondInMs := block.Timestamp() * SecondInMs
+	if elapsedSecondInMs <= 0 || elapsedSecondInMs%pod.params.BlockIntervalInMs != 0 {
+		return ErrInvalidBlockInterval
+	}
+	proposer, err := core.AddressParseFromBytes(block.ConsensusRoot().Proposer)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"proposer": block.ConsensusRoot().Proposer,
+			"err":      err,
+			"block":    block,
+		}).Debug("Failed to parse proposer.")
+		return ErrInvalidBlockProposer
+	}
+	signer, err := core.RecoverSignerFromSignature(block.Alg(), block.Hash(), block.Signature())
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err":   err,
+			"block": block,
+		}).Debug("Failed to recover block's miner.")
+		return err
+	}
+	if !proposer.Equals(signer) {
+		logging.VLog().WithFields(logrus.Fields{
+			"signer":   signer,
+			"proposer": proposer,
+			"block":    block,
+		}).Debug("Failed to verify block's sign.")
+		return ErrInvalidBlockProposer
+	}
+
+	if block.Height() >= core.RandomAvailableHeight && !block.HasRandomSeed() {
+		logging.VLog().WithFields(logrus.Fields{
+			"blockHeight":      block.Height(),
+			"compatibleHeight": core.RandomAvailableHeight,
+		}).Debug("No random found in block header.")
+		return core.ErrInvalidBlockRandom
+	}
+
+	pod.slot.Add(block.Timestamp(), block)
+	return nil
+}
+
+// CheckDoubleMint if double mint exists
+func (pod *Pod) CheckDoubleMint(block *core.Block) bool {
+	if preBlock, exist := pod.slot.Get(block.Timestamp()); exist {
+		if !preBlock.(*core.Block).Hash().Equals(block.Hash()) {
+			logging.VLog().WithFields(logrus.Fields{
+				"curBlock": block,
+				"preBlock": preBlock.(*core.Block),
+			}).Warn("Found someone minted multiple blocks at same time.")
+			go pod.evidences.ReportDoubleMint(preBlock.(*core.Block), block)
+			return true
+		}
+	}
+	return false
+}
+
+// NumberOfBlocksInDynasty number of blocks in a dynasty interval
+func (pod *Pod) NumberOfBlocksInDynasty() uint64 {
+	return pod.params.NumberOfBlocksInDynasty()
+}
+
+func (pod *Pod) nextSlot(nowInMs int64) int64 {
+	blockIntervalInMs := pod.params.BlockIntervalInMs
+	return int64((nowInMs+blockIntervalInMs-SecondInMs)/blockIntervalInMs) * blockIntervalInMs
+}
+
+func (pod *Pod) checkProposer(tail *core.Block, slotInMs int64) (state.ConsensusState, error) {
+	elapsedInMs := slotInMs - tail.Timestamp()*SecondInMs
+	consensusState, err := tail.WorldState().NextConsensusStateOf(tail.Hash(), elapsedInMs/SecondInMs)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"tail":    tail,
+			"elapsed": elapsedInMs,
+			"err":     err,
+		}).Debug("Failed to generate next consensus state.")
+		return nil, ErrGenerateNextConsensusState
+	}
+	if !consensusState.Proposer().Equals(pod.miner.Bytes()) {
+		logging.VLog().WithFields(logrus.Fields{
+			"tail":     tail,
+			"slot":     slotInMs,
+			"expected": consensusState.Proposer().Base58(),
+			"actual":   pod.miner,
+		}).Debug("Not my turn, waiting...")
+		return nil, ErrInvalidBlockProposer
+	}
+	return consensusState, nil
+}
+
+func (pod *Pod) newBlock(tail *core.Block, consensusState state.ConsensusState, deadlineInMs int64) (*core.Block, error) {
+	block, err := core.NewBlock(pod.chain.ChainID(), pod.coinbase, tail)
+	if err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"tail":     tail,
+			"coinbase": pod.coinbase,
+			"chainid":  pod.chain.ChainID(),
+			"err":      err,
+		}).Error("Failed to create new block")
+		return nil, err
+	}
+	if block.Height() >= core.RandomAvailableHeight {
+		ancestorHash, parentSeed, err := pod.chain.GetInputForVRFSigner(block.ParentHash(), block.Height())
+		if err != nil {
+			return nil, err
+		}
+		vrfSeed, vrfProof, err := pod.am.GenerateRandomSeed(pod.miner, ancestorHash, parentSeed)
+		if err != nil {
+			return nil, err
+		}
+		block.SetRandomSeed(vrfSeed, vrfProof)
+	}
+
+	block.WorldState().SetConsensusState(consensusState)
+	block.SetTimestamp(consensusState.TimeStamp())
+	block.CollectTransactions(deadlineInMs)
+	if err := block.Seal(); err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"block": block,
+			"err":   err,
+		}).Error("Failed to seal new block")
+		go block.ReturnTransactions()
+		return nil, err
+	}
+	if err := pod.am.SignBlock(pod.miner, block); err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"miner": pod.miner,
+			"block": block,
+			"err":   err,
+		}).Error("Failed to sign new block")
+		go block.ReturnTransactions()
+		return nil, err
+	}
+	return block, nil
+}
+
+// mintBlock pack txs until the next slot if the miner is its proposer, and push the block at the slot.
+func (pod *Pod) mintBlock(now int64) error {
+	if !pod.enable {
+		return ErrCannotMintWhenDisable
+	}
+	if pod.pending {
+		return ErrCannotMintWhenPending
+	}
+
+	nowInMs := now * SecondInMs
+	slotInMs := pod.nextSlot(nowInMs)
+	tail := pod.chain.TailBlock()
+	if tail.Timestamp()*SecondInMs >= slotInMs {
+		return ErrBlockMintedInNextSlot
+	}
+	if slotInMs-nowInMs > pod.params.MintDurationInMs {
+		return ErrWaitingForNextSlot
+	}
+	consensusState, err := pod.checkProposer(tail, slotInMs)
+	if err != nil {
+		return err
+	}
+
+	logging.CLog().WithFields(logrus.Fields{
+		"tail":     tail,
+		"start":    nowInMs,
+		"deadline": slotInMs,
+		"miner":    pod.miner,
+	}).Info("My turn to mint block")
+
+	block, err := pod.newBlock(tail, consensusState, slotInMs)
+	if err != nil {
+		return err
+	}
+	if currentInMs := time.Now().Unix() * SecondInMs; slotInMs > currentInMs {
+		<-time.NewTimer(time.Duration(slotInMs-currentInMs) * time.Millisecond).C
+	}
+
+	if err := pod.chain.BlockPool().PushAndBroadcast(block); err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"tail":  tail,
+			"block": block,
+			"err":   err,
+		}).Error("Failed to push new minted block into block pool")
+		go block.ReturnTransactions()
+		return err
+	}
+	if !pod.chain.TailBlock().Hash().Equals(block.Hash()) {
+		return ErrAppendNewBlockFailed
+	}
+
+	logging.CLog().WithFields(logrus.Fields{
+		"tail":  tail,
+		"block": block,
+		"txs":   len(block.Transactions()),
+	}).Info("Minted new block")
+	return nil
+}
+
+func (pod *Pod) blockLoop() {
+	logging.CLog().Info("Started Pod Mining.")
+	timeChan := time.NewTicker(time.Second).C
+	for {
+		select {
+		case now := <-timeChan:
+			pod.mintBlock(now.Unix())
+		case msg := <-pod.messageCh:
+			pod.onMessage(msg)
+		case <-pod.quitCh:
+			logging.CLog().Info("Stopped Pod Mining.")
+			return
+		}
+	}
+}
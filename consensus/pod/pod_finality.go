@@ -0,0 +1,73 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package pod
+
+import (
+	"github.com/nebulasio/go-nebulas/consensus/finality"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// RegisterInNetwork register the double mint evidence and pre-commit subscribers in network.
+func (pod *Pod) RegisterInNetwork(ns net.Service) {
+	ns.Register(net.NewSubscriber(pod, pod.messageCh, true, core.MessageTypeDoubleMintEvidence, net.MessageWeightZero))
+	ns.Register(net.NewSubscriber(pod, pod.messageCh, true, core.MessageTypePreCommit, net.MessageWeightZero))
+}
+
+func (pod *Pod) onMessage(msg net.Message) {
+	switch msg.MessageType() {
+	case core.MessageTypeDoubleMintEvidence:
+		pod.evidences.OnEvidenceMessage(msg)
+	case core.MessageTypePreCommit:
+		pod.finality.OnPreCommitMessage(msg)
+	default:
+		logging.VLog().WithFields(logrus.Fields{
+			"messageType": msg.MessageType(),
+			"message":     msg,
+			"err":         "not consensus msg",
+		}).Debug("Received unregistered message.")
+	}
+}
+
+// Signer return the miner signing pre-commits and evidences, nil if the node doesn't mint.
+func (pod *Pod) Signer() *core.Address {
+	if !pod.enable {
+		return nil
+	}
+	return pod.miner
+}
+
+// FinalitySize return the pre-commits needed to finalize a block among the dynasty.
+func (pod *Pod) FinalitySize(dynasty []byteutils.Hash) int {
+	return finality.FinalitySizeOf(len(dynasty))
+}
+
+func (pod *Pod) setLIB(lib *core.Block) {
+	pod.chain.SetLIB(lib)
+
+	e := &state.Event{
+		Topic: core.TopicLibBlock,
+		Data:  pod.chain.LIB().String(),
+	}
+	pod.chain.EventEmitter().Trigger(e)
+}
@@ -0,0 +1,59 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package pod
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/consensus/finality"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreCommitFinality(t *testing.T) {
+	neb := mockNeb(t)
+	chain := neb.chain
+	pod := neb.consensus.(*Pod)
+	genesis := chain.LIB()
+
+	proposer := getUnlockAddress(t, neb.am, testDynasty[1])
+	block := mockBlock(t, neb, proposer, proposer)
+	assert.Nil(t, chain.BlockPool().Push(block))
+
+	// the pre-commits are checked against the dynasty in the world state of block.
+	outsider, err := finality.SignPreCommit(neb.am, getUnlockAddress(t, neb.am, testOutsider), block.Hash())
+	assert.Nil(t, err)
+	assert.Equal(t, finality.ErrInvalidPreCommitVoter, pod.finality.HandlePreCommit(outsider))
+
+	// the block is finalized by more than 2/3 of the dynasty, and the LIB follows it.
+	dynasty, err := block.WorldState().Dynasty()
+	assert.Nil(t, err)
+	size := pod.FinalitySize(dynasty)
+	assert.Equal(t, finality.FinalitySizeOf(len(testDynasty)), size)
+	for i, v := range testDynasty[:size] {
+		vote, err := finality.SignPreCommit(neb.am, getUnlockAddress(t, neb.am, v), block.Hash())
+		assert.Nil(t, err)
+		assert.Nil(t, pod.finality.HandlePreCommit(vote))
+		if i+1 < size {
+			assert.Equal(t, genesis.Hash(), chain.LIB().Hash())
+		}
+	}
+	assert.Equal(t, block.Hash(), chain.LIB().Hash())
+	_, err = chain.GetFinalityProof(block.Hash())
+	assert.Nil(t, err)
+}
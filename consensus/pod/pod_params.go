@@ -0,0 +1,128 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package pod
+
+import (
+	"errors"
+
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/util"
+)
+
+// Consensus Related Constants, the defaults of Params
+const (
+	SecondInMs               = int64(1000)
+	BlockIntervalInMs        = int64(15000)
+	AcceptedNetWorkDelayInMs = int64(3750)
+	MintDurationInMs         = int64(5250)
+	DynastyIntervalInMs      = int64(3150000)
+	DynastySize              = 21
+
+	// DefaultMinDeposit 20000 NAS in wei
+	DefaultMinDeposit = "20000000000000000000000"
+)
+
+// Errors in pod params
+var (
+	ErrMissingConfigForPod         = errors.New("missing pod configuration in genesis")
+	ErrInvalidBlockIntervalParam   = errors.New("invalid block interval in genesis, should be a positive multiple of second")
+	ErrInvalidDynastyIntervalParam = errors.New("invalid dynasty interval in genesis, should be a multiple of block interval and hold the whole dynasty")
+	ErrInvalidDynastySizeParam     = errors.New("invalid dynasty size in genesis, should be positive")
+	ErrInvalidMinDepositParam      = errors.New("invalid min deposit in genesis, should be a positive integer in wei")
+	ErrInvalidGenesisDynastyParam  = errors.New("invalid dynasty in genesis, should be distinct and no more than dynasty size")
+)
+
+// Params carry the timing, dynasty size and deposit of pod consensus
+type Params struct {
+	BlockIntervalInMs        int64
+	AcceptedNetWorkDelayInMs int64
+	MintDurationInMs         int64
+	DynastyIntervalInMs      int64
+	DynastySize              int
+
+	MinDeposit *util.Uint128
+}
+
+// DefaultParams return the default params
+func DefaultParams() *Params {
+	minDeposit, _ := util.NewUint128FromString(DefaultMinDeposit)
+	return &Params{
+		BlockIntervalInMs:        BlockIntervalInMs,
+		AcceptedNetWorkDelayInMs: AcceptedNetWorkDelayInMs,
+		MintDurationInMs:         MintDurationInMs,
+		DynastyIntervalInMs:      DynastyIntervalInMs,
+		DynastySize:              DynastySize,
+		MinDeposit:               minDeposit,
+	}
+}
+
+// LoadParams read the params from genesis conf, the missing timing is derived from
+// block interval in the same proportion as the defaults.
+func LoadParams(conf *corepb.GenesisConsensusPod) (*Params, error) {
+	if conf == nil {
+		return nil, ErrMissingConfigForPod
+	}
+	params := DefaultParams()
+	if conf.BlockIntervalInMs != 0 {
+		params.BlockIntervalInMs = conf.BlockIntervalInMs
+		params.AcceptedNetWorkDelayInMs = conf.BlockIntervalInMs * AcceptedNetWorkDelayInMs / BlockIntervalInMs
+		params.MintDurationInMs = conf.BlockIntervalInMs * MintDurationInMs / BlockIntervalInMs
+		params.DynastyIntervalInMs = conf.BlockIntervalInMs * (DynastyIntervalInMs / BlockIntervalInMs)
+	}
+	if conf.DynastyIntervalInMs != 0 {
+		params.DynastyIntervalInMs = conf.DynastyIntervalInMs
+	}
+	if conf.DynastySize != 0 {
+		params.DynastySize = int(conf.DynastySize)
+	}
+	if len(conf.MinDeposit) > 0 {
+		minDeposit, err := util.NewUint128FromString(conf.MinDeposit)
+		if err != nil || minDeposit.Cmp(util.NewUint128()) <= 0 {
+			return nil, ErrInvalidMinDepositParam
+		}
+		params.MinDeposit = minDeposit
+	}
+
+	if err := params.verify(); err != nil {
+		return nil, err
+	}
+	if len(conf.Dynasty) == 0 || len(conf.Dynasty) > params.DynastySize {
+		return nil, ErrInvalidGenesisDynastyParam
+	}
+	return params, nil
+}
+
+func (p *Params) verify() error {
+	if p.BlockIntervalInMs <= 0 || p.BlockIntervalInMs%SecondInMs != 0 {
+		return ErrInvalidBlockIntervalParam
+	}
+	if p.DynastySize <= 0 {
+		return ErrInvalidDynastySizeParam
+	}
+	if p.DynastyIntervalInMs <= 0 || p.DynastyIntervalInMs%p.BlockIntervalInMs != 0 ||
+		p.DynastyIntervalInMs/p.BlockIntervalInMs < int64(p.DynastySize) {
+		return ErrInvalidDynastyIntervalParam
+	}
+	return nil
+}
+
+// NumberOfBlocksInDynasty number of blocks in one dynasty
+func (p *Params) NumberOfBlocksInDynasty() uint64 {
+	return uint64(p.DynastyIntervalInMs) / uint64(p.BlockIntervalInMs)
+}
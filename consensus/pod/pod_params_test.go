@@ -0,0 +1,86 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package pod
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadParams(t *testing.T) {
+	dynasty := []string{"n1FF1nz6tarkDVwWQkMnnwFPuPKUaQTdptE", "n1GmkKH6nBMw4rrjt16RrJ9WcgvKUtAZP1s"}
+	params, err := LoadParams(&corepb.GenesisConsensusPod{Dynasty: dynasty})
+	assert.Nil(t, err)
+	assert.Equal(t, DefaultParams(), params)
+	assert.Equal(t, uint64(210), params.NumberOfBlocksInDynasty())
+
+	params, err = LoadParams(&corepb.GenesisConsensusPod{
+		Dynasty:           dynasty,
+		MinDeposit:        "100",
+		BlockIntervalInMs: 2000,
+		DynastySize:       2,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2000), params.BlockIntervalInMs)
+	assert.Equal(t, int64(500), params.AcceptedNetWorkDelayInMs)
+	assert.Equal(t, int64(700), params.MintDurationInMs)
+	assert.Equal(t, int64(420000), params.DynastyIntervalInMs)
+	assert.Equal(t, 2, params.DynastySize)
+	assert.Equal(t, util.NewUint128FromUint(100), params.MinDeposit)
+
+	tests := []struct {
+		name string
+		conf *corepb.GenesisConsensusPod
+		err  error
+	}{
+		{"missing config", nil, ErrMissingConfigForPod},
+		{"no dynasty", &corepb.GenesisConsensusPod{}, ErrInvalidGenesisDynastyParam},
+		{"dynasty over size", &corepb.GenesisConsensusPod{Dynasty: dynasty, DynastySize: 1}, ErrInvalidGenesisDynastyParam},
+		{"partial second block interval", &corepb.GenesisConsensusPod{Dynasty: dynasty, BlockIntervalInMs: 1500}, ErrInvalidBlockIntervalParam},
+		{"dynasty interval too short", &corepb.GenesisConsensusPod{Dynasty: dynasty, DynastyIntervalInMs: 20 * BlockIntervalInMs}, ErrInvalidDynastyIntervalParam},
+		{"zero min deposit", &corepb.GenesisConsensusPod{Dynasty: dynasty, MinDeposit: "0"}, ErrInvalidMinDepositParam},
+		{"invalid min deposit", &corepb.GenesisConsensusPod{Dynasty: dynasty, MinDeposit: "1 NAS"}, ErrInvalidMinDepositParam},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadParams(tt.conf)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestFindProposer(t *testing.T) {
+	params := DefaultParams()
+	params.DynastyIntervalInMs = 5 * BlockIntervalInMs
+	dynasty := []byteutils.Hash{{0x01}, {0x02}, {0x03}}
+	for slot := int64(0); slot < 10; slot++ {
+		proposer, err := FindProposer(slot*BlockIntervalInMs/SecondInMs, dynasty, params)
+		assert.Nil(t, err)
+		// members take turns from the beginning of each dynasty interval.
+		assert.Equal(t, dynasty[(slot%5)%3], proposer)
+	}
+	_, err := FindProposer(1, dynasty, params)
+	assert.Equal(t, ErrNotBlockForgTime, err)
+	_, err = FindProposer(0, nil, params)
+	assert.Equal(t, ErrFoundNilProposer, err)
+}
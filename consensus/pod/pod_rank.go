@@ -0,0 +1,41 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package pod
+
+import (
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// Ranker score the participants when a new dynasty is elected, the participants with
+// higher score are elected first. The score must be the same among nodes, it's only
+// derived from the parent block of the new dynasty and its ancestors. The parent is nil
+// if the state is advanced without the chain.
+type Ranker interface {
+	Score(parent byteutils.Hash, participant byteutils.Hash, deposit *util.Uint128, timestamp int64) (*util.Uint128, error)
+}
+
+// DepositRanker rank the participants by their deposits, it's used until a Nebulas Rank
+// based ranker is set.
+type DepositRanker struct{}
+
+// Score return the deposit as score
+func (r *DepositRanker) Score(parent byteutils.Hash, participant byteutils.Hash, deposit *util.Uint128, timestamp int64) (*util.Uint128, error) {
+	return deposit, nil
+}
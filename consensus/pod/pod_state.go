@@ -0,0 +1,630 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package pod
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/consensus/pb"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Errors in pod state
+var (
+	ErrNotBlockForgTime = errors.New("now is not time to forg block")
+	ErrFoundNilProposer = errors.New("found a nil proposer")
+)
+
+// State carry context in pod consensus
+type State struct {
+	timestamp int64
+	proposer  byteutils.Hash
+
+	dynastyTrie     *trie.Trie // key: member, val: member
+	participantTrie *trie.Trie // key: participant, val: participant
+	depositTrie     *trie.Trie // key: addr, val: locked deposit
+	slashedTrie     *trie.Trie // key: addr, val: addr
+	unbondingTrie   *trie.Trie // key: addr, val: timestamp the deposit unlocks at
+
+	params *Params
+	ranker Ranker
+}
+
+// NewState create a new pod state
+func (pod *Pod) NewState(root *consensuspb.ConsensusRoot, stor storage.Storage, needChangeLog bool) (state.ConsensusState, error) {
+	var dynastyRoot, participantRoot, depositRoot, slashedRoot, unbondingRoot byteutils.Hash
+	if root != nil {
+		dynastyRoot = root.DynastyRoot
+		participantRoot = root.CandidateRoot
+		depositRoot = root.DepositRoot
+		slashedRoot = root.DisqualifiedRoot
+		unbondingRoot = root.UnbondingRoot
+	}
+	dynastyTrie, err := trie.NewTrie(dynastyRoot, stor, needChangeLog)
+	if err != nil {
+		return nil, err
+	}
+	participantTrie, err := trie.NewTrie(participantRoot, stor, needChangeLog)
+	if err != nil {
+		return nil, err
+	}
+	depositTrie, err := trie.NewTrie(depositRoot, stor, needChangeLog)
+	if err != nil {
+		return nil, err
+	}
+	slashedTrie, err := trie.NewTrie(slashedRoot, stor, needChangeLog)
+	if err != nil {
+		return nil, err
+	}
+	unbondingTrie, err := trie.NewTrie(unbondingRoot, stor, needChangeLog)
+	if err != nil {
+		return nil, err
+	}
+	return &State{
+		timestamp: root.Timestamp,
+		proposer:  root.Proposer,
+
+		dynastyTrie:     dynastyTrie,
+		participantTrie: participantTrie,
+		depositTrie:     depositTrie,
+		slashedTrie:     slashedTrie,
+		unbondingTrie:   unbondingTrie,
+
+		params: pod.params,
+		ranker: pod.ranker,
+	}, nil
+}
+
+// GenesisConsensusState create a new genesis pod state
+func (pod *Pod) GenesisConsensusState(chain *core.BlockChain, conf *corepb.Genesis) (state.ConsensusState, error) {
+	podConf := conf.GetConsensus().GetPod()
+	params, err := LoadParams(podConf)
+	if err != nil {
+		return nil, err
+	}
+	stor := chain.Storage()
+	dynastyTrie, err := trie.NewTrie(nil, stor, false)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range podConf.Dynasty {
+		member, err := core.AddressParse(v)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := dynastyTrie.Get(member.Bytes()); err != storage.ErrKeyNotFound {
+			return nil, ErrInvalidGenesisDynastyParam
+		}
+		if _, err := dynastyTrie.Put(member.Bytes(), member.Bytes()); err != nil {
+			return nil, err
+		}
+	}
+	participantTrie, err := trie.NewTrie(nil, stor, false)
+	if err != nil {
+		return nil, err
+	}
+	depositTrie, err := trie.NewTrie(nil, stor, false)
+	if err != nil {
+		return nil, err
+	}
+	slashedTrie, err := trie.NewTrie(nil, stor, false)
+	if err != nil {
+		return nil, err
+	}
+	unbondingTrie, err := trie.NewTrie(nil, stor, false)
+	if err != nil {
+		return nil, err
+	}
+	return &State{
+		timestamp: core.GenesisTimestamp,
+		proposer:  nil,
+
+		dynastyTrie:     dynastyTrie,
+		participantTrie: participantTrie,
+		depositTrie:     depositTrie,
+		slashedTrie:     slashedTrie,
+		unbondingTrie:   unbondingTrie,
+
+		params: params,
+		ranker: pod.ranker,
+	}, nil
+}
+
+// CheckTimeout check whether the block is timeout
+func (pod *Pod) CheckTimeout(block *core.Block) bool {
+	nowInMs := time.Now().Unix() * SecondInMs
+	blockTimeInMs := block.Timestamp() * SecondInMs
+	if nowInMs < blockTimeInMs {
+		logging.VLog().WithFields(logrus.Fields{
+			"block": block,
+			"now":   nowInMs,
+			"diff":  blockTimeInMs - nowInMs,
+			"err":   "timeout - future block",
+		}).Warn("Found a future block.")
+		return false
+	}
+	behindInMs := nowInMs - blockTimeInMs
+	if behindInMs > pod.params.AcceptedNetWorkDelayInMs {
+		logging.VLog().WithFields(logrus.Fields{
+			"block": block,
+			"now":   nowInMs,
+			"diff":  behindInMs,
+			"limit": pod.params.AcceptedNetWorkDelayInMs,
+			"err":   "timeout - expired block",
+		}).Warn("Found a expired block.")
+		return true
+	}
+	return false
+}
+
+func (ps *State) String() string {
+	proposer := ""
+	if ps.proposer != nil {
+		proposer = ps.proposer.String()
+	}
+	return fmt.Sprintf(`{"timestamp": %d, "proposer": "%s", "dynasty": "%s", "participant": "%s", "deposit": "%s", "slashed": "%s", "unbonding": "%s"}`,
+		ps.timestamp,
+		proposer,
+		byteutils.Hex(ps.dynastyTrie.RootHash()),
+		byteutils.Hex(ps.participantTrie.RootHash()),
+		byteutils.Hex(ps.depositTrie.RootHash()),
+		byteutils.Hex(ps.slashedTrie.RootHash()),
+		byteutils.Hex(ps.unbondingTrie.RootHash()),
+	)
+}
+
+// Replay a pod state
+func (ps *State) Replay(done state.ConsensusState) error {
+	state := done.(*State)
+	if _, err := ps.dynastyTrie.Replay(state.dynastyTrie); err != nil {
+		return err
+	}
+	if _, err := ps.participantTrie.Replay(state.participantTrie); err != nil {
+		return err
+	}
+	if _, err := ps.depositTrie.Replay(state.depositTrie); err != nil {
+		return err
+	}
+	if _, err := ps.slashedTrie.Replay(state.slashedTrie); err != nil {
+		return err
+	}
+	if _, err := ps.unbondingTrie.Replay(state.unbondingTrie); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Clone a pod state
+func (ps *State) Clone() (state.ConsensusState, error) {
+	cloned, err := ps.clone()
+	if err != nil {
+		return nil, err
+	}
+	cloned.proposer = ps.proposer
+	return cloned, nil
+}
+
+func (ps *State) clone() (*State, error) {
+	dynastyTrie, err := ps.dynastyTrie.Clone()
+	if err != nil {
+		return nil, err
+	}
+	participantTrie, err := ps.participantTrie.Clone()
+	if err != nil {
+		return nil, err
+	}
+	depositTrie, err := ps.depositTrie.Clone()
+	if err != nil {
+		return nil, err
+	}
+	slashedTrie, err := ps.slashedTrie.Clone()
+	if err != nil {
+		return nil, err
+	}
+	unbondingTrie, err := ps.unbondingTrie.Clone()
+	if err != nil {
+		return nil, err
+	}
+	return &State{
+		timestamp: ps.timestamp,
+
+		dynastyTrie:     dynastyTrie,
+		participantTrie: participantTrie,
+		depositTrie:     depositTrie,
+		slashedTrie:     slashedTrie,
+		unbondingTrie:   unbondingTrie,
+
+		params: ps.params,
+		ranker: ps.ranker,
+	}, nil
+}
+
+// RootHash hash pod state
+func (ps *State) RootHash() *consensuspb.ConsensusRoot {
+	return &consensuspb.ConsensusRoot{
+		DynastyRoot:      ps.dynastyTrie.RootHash(),
+		CandidateRoot:    ps.participantTrie.RootHash(),
+		DepositRoot:      ps.depositTrie.RootHash(),
+		DisqualifiedRoot: ps.slashedTrie.RootHash(),
+		UnbondingRoot:    ps.unbondingTrie.RootHash(),
+		Timestamp:        ps.TimeStamp(),
+		Proposer:         ps.Proposer(),
+	}
+}
+
+// Proposer return the current proposer
+func (ps *State) Proposer() byteutils.Hash {
+	return ps.proposer
+}
+
+// TimeStamp return the current timestamp
+func (ps *State) TimeStamp() int64 {
+	return ps.timestamp
+}
+
+// Dynasty return the current dynasty
+func (ps *State) Dynasty() ([]byteutils.Hash, error) {
+	return traverse(ps.dynastyTrie)
+}
+
+// DynastyRoot return the roothash of current dynasty
+func (ps *State) DynastyRoot() byteutils.Hash {
+	return ps.dynastyTrie.RootHash()
+}
+
+// FindProposer return the member proposing the block at now, the members of
+// dynasty take turns from the beginning of each dynasty interval.
+func FindProposer(now int64, dynasty []byteutils.Hash, params *Params) (byteutils.Hash, error) {
+	nowInMs := now * SecondInMs
+	offsetInMs := nowInMs % params.DynastyIntervalInMs
+	if offsetInMs%params.BlockIntervalInMs != 0 {
+		return nil, ErrNotBlockForgTime
+	}
+	if len(dynasty) == 0 {
+		return nil, ErrFoundNilProposer
+	}
+	offset := offsetInMs / params.BlockIntervalInMs
+	return dynasty[offset%int64(len(dynasty))], nil
+}
+
+// NextConsensusState return the new state after some seconds elapsed, the participants
+// are ranked without the chain history of the block.
+func (ps *State) NextConsensusState(elapsedSecond int64, worldState state.WorldState) (state.ConsensusState, error) {
+	return ps.NextConsensusStateOf(nil, elapsedSecond, worldState)
+}
+
+// NextConsensusStateOf return the new state after some seconds elapsed since the parent block,
+// the participants are ranked by the chain history up to the parent.
+func (ps *State) NextConsensusStateOf(parent byteutils.Hash, elapsedSecond int64, worldState state.WorldState) (state.ConsensusState, error) {
+	elapsedSecondInMs := elapsedSecond * SecondInMs
+	if elapsedSecondInMs <= 0 || elapsedSecondInMs%ps.params.BlockIntervalInMs != 0 {
+		return nil, ErrNotBlockForgTime
+	}
+	consensusState, err := ps.clone()
+	if err != nil {
+		return nil, err
+	}
+	consensusState.timestamp = ps.timestamp + elapsedSecond
+
+	// the next dynasty is elected when a new dynasty interval begins.
+	dynastyIntervalInMs := ps.params.DynastyIntervalInMs
+	if ps.timestamp*SecondInMs/dynastyIntervalInMs < consensusState.timestamp*SecondInMs/dynastyIntervalInMs {
+		if err := consensusState.electDynasty(parent); err != nil {
+			return nil, err
+		}
+	}
+
+	dynasty, err := consensusState.Dynasty()
+	if err != nil {
+		return nil, err
+	}
+	consensusState.proposer, err = FindProposer(consensusState.timestamp, dynasty, ps.params)
+	if err != nil {
+		return nil, err
+	}
+	return consensusState, nil
+}
+
+// MinDeposit return the minimal deposit to join the participants
+func (ps *State) MinDeposit() *util.Uint128 {
+	return ps.params.MinDeposit
+}
+
+// IsParticipant return true if the addr joined the election
+func (ps *State) IsParticipant(addr byteutils.Hash) (bool, error) {
+	return contains(ps.participantTrie, addr)
+}
+
+// Join register the addr as a participant, the deposit is added to its locked deposit
+func (ps *State) Join(addr byteutils.Hash, deposit *util.Uint128) error {
+	locked, err := ps.DepositOf(addr)
+	if err != nil {
+		return err
+	}
+	locked, err = locked.Add(deposit)
+	if err != nil {
+		return err
+	}
+	value, err := locked.ToFixedSizeByteSlice()
+	if err != nil {
+		return err
+	}
+	if _, err := ps.depositTrie.Put(addr, value); err != nil {
+		return err
+	}
+	_, err = ps.participantTrie.Put(addr, addr)
+	return err
+}
+
+// Quit unregister the participant, the deposit stays locked until withdrawn
+// and unbonds for one dynasty after the current one.
+func (ps *State) Quit(addr byteutils.Hash) error {
+	if _, err := ps.participantTrie.Del(addr); err != nil {
+		return err
+	}
+	return ps.unbond(addr, ps.timestamp)
+}
+
+// unbond keep the deposit of addr locked until one dynasty after the dynasty at timestamp ends.
+func (ps *State) unbond(addr byteutils.Hash, timestamp int64) error {
+	interval := ps.params.DynastyIntervalInMs
+	until := (timestamp*SecondInMs/interval + 2) * interval / SecondInMs
+	if locked, err := ps.unbondingUntil(addr); err != nil || locked >= until {
+		return err
+	}
+	_, err := ps.unbondingTrie.Put(addr, byteutils.FromInt64(until))
+	return err
+}
+
+func (ps *State) unbondingUntil(addr byteutils.Hash) (int64, error) {
+	value, err := ps.unbondingTrie.Get(addr)
+	if err != nil {
+		if err == storage.ErrKeyNotFound {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return byteutils.Int64(value), nil
+}
+
+// IsUnbonding return true if the deposit of addr is still locked for the dynasties it quit or served in
+func (ps *State) IsUnbonding(addr byteutils.Hash) (bool, error) {
+	until, err := ps.unbondingUntil(addr)
+	if err != nil {
+		return false, err
+	}
+	return ps.timestamp < until, nil
+}
+
+// DepositOf return the locked deposit of addr
+func (ps *State) DepositOf(addr byteutils.Hash) (*util.Uint128, error) {
+	value, err := ps.depositTrie.Get(addr)
+	if err != nil {
+		if err == storage.ErrKeyNotFound {
+			return util.NewUint128(), nil
+		}
+		return nil, err
+	}
+	return util.NewUint128FromFixedSizeByteSlice(value)
+}
+
+// Withdraw unlock the whole deposit of addr
+func (ps *State) Withdraw(addr byteutils.Hash) (*util.Uint128, error) {
+	locked, err := ps.DepositOf(addr)
+	if err != nil {
+		return nil, err
+	}
+	if locked.Cmp(util.NewUint128()) == 0 {
+		return locked, nil
+	}
+	if _, err := ps.depositTrie.Del(addr); err != nil {
+		return nil, err
+	}
+	if _, err := ps.unbondingTrie.Del(addr); err != nil && err != storage.ErrKeyNotFound {
+		return nil, err
+	}
+	return locked, nil
+}
+
+// IsSlashed return true if the addr is not eligible for dynasties
+func (ps *State) IsSlashed(addr byteutils.Hash) (bool, error) {
+	return contains(ps.slashedTrie, addr)
+}
+
+// Slash confiscate the deposit of addr, and make it ineligible for subsequent dynasties
+func (ps *State) Slash(addr byteutils.Hash) (*util.Uint128, error) {
+	slashed, err := ps.Withdraw(addr)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := ps.participantTrie.Del(addr); err != nil {
+		return nil, err
+	}
+	if _, err := ps.slashedTrie.Put(addr, addr); err != nil {
+		return nil, err
+	}
+	return slashed, nil
+}
+
+type rank struct {
+	participant byteutils.Hash
+	score       *util.Uint128
+}
+
+// electDynasty elect the participants with highest score as the new dynasty. The seats
+// without elected participants are kept by the members of current dynasty who neither
+// quit nor got slashed, the others keep their seats only if there is no one else.
+func (ps *State) electDynasty(parent byteutils.Hash) error {
+	members, err := ps.Dynasty()
+	if err != nil {
+		return err
+	}
+	eligible := []byteutils.Hash{}
+	retired := []byteutils.Hash{}
+	for _, member := range members {
+		ok, err := ps.isEligibleMember(member)
+		if err != nil {
+			return err
+		}
+		if ok {
+			eligible = append(eligible, member)
+		} else {
+			retired = append(retired, member)
+		}
+	}
+
+	ranks, err := ps.rankParticipants(parent)
+	if err != nil {
+		return err
+	}
+
+	elected := make(map[byteutils.HexHash]bool)
+	dynasty := []byteutils.Hash{}
+	elect := func(members []byteutils.Hash) {
+		for _, member := range members {
+			if len(dynasty) >= ps.params.DynastySize {
+				return
+			}
+			if !elected[member.Hex()] {
+				elected[member.Hex()] = true
+				dynasty = append(dynasty, member)
+			}
+		}
+	}
+	for _, r := range ranks {
+		elect([]byteutils.Hash{r.participant})
+	}
+	elect(eligible)
+	if len(dynasty) == 0 {
+		elect(retired)
+	}
+
+	for _, member := range members {
+		if _, err := ps.dynastyTrie.Del(member); err != nil {
+			return err
+		}
+	}
+	for _, member := range dynasty {
+		if _, err := ps.dynastyTrie.Put(member, member); err != nil {
+			return err
+		}
+		// the deposit of member stays locked for one dynasty after the new dynasty.
+		deposit, err := ps.DepositOf(member)
+		if err != nil {
+			return err
+		}
+		if deposit.Cmp(util.NewUint128()) > 0 {
+			if err := ps.unbond(member, ps.timestamp); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// isEligibleMember return false if the member is slashed or has quit the participants,
+// the genesis members without deposit are always eligible.
+func (ps *State) isEligibleMember(member byteutils.Hash) (bool, error) {
+	slashed, err := ps.IsSlashed(member)
+	if err != nil || slashed {
+		return false, err
+	}
+	participant, err := ps.IsParticipant(member)
+	if err != nil || participant {
+		return participant, err
+	}
+	deposit, err := ps.DepositOf(member)
+	if err != nil {
+		return false, err
+	}
+	return deposit.Cmp(util.NewUint128()) == 0, nil
+}
+
+// rankParticipants return the participants with enough deposit, sorted by score in descending order.
+func (ps *State) rankParticipants(parent byteutils.Hash) ([]*rank, error) {
+	participants, err := traverse(ps.participantTrie)
+	if err != nil {
+		return nil, err
+	}
+	ranks := make([]*rank, 0, len(participants))
+	for _, participant := range participants {
+		deposit, err := ps.DepositOf(participant)
+		if err != nil {
+			return nil, err
+		}
+		if deposit.Cmp(ps.params.MinDeposit) < 0 {
+			continue
+		}
+		score, err := ps.ranker.Score(parent, participant, deposit, ps.timestamp)
+		if err != nil {
+			return nil, err
+		}
+		ranks = append(ranks, &rank{participant: participant, score: score})
+	}
+	sort.Slice(ranks, func(i, j int) bool {
+		if c := ranks[i].score.Cmp(ranks[j].score); c != 0 {
+			return c > 0
+		}
+		return bytes.Compare(ranks[i].participant, ranks[j].participant) < 0
+	})
+	return ranks, nil
+}
+
+func contains(t *trie.Trie, key byteutils.Hash) (bool, error) {
+	if _, err := t.Get(key); err != nil {
+		if err == storage.ErrKeyNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// traverse return the values of all entries in the trie
+func traverse(t *trie.Trie) ([]byteutils.Hash, error) {
+	values := []byteutils.Hash{}
+	iter, err := t.Iterator(nil)
+	if err != nil && err != storage.ErrKeyNotFound {
+		return nil, err
+	}
+	if err != nil {
+		return values, nil
+	}
+	exist, err := iter.Next()
+	for exist {
+		values = append(values, iter.Value())
+		exist, err = iter.Next()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return values, nil
+}
@@ -0,0 +1,492 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package pod
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/account"
+	"github.com/nebulasio/go-nebulas/consensus/finality"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testDynasty = []string{
+		"n1FF1nz6tarkDVwWQkMnnwFPuPKUaQTdptE",
+		"n1GmkKH6nBMw4rrjt16RrJ9WcgvKUtAZP1s",
+		"n1H4MYms9F55ehcvygwWE71J8tJC4CRr2so",
+	}
+	testCandidate  = "n1JAy4X6KKLCNiTd7MWMRsVBjgdVq5WCCpf"
+	testOutsider   = "n1LkDi2gGMqPrjYcczUiweyP4RxTB6Go1qS"
+	testMinDeposit = "1000000000000000000000"
+
+	// a dynasty lasts 10 slots.
+	testDynastyIntervalInMs = 10 * BlockIntervalInMs
+)
+
+type Neb struct {
+	config    *nebletpb.Config
+	chain     *core.BlockChain
+	ns        net.Service
+	am        *account.Manager
+	genesis   *corepb.Genesis
+	storage   storage.Storage
+	consensus core.Consensus
+	emitter   *core.EventEmitter
+	nvm       core.NVM
+}
+
+func mockNeb(t *testing.T) *Neb {
+	storage, _ := storage.NewMemoryStorage()
+	eventEmitter := core.NewEventEmitter(1024)
+	pod := NewPod()
+	distribution := []*corepb.GenesisTokenDistribution{}
+	for _, v := range append(testDynasty, testCandidate, testOutsider) {
+		distribution = append(distribution, &corepb.GenesisTokenDistribution{
+			Address: v,
+			Value:   "5000000000000000000000000",
+		})
+	}
+	neb := &Neb{
+		genesis: &corepb.Genesis{
+			Meta: &corepb.GenesisMeta{ChainId: 0},
+			Consensus: &corepb.GenesisConsensus{
+				Engine: &corepb.GenesisConsensus_Pod{
+					Pod: &corepb.GenesisConsensusPod{
+						Dynasty:             testDynasty,
+						MinDeposit:          testMinDeposit,
+						DynastyIntervalInMs: testDynastyIntervalInMs,
+						DynastySize:         3,
+					},
+				},
+			},
+			TokenDistribution: distribution,
+		},
+		storage:   storage,
+		emitter:   eventEmitter,
+		consensus: pod,
+		nvm:       nvm.NewNebulasVM(),
+		config: &nebletpb.Config{
+			Chain: &nebletpb.ChainConfig{
+				ChainId:    0,
+				Keydir:     "keydir",
+				StartMine:  true,
+				Coinbase:   testOutsider,
+				Miner:      testDynasty[0],
+				Passphrase: "passphrase",
+				Consensus:  "pod",
+			},
+		},
+		ns: mockNetService{},
+	}
+
+	am, _ := account.NewManager(neb)
+	neb.am = am
+
+	chain, err := core.NewBlockChain(neb)
+	assert.Nil(t, err)
+	neb.chain = chain
+	assert.Nil(t, pod.Setup(neb))
+	assert.Nil(t, chain.Setup(neb))
+
+	eventEmitter.Start()
+	return neb
+}
+
+func (n *Neb) Config() *nebletpb.Config {
+	return n.config
+}
+
+func (n *Neb) BlockChain() *core.BlockChain {
+	return n.chain
+}
+
+func (n *Neb) NetService() net.Service {
+	return n.ns
+}
+
+func (n *Neb) IsActiveSyncing() bool {
+	return true
+}
+
+func (n *Neb) AccountManager() core.AccountManager {
+	return n.am
+}
+
+func (n *Neb) Genesis() *corepb.Genesis {
+	return n.genesis
+}
+
+func (n *Neb) SetGenesis(genesis *corepb.Genesis) {
+	n.genesis = genesis
+}
+
+func (n *Neb) Storage() storage.Storage {
+	return n.storage
+}
+
+func (n *Neb) EventEmitter() *core.EventEmitter {
+	return n.emitter
+}
+
+func (n *Neb) Consensus() core.Consensus {
+	return n.consensus
+}
+
+func (n *Neb) Nvm() core.NVM {
+	return n.nvm
+}
+
+func (n *Neb) StartActiveSync() {}
+
+func (n *Neb) StartPprof(string) error { return nil }
+
+type mockNetService struct{}
+
+func (n mockNetService) Start() error { return nil }
+func (n mockNetService) Stop()        {}
+
+func (n mockNetService) Node() *net.Node { return nil }
+
+func (n mockNetService) Sync(net.Serializable) error { return nil }
+
+func (n mockNetService) Register(...*net.Subscriber)   {}
+func (n mockNetService) Deregister(...*net.Subscriber) {}
+
+func (n mockNetService) Broadcast(name string, msg net.Serializable, priority int) {}
+func (n mockNetService) Relay(name string, msg net.Serializable, priority int)     {}
+func (n mockNetService) SendMsg(name string, msg []byte, target string, priority int) error {
+	return nil
+}
+
+func (n mockNetService) SendMessageToPeers(messageName string, data []byte, priority int, filter net.PeerFilterAlgorithm) []string {
+	return make([]string, 0)
+}
+func (n mockNetService) SendMessageToPeer(messageName string, data []byte, priority int, peerID string) error {
+	return nil
+}
+
+func (n mockNetService) ClosePeer(peerID string, reason error) {}
+
+func (n mockNetService) BroadcastNetworkID([]byte) {}
+
+func getUnlockAddress(t *testing.T, am *account.Manager, addr string) *core.Address {
+	address, err := core.AddressParse(addr)
+	assert.Nil(t, err)
+	assert.Nil(t, am.Unlock(address, []byte("passphrase"), time.Second*60*60*24*365))
+	return address
+}
+
+// slotOf return the first slot after tail proposed by the member
+func slotOf(t *testing.T, tail *core.Block, member *core.Address) int64 {
+	for i := int64(1); ; i++ {
+		elapsed := i * BlockIntervalInMs / SecondInMs
+		consensusState, err := tail.WorldState().NextConsensusState(elapsed)
+		assert.Nil(t, err)
+		if consensusState.Proposer().Equals(member.Bytes()) {
+			return tail.Timestamp() + elapsed
+		}
+	}
+}
+
+func mockBlock(t *testing.T, neb *Neb, proposer *core.Address, signer *core.Address, txs ...*core.Transaction) *core.Block {
+	tail := neb.chain.TailBlock()
+	slot := slotOf(t, tail, proposer)
+	consensusState, err := tail.WorldState().NextConsensusStateOf(tail.Hash(), slot-tail.Timestamp())
+	assert.Nil(t, err)
+	block, err := core.NewBlock(neb.chain.ChainID(), signer, tail)
+	assert.Nil(t, err)
+	block.WorldState().SetConsensusState(consensusState)
+	block.SetTimestamp(slot)
+	for _, tx := range txs {
+		assert.Nil(t, neb.chain.TransactionPool().Push(tx))
+	}
+	block.CollectTransactions((time.Now().Unix() + 1) * SecondInMs)
+	assert.Equal(t, len(txs), len(block.Transactions()))
+	assert.Nil(t, block.Seal())
+	assert.Nil(t, neb.am.SignBlock(signer, block))
+	return block
+}
+
+// nextProposer return the proposer of the first slot after tail
+func nextProposer(t *testing.T, neb *Neb) *core.Address {
+	consensusState, err := neb.chain.TailBlock().WorldState().NextConsensusState(BlockIntervalInMs / SecondInMs)
+	assert.Nil(t, err)
+	proposer, err := core.AddressParseFromBytes(consensusState.Proposer())
+	assert.Nil(t, err)
+	return proposer
+}
+
+func mockNextBlock(t *testing.T, neb *Neb, txs ...*core.Transaction) *core.Block {
+	proposer := nextProposer(t, neb)
+	block := mockBlock(t, neb, proposer, proposer, txs...)
+	assert.Nil(t, neb.chain.BlockPool().Push(block))
+	assert.Equal(t, block.Hash(), neb.chain.TailBlock().Hash())
+	return block
+}
+
+func mockTx(t *testing.T, neb *Neb, from *core.Address, txType string, data []byte, value *util.Uint128) *core.Transaction {
+	acc, err := neb.chain.TailBlock().WorldState().GetOrCreateUserAccount(from.Bytes())
+	assert.Nil(t, err)
+	gasLimit, _ := util.NewUint128FromInt(200000)
+	tx, err := core.NewTransaction(neb.chain.ChainID(), from, from, value, acc.Nonce()+1,
+		txType, data, core.TransactionGasPrice, gasLimit)
+	assert.Nil(t, err)
+	assert.Nil(t, neb.am.SignTransaction(from, tx))
+	return tx
+}
+
+func mockDevotionTx(t *testing.T, neb *Neb, from *core.Address, action string, value *util.Uint128) *core.Transaction {
+	payload, err := core.NewDevotionPayload(action)
+	assert.Nil(t, err)
+	data, err := payload.ToBytes()
+	assert.Nil(t, err)
+	return mockTx(t, neb, from, core.TxPayloadDevotionType, data, value)
+}
+
+func depositState(t *testing.T, neb *Neb) state.DepositState {
+	deposit, err := neb.chain.TailBlock().WorldState().DepositState()
+	assert.Nil(t, err)
+	return deposit
+}
+
+func inDynasty(t *testing.T, neb *Neb, addr *core.Address) bool {
+	dynasty, err := neb.chain.TailBlock().WorldState().Dynasty()
+	assert.Nil(t, err)
+	return finality.IsMember(dynasty, addr)
+}
+
+func assertExecutionError(t *testing.T, block *core.Block, tx *core.Transaction, expected error) {
+	result, err := block.FetchExecutionResultEvent(tx.Hash())
+	assert.Nil(t, err)
+	assert.Contains(t, result.Data, expected.Error())
+}
+
+func TestPod_New(t *testing.T) {
+	neb := mockNeb(t)
+	miner := neb.config.Chain.Miner
+	neb.config.Chain.Miner += "0"
+	assert.NotNil(t, neb.Consensus().Setup(neb))
+	neb.config.Chain.Miner = miner
+	neb.genesis.Consensus.Engine = &corepb.GenesisConsensus_Dpos{}
+	assert.Equal(t, ErrMissingConfigForPod, neb.Consensus().Setup(neb))
+}
+
+func TestPod_MintBlock(t *testing.T) {
+	neb := mockNeb(t)
+	pod := neb.consensus.(*Pod)
+	chain := neb.chain
+	genesis := chain.TailBlock()
+
+	assert.Equal(t, ErrCannotMintWhenDisable, pod.mintBlock(0))
+	assert.Nil(t, pod.EnableMining("passphrase"))
+	assert.Equal(t, ErrCannotMintWhenPending, pod.mintBlock(0))
+	pod.ResumeMining()
+
+	other, err := core.AddressParse(testDynasty[1])
+	assert.Nil(t, err)
+	assert.Equal(t, ErrInvalidBlockProposer, pod.mintBlock(slotOf(t, genesis, other)))
+
+	slot := slotOf(t, genesis, pod.miner)
+	assert.Equal(t, ErrWaitingForNextSlot, pod.mintBlock(slot-BlockIntervalInMs/SecondInMs+1))
+	assert.Nil(t, pod.mintBlock(slot))
+	tail := chain.TailBlock()
+	assert.Equal(t, uint64(2), tail.Height())
+	assert.Equal(t, slot, tail.Timestamp())
+	assert.Equal(t, byteutils.Hash(pod.miner.Bytes()), tail.ConsensusRoot().Proposer)
+	assert.Equal(t, ErrBlockMintedInNextSlot, pod.mintBlock(slot))
+}
+
+func TestVerifyBlock(t *testing.T) {
+	neb := mockNeb(t)
+	proposer := getUnlockAddress(t, neb.am, testDynasty[1])
+	other := getUnlockAddress(t, neb.am, testDynasty[2])
+
+	block := mockBlock(t, neb, proposer, other)
+	assert.Equal(t, ErrInvalidBlockProposer, neb.consensus.VerifyBlock(block))
+
+	block = mockBlock(t, neb, proposer, proposer)
+	assert.Nil(t, neb.consensus.VerifyBlock(block))
+	assert.Nil(t, neb.chain.BlockPool().Push(block))
+	assert.Equal(t, block.Hash(), neb.chain.TailBlock().Hash())
+
+	tail := neb.chain.TailBlock()
+	_, err := tail.WorldState().NextConsensusState(BlockIntervalInMs/SecondInMs + 1)
+	assert.Equal(t, ErrNotBlockForgTime, err)
+}
+
+func TestDevotionElection(t *testing.T) {
+	height := core.DevotionAvailableHeight
+	core.DevotionAvailableHeight = core.LocalDevotionAvailableHeight
+	defer func() { core.DevotionAvailableHeight = height }()
+
+	neb := mockNeb(t)
+	for _, v := range testDynasty {
+		getUnlockAddress(t, neb.am, v)
+	}
+	candidate := getUnlockAddress(t, neb.am, testCandidate)
+	outsider := getUnlockAddress(t, neb.am, testOutsider)
+	minDeposit, err := util.NewUint128FromString(testMinDeposit)
+	assert.Nil(t, err)
+	// mint a block so that devotion transactions are available.
+	mockNextBlock(t, neb)
+
+	join := mockDevotionTx(t, neb, candidate, core.JoinAction, minDeposit)
+	poor := mockDevotionTx(t, neb, outsider, core.JoinAction, util.NewUint128FromUint(1))
+	block := mockNextBlock(t, neb, join, poor)
+	assertExecutionError(t, block, poor, core.ErrInsufficientDeposit)
+	participant, err := depositState(t, neb).IsParticipant(candidate.Bytes())
+	assert.Nil(t, err)
+	assert.True(t, participant)
+	deposit, err := depositState(t, neb).DepositOf(candidate.Bytes())
+	assert.Nil(t, err)
+	assert.Equal(t, minDeposit, deposit)
+	deposit, err = depositState(t, neb).DepositOf(outsider.Bytes())
+	assert.Nil(t, err)
+	assert.Equal(t, util.NewUint128(), deposit)
+
+	// the candidate is elected in the next dynasty and proposes in its turn.
+	assert.False(t, inDynasty(t, neb, candidate))
+	block = mockBlock(t, neb, candidate, candidate)
+	assert.Nil(t, neb.chain.BlockPool().Push(block))
+	assert.Equal(t, block.Hash(), neb.chain.TailBlock().Hash())
+	assert.True(t, inDynasty(t, neb, candidate))
+	dynasty, err := block.WorldState().Dynasty()
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(dynasty))
+
+	withdraw := mockDevotionTx(t, neb, candidate, core.WithdrawAction, util.NewUint128())
+	quit := mockDevotionTx(t, neb, outsider, core.QuitAction, util.NewUint128())
+	block = mockNextBlock(t, neb, withdraw, quit)
+	assertExecutionError(t, block, withdraw, core.ErrWithdrawFromParticipant)
+	assertExecutionError(t, block, quit, core.ErrQuitFromNonParticipant)
+
+	// the deposit stays locked while the candidate is in dynasty.
+	mockNextBlock(t, neb, mockDevotionTx(t, neb, candidate, core.QuitAction, util.NewUint128()))
+	participant, err = depositState(t, neb).IsParticipant(candidate.Bytes())
+	assert.Nil(t, err)
+	assert.False(t, participant)
+	withdraw = mockDevotionTx(t, neb, candidate, core.WithdrawAction, util.NewUint128())
+	block = mockNextBlock(t, neb, withdraw)
+	assertExecutionError(t, block, withdraw, core.ErrWithdrawInDynasty)
+
+	// the candidate leaves in the next dynasty, the deposit unbonds for one more dynasty.
+	for inDynasty(t, neb, candidate) {
+		mockNextBlock(t, neb)
+	}
+	withdraw = mockDevotionTx(t, neb, candidate, core.WithdrawAction, util.NewUint128())
+	block = mockNextBlock(t, neb, withdraw)
+	assertExecutionError(t, block, withdraw, core.ErrWithdrawInUnbonding)
+	for {
+		unbonding, err := depositState(t, neb).IsUnbonding(candidate.Bytes())
+		assert.Nil(t, err)
+		if !unbonding {
+			break
+		}
+		mockNextBlock(t, neb)
+	}
+	acc, err := neb.chain.TailBlock().WorldState().GetOrCreateUserAccount(candidate.Bytes())
+	assert.Nil(t, err)
+	balance := acc.Balance()
+	mockNextBlock(t, neb, mockDevotionTx(t, neb, candidate, core.WithdrawAction, util.NewUint128()))
+	deposit, err = depositState(t, neb).DepositOf(candidate.Bytes())
+	assert.Nil(t, err)
+	assert.Equal(t, util.NewUint128(), deposit)
+	acc, err = neb.chain.TailBlock().WorldState().GetOrCreateUserAccount(candidate.Bytes())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, acc.Balance().Cmp(balance))
+
+	withdraw = mockDevotionTx(t, neb, candidate, core.WithdrawAction, util.NewUint128())
+	block = mockNextBlock(t, neb, withdraw)
+	assertExecutionError(t, block, withdraw, core.ErrNoDepositToWithdraw)
+}
+
+func TestDepositSlashing(t *testing.T) {
+	devotion, election := core.DevotionAvailableHeight, core.ElectionAvailableHeight
+	core.DevotionAvailableHeight, core.ElectionAvailableHeight = core.LocalDevotionAvailableHeight, core.LocalElectionAvailableHeight
+	defer func() { core.DevotionAvailableHeight, core.ElectionAvailableHeight = devotion, election }()
+
+	neb := mockNeb(t)
+	for _, v := range testDynasty {
+		getUnlockAddress(t, neb.am, v)
+	}
+	candidate := getUnlockAddress(t, neb.am, testCandidate)
+	reporter := getUnlockAddress(t, neb.am, testOutsider)
+	minDeposit, err := util.NewUint128FromString(testMinDeposit)
+	assert.Nil(t, err)
+	mockNextBlock(t, neb)
+	mockNextBlock(t, neb, mockDevotionTx(t, neb, candidate, core.JoinAction, minDeposit))
+	block := mockBlock(t, neb, candidate, candidate)
+	assert.Nil(t, neb.chain.BlockPool().Push(block))
+
+	// the candidate mints two blocks in its next slot.
+	tail := neb.chain.TailBlock()
+	slot := slotOf(t, tail, candidate)
+	blocks := []*core.Block{}
+	for _, v := range testDynasty[:2] {
+		coinbase, err := core.AddressParse(v)
+		assert.Nil(t, err)
+		consensusState, err := tail.WorldState().NextConsensusState(slot - tail.Timestamp())
+		assert.Nil(t, err)
+		block, err := core.NewBlock(neb.chain.ChainID(), coinbase, tail)
+		assert.Nil(t, err)
+		block.WorldState().SetConsensusState(consensusState)
+		block.SetTimestamp(slot)
+		assert.Nil(t, block.Seal())
+		assert.Nil(t, neb.am.SignBlock(candidate, block))
+		blocks = append(blocks, block)
+	}
+	evidence, err := core.NewDoubleMintEvidence(blocks[0], blocks[1])
+	assert.Nil(t, err)
+	payload, err := core.NewEvidencePayload(evidence)
+	assert.Nil(t, err)
+	data, err := payload.ToBytes()
+	assert.Nil(t, err)
+	report := mockTx(t, neb, reporter, core.TxPayloadEvidenceType, data, util.NewUint128())
+	block = mockNextBlock(t, neb, report)
+
+	events, err := block.FetchEvents(report.Hash())
+	assert.Nil(t, err)
+	assert.Equal(t, core.TopicDoubleMint, events[0].Topic)
+	assert.Contains(t, events[0].Data, `"slashed":"`+testMinDeposit+`"`)
+	slashed, err := depositState(t, neb).IsSlashed(candidate.Bytes())
+	assert.Nil(t, err)
+	assert.True(t, slashed)
+	deposit, err := depositState(t, neb).DepositOf(candidate.Bytes())
+	assert.Nil(t, err)
+	assert.Equal(t, util.NewUint128(), deposit)
+
+	// the slashed candidate leaves in the next dynasty, and can't join again.
+	for inDynasty(t, neb, candidate) {
+		mockNextBlock(t, neb)
+	}
+	join := mockDevotionTx(t, neb, candidate, core.JoinAction, minDeposit)
+	block = mockNextBlock(t, neb, join)
+	assertExecutionError(t, block, join, core.ErrJoinFromSlashed)
+}
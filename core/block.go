@@ -54,6 +54,9 @@ var (
 	// rule: 3% per year, 3,000,000. 1 block per 15 seconds
 	// value: 10^8 * 3% / (365*24*3600/15) * 10^18 ≈ 1.42694 * 10^18
 	BlockReward, _ = util.NewUint128FromString("1426940000000000000")
+
+	// MaxRecentBlockCount the count of recent blocks readable by contracts.
+	MaxRecentBlockCount uint64 = 256
 )
 
 // BlockHeader of a block
@@ -139,14 +142,16 @@ type Block struct {
 	transactions Transactions
 	dependency   *dag.Dag
 
-	sealed bool
-	height uint64
+	sealed    bool
+	simulated bool
+	height    uint64
 
 	worldState state.WorldState
 
 	txPool       *TransactionPool
 	eventEmitter *EventEmitter
 	nvm          NVM
+	dip          Dip
 	storage      storage.Storage
 }
 
@@ -251,6 +256,7 @@ func NewBlock(chainID uint32, coinbase *Address, parent *Block) (*Block, error)
 		txPool:       parent.txPool,
 		eventEmitter: parent.eventEmitter,
 		nvm:          parent.nvm,
+		dip:          parent.dip,
 		storage:      parent.storage,
 	}
 
@@ -399,6 +405,27 @@ func (block *Block) DateAvailable() bool {
 	return block.height >= DateAvailableHeight
 }
 
+// RecentBlock returns the ancestor of the block at the height, which must be in the recent MaxRecentBlockCount blocks.
+func (block *Block) RecentBlock(height uint64) (*Block, error) {
+	if height == 0 || height >= block.height || block.height-height > MaxRecentBlockCount {
+		return nil, ErrInvalidRecentBlockHeight
+	}
+	if block.txPool == nil || block.txPool.bc == nil {
+		return nil, ErrNilArgument
+	}
+	bc := block.txPool.bc
+
+	// walk back by parent hash, so the ancestor is the same whether the block is on canonical chain or not.
+	ancestor := bc.GetBlock(block.ParentHash())
+	for i := uint64(1); ancestor != nil && ancestor.height > height && i < MaxRecentBlockCount; i++ {
+		ancestor = bc.GetBlock(ancestor.ParentHash())
+	}
+	if ancestor == nil || ancestor.height != height {
+		return nil, ErrMissingParentBlock
+	}
+	return ancestor, nil
+}
+
 // LinkParentBlock link parent block, return true if hash is the same; false otherwise.
 func (block *Block) LinkParentBlock(chain *BlockChain, parentBlock *Block) error {
 	if !block.ParentHash().Equals(parentBlock.Hash()) {
@@ -411,7 +438,7 @@ func (block *Block) LinkParentBlock(chain *BlockChain, parentBlock *Block) error
 	}
 
 	elapsedSecond := block.Timestamp() - parentBlock.Timestamp()
-	consensusState, err := parentBlock.worldState.NextConsensusState(elapsedSecond)
+	consensusState, err := parentBlock.worldState.NextConsensusStateOf(parentBlock.Hash(), elapsedSecond)
 	if err != nil {
 		return err
 	}
@@ -422,6 +449,7 @@ func (block *Block) LinkParentBlock(chain *BlockChain, parentBlock *Block) error
 	block.storage = parentBlock.storage
 	block.eventEmitter = parentBlock.eventEmitter
 	block.nvm = parentBlock.nvm
+	block.dip = parentBlock.dip
 
 	return nil
 }
@@ -761,6 +789,11 @@ func (block *Block) Sealed() bool {
 	return block.sealed
 }
 
+// Simulated return if the block is a sandbox of simulating transaction execution, it is never sealed.
+func (block *Block) Simulated() bool {
+	return block.simulated
+}
+
 // Seal seal block, calculate stateRoot and block hash.
 func (block *Block) Seal() error {
 	if block.sealed {
@@ -1207,34 +1240,43 @@ func (block *Block) GetTransaction(hash byteutils.Hash) (*Transaction, error) {
 
 // CalHash calculate the hash of block.
 func (block *Block) calHash() (byteutils.Hash, error) {
-	hasher := sha3.New256()
-
-	consensusRoot, err := proto.Marshal(block.ConsensusRoot())
+	pbDep, err := block.dependency.ToProto()
 	if err != nil {
 		return nil, err
 	}
+	txHashes := make([][]byte, len(block.transactions))
+	for idx, tx := range block.transactions {
+		txHashes[idx] = tx.Hash()
+	}
+	return hashBlock(block.header, pbDep, txHashes)
+}
 
-	pbDep, err := block.dependency.ToProto()
+// hashBlock return the hash of block made up of the header, dependency and tx hashes.
+func hashBlock(header *BlockHeader, pbDep proto.Message, txHashes [][]byte) (byteutils.Hash, error) {
+	hasher := sha3.New256()
+
+	consensusRoot, err := proto.Marshal(header.consensusRoot)
 	if err != nil {
 		return nil, err
 	}
+
 	dependency, err := proto.Marshal(pbDep)
 	if err != nil {
 		return nil, err
 	}
 
-	hasher.Write(block.ParentHash())
-	hasher.Write(block.StateRoot())
-	hasher.Write(block.TxsRoot())
-	hasher.Write(block.EventsRoot())
+	hasher.Write(header.parentHash)
+	hasher.Write(header.stateRoot)
+	hasher.Write(header.txsRoot)
+	hasher.Write(header.eventsRoot)
 	hasher.Write(consensusRoot)
 	hasher.Write(dependency)
-	hasher.Write(block.header.coinbase.address)
-	hasher.Write(byteutils.FromInt64(block.header.timestamp))
-	hasher.Write(byteutils.FromUint32(block.header.chainID))
+	hasher.Write(header.coinbase.address)
+	hasher.Write(byteutils.FromInt64(header.timestamp))
+	hasher.Write(byteutils.FromUint32(header.chainID))
 
-	for _, tx := range block.transactions {
-		hasher.Write(tx.Hash())
+	for _, hash := range txHashes {
+		hasher.Write(hash)
 	}
 
 	return hasher.Sum(nil), nil
@@ -1255,7 +1297,7 @@ func LoadBlockFromStorage(hash byteutils.Hash, chain *BlockChain) (*Block, error
 		return nil, ErrNilArgument
 	}
 
-	value, err := chain.storage.Get(hash)
+	value, err := chain.blockStorage.Get(hash)
 	if err != nil {
 		return nil, err
 	}
@@ -1287,6 +1329,7 @@ func LoadBlockFromStorage(hash byteutils.Hash, chain *BlockChain) (*Block, error
 	block.txPool = chain.txPool
 	block.eventEmitter = chain.eventEmitter
 	block.nvm = chain.nvm
+	block.dip = chain.dip
 	block.storage = chain.storage
 	return block, nil
 }
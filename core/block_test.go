@@ -79,8 +79,10 @@ func MockGenesisConf() *corepb.Genesis {
 	return &corepb.Genesis{
 		Meta: &corepb.GenesisMeta{ChainId: 100},
 		Consensus: &corepb.GenesisConsensus{
-			Dpos: &corepb.GenesisConsensusDpos{
-				Dynasty: MockDynasty,
+			Engine: &corepb.GenesisConsensus_Dpos{
+				Dpos: &corepb.GenesisConsensusDpos{
+					Dynasty: MockDynasty,
+				},
 			},
 		},
 		TokenDistribution: []*corepb.GenesisTokenDistribution{
@@ -329,6 +331,9 @@ func (nvm *mockNvm) CreateEngine(block *Block, tx *Transaction, contract state.A
 func (nvm *mockNvm) CheckV8Run() error {
 	return nil
 }
+func (nvm *mockNvm) PublicFunctions(source, sourceType string) ([]string, error) {
+	return []string{}, nil
+}
 
 func (nvm *mockEngine) Dispose() {
 
@@ -742,3 +747,70 @@ func TestBlock_String(t *testing.T) {
 	bc := neb.chain
 	assert.NotNil(t, bc.genesisBlock.String())
 }
+
+func TestBlock_RecentBlock(t *testing.T) {
+	neb := testNeb(t)
+	bc := neb.chain
+
+	coinbase11, _ := AddressParse("n1GmkKH6nBMw4rrjt16RrJ9WcgvKUtAZP1s")
+	coinbase12, _ := AddressParse("n1FF1nz6tarkDVwWQkMnnwFPuPKUaQTdptE")
+
+	block0, err := bc.NewBlock(coinbase11)
+	assert.Nil(t, err)
+	block0.header.timestamp = BlockInterval
+	assert.Nil(t, block0.Seal())
+	signBlock(block0)
+	assert.Nil(t, bc.BlockPool().Push(block0))
+
+	/*
+		genesis -- 0 -- 11
+		             \_ 12
+	*/
+	block11, err := bc.NewBlock(coinbase11)
+	assert.Nil(t, err)
+	block11.header.timestamp = BlockInterval * 2
+	block12, err := bc.NewBlock(coinbase12)
+	assert.Nil(t, err)
+	block12.header.timestamp = BlockInterval * 3
+	assert.Nil(t, block11.Seal())
+	signBlock(block11)
+	assert.Nil(t, block12.Seal())
+	signBlock(block12)
+	assert.Nil(t, bc.BlockPool().Push(block11))
+	assert.Nil(t, bc.BlockPool().Push(block12))
+
+	tail := bc.TailBlock()
+	fork := block11
+	if tail.Hash().Equals(block11.Hash()) {
+		fork = block12
+	}
+
+	// the block on canonical chain reads the canonical ancestors.
+	block, err := bc.NewBlock(coinbase11)
+	assert.Nil(t, err)
+	ancestor, err := block.RecentBlock(tail.Height())
+	assert.Nil(t, err)
+	assert.Equal(t, tail.Hash(), ancestor.Hash())
+	ancestor, err = block.RecentBlock(block0.Height())
+	assert.Nil(t, err)
+	assert.Equal(t, block0.Hash(), ancestor.Hash())
+	ancestor, err = block.RecentBlock(bc.genesisBlock.Height())
+	assert.Nil(t, err)
+	assert.Equal(t, bc.genesisBlock.Hash(), ancestor.Hash())
+
+	// the block on fork reads its own ancestors.
+	forkBlock, err := bc.NewBlockFromParent(coinbase12, fork)
+	assert.Nil(t, err)
+	ancestor, err = forkBlock.RecentBlock(fork.Height())
+	assert.Nil(t, err)
+	assert.Equal(t, fork.Hash(), ancestor.Hash())
+	ancestor, err = forkBlock.RecentBlock(block0.Height())
+	assert.Nil(t, err)
+	assert.Equal(t, block0.Hash(), ancestor.Hash())
+
+	// the block itself, the future and height 0 are invalid.
+	for _, height := range []uint64{0, block.Height(), block.Height() + 1} {
+		_, err = block.RecentBlock(height)
+		assert.Equal(t, ErrInvalidRecentBlockHeight, err)
+	}
+}
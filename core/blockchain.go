@@ -36,12 +36,14 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-// storage: key -> value
-// scheme -> scheme version
-// genesis hash -> genesis block
-// blockchain_tail -> tail block hash
-// block hash -> block
-// height -> block hash
+// storage: keyspace: key -> value
+// meta: scheme -> scheme version
+// meta: blockchain_tail -> tail block hash
+// meta: blockchain_lib -> lib block hash
+// block: genesis hash -> genesis block
+// block: block hash -> block
+// index: height -> block hash
+// state: trie node hash -> trie node
 
 // BlockChain the BlockChain core type.
 type BlockChain struct {
@@ -64,11 +66,18 @@ type BlockChain struct {
 	// latest irreversible block
 	lib *Block
 
-	storage storage.Storage
+	// storage of world state
+	storage      storage.Storage
+	blockStorage storage.Storage
+	indexStorage storage.Storage
+	metaStorage  storage.Storage
+
+	finalityStorage storage.Storage
 
 	eventEmitter *EventEmitter
 
 	nvm NVM
+	dip Dip
 
 	quitCh chan int
 
@@ -90,6 +99,9 @@ const (
 
 	// LIB (latest irreversible block) in storage
 	LIB = "blockchain_lib"
+
+	// GenesisConf the genesis configuration the chain is created with in storage
+	GenesisConf = "blockchain_genesis"
 )
 
 // NewBlockChain create new #BlockChain instance.
@@ -134,12 +146,21 @@ func NewBlockChain(neb Neblet) (*BlockChain, error) {
 	}
 	txPool.RegisterInNetwork(neb.NetService())
 
+	cs, err := newChainStorage(neb.Storage())
+	if err != nil {
+		return nil, err
+	}
+
 	var bc = &BlockChain{
 		chainID:            neb.Config().Chain.ChainId,
 		genesis:            neb.Genesis(),
 		bkPool:             blockPool,
 		txPool:             txPool,
-		storage:            neb.Storage(),
+		storage:            cs.state,
+		blockStorage:       cs.block,
+		indexStorage:       cs.index,
+		metaStorage:        cs.meta,
+		finalityStorage:    cs.finality,
 		eventEmitter:       neb.EventEmitter(),
 		nvm:                neb.Nvm(),
 		quitCh:             make(chan int, 1),
@@ -250,9 +271,9 @@ func (bc *BlockChain) CheckGenesisConfig(neb Neblet) error {
 	}
 
 	logging.CLog().WithFields(logrus.Fields{
-		"meta.chainid":           neb.Genesis().Meta.ChainId,
-		"consensus.dpos.dynasty": neb.Genesis().Consensus.Dpos.Dynasty,
-		"token.distribution":     neb.Genesis().TokenDistribution,
+		"meta.chainid":       neb.Genesis().Meta.ChainId,
+		"consensus.dynasty":  GenesisDynasty(neb.Genesis()),
+		"token.distribution": neb.Genesis().TokenDistribution,
 	}).Info("Genesis Configuration.")
 	return nil
 }
@@ -262,7 +283,7 @@ func (bc *BlockChain) ChainID() uint32 {
 	return bc.chainID
 }
 
-// Storage return the storage.
+// Storage return the storage of world state.
 func (bc *BlockChain) Storage() storage.Storage {
 	return bc.storage
 }
@@ -359,7 +380,7 @@ func (bc *BlockChain) triggerNewTailEvent(blocks []*Block) {
 func (bc *BlockChain) buildIndexByBlockHeight(from *Block, to *Block) error {
 	blocks := []*Block{}
 	for !to.Hash().Equals(from.Hash()) {
-		err := bc.storage.Put(byteutils.FromUint64(to.height), to.Hash())
+		err := bc.indexStorage.Put(byteutils.FromUint64(to.height), to.Hash())
 		if err != nil {
 			return err
 		}
@@ -431,7 +452,7 @@ func (bc *BlockChain) GetBlockOnCanonicalChainByHeight(height uint64) *Block {
 		return nil
 	}
 
-	blockHash, err := bc.storage.Get(byteutils.FromUint64(height))
+	blockHash, err := bc.indexStorage.Get(byteutils.FromUint64(height))
 	if err != nil {
 		return nil
 	}
@@ -571,6 +592,12 @@ func (bc *BlockChain) SetSyncService(syncService SyncService) {
 	bc.syncService = syncService
 }
 
+// SetDip set the developer incentive protocol, it should be set before Setup
+// so that all blocks loaded are able to execute dip txs.
+func (bc *BlockChain) SetDip(dip Dip) {
+	bc.dip = dip
+}
+
 // StartActiveSync start active sync task
 func (bc *BlockChain) StartActiveSync() bool {
 	if bc.syncService.StartActiveSync() {
@@ -689,6 +716,33 @@ func (bc *BlockChain) GetContract(addr *Address) (state.Account, error) {
 	return contract, nil
 }
 
+// GetContractSource returns the source and source type of the latest version of the contract
+func (bc *BlockChain) GetContractSource(contract state.Account) (string, string, error) {
+	worldState, err := bc.TailBlock().WorldState().Clone()
+	if err != nil {
+		return "", "", err
+	}
+	return LoadContractSource(contract, worldState)
+}
+
+// GasSchedules returns all versions of gas schedule, and the one activated at the tail block.
+func (bc *BlockChain) GasSchedules() ([]*GasSchedule, *GasSchedule, error) {
+	tail := bc.TailBlock()
+	worldState, err := tail.WorldState().Clone()
+	if err != nil {
+		return nil, nil, err
+	}
+	schedules, err := GasSchedules(worldState)
+	if err != nil {
+		return nil, nil, err
+	}
+	active, err := GasScheduleAt(worldState, tail.Height())
+	if err != nil {
+		return nil, nil, err
+	}
+	return schedules, active, nil
+}
+
 // GasPrice returns the lowest transaction gas price.
 func (bc *BlockChain) GasPrice() *util.Uint128 {
 	gasPrice := TransactionMaxGasPrice
@@ -744,6 +798,7 @@ func (bc *BlockChain) SimulateTransactionExecution(tx *Transaction) (*SimulateRe
 	_, _ = io.ReadFull(rand.Reader, sVrfProof)
 	block.header.random.VrfSeed = sVrfSeed
 	block.header.random.VrfProof = sVrfProof
+	block.simulated = true
 
 	defer block.RollBack()
 
@@ -777,7 +832,7 @@ func (bc *BlockChain) StoreBlockToStorage(block *Block) error {
 	if err != nil {
 		return err
 	}
-	err = bc.storage.Put(block.Hash(), value)
+	err = bc.blockStorage.Put(block.Hash(), value)
 	if err != nil {
 		return err
 	}
@@ -786,17 +841,17 @@ func (bc *BlockChain) StoreBlockToStorage(block *Block) error {
 
 // StoreTailHashToStorage store tail block hash
 func (bc *BlockChain) StoreTailHashToStorage(block *Block) error { // ToRefine, update func to StoreTailHashToStorage
-	return bc.storage.Put([]byte(Tail), block.Hash())
+	return bc.metaStorage.Put([]byte(Tail), block.Hash())
 }
 
 // StoreLIBHashToStorage store LIB block hash
 func (bc *BlockChain) StoreLIBHashToStorage(block *Block) error {
-	return bc.storage.Put([]byte(LIB), block.Hash())
+	return bc.metaStorage.Put([]byte(LIB), block.Hash())
 }
 
 // LoadTailFromStorage load tail block
 func (bc *BlockChain) LoadTailFromStorage() (*Block, error) {
-	hash, err := bc.storage.Get([]byte(Tail))
+	hash, err := bc.metaStorage.Get([]byte(Tail))
 	if err != nil && err != storage.ErrKeyNotFound {
 		return nil, err
 	}
@@ -830,8 +885,15 @@ func (bc *BlockChain) LoadGenesisFromStorage() (*Block, error) { // ToRefine, re
 		if err := bc.StoreBlockToStorage(genesis); err != nil {
 			return nil, err
 		}
+		conf, err := proto.Marshal(bc.genesis)
+		if err != nil {
+			return nil, err
+		}
+		if err := bc.metaStorage.Put([]byte(GenesisConf), conf); err != nil {
+			return nil, err
+		}
 		heightKey := byteutils.FromUint64(genesis.height)
-		if err := bc.storage.Put(heightKey, genesis.Hash()); err != nil {
+		if err := bc.indexStorage.Put(heightKey, genesis.Hash()); err != nil {
 			return nil, err
 		}
 	}
@@ -840,7 +902,7 @@ func (bc *BlockChain) LoadGenesisFromStorage() (*Block, error) { // ToRefine, re
 
 // LoadLIBFromStorage load LIB
 func (bc *BlockChain) LoadLIBFromStorage() (*Block, error) {
-	hash, err := bc.storage.Get([]byte(LIB))
+	hash, err := bc.metaStorage.Get([]byte(LIB))
 	if err != nil && err != storage.ErrKeyNotFound {
 		return nil, err
 	}
@@ -19,6 +19,8 @@
 package core
 
 import (
+	"math"
+
 	"github.com/nebulasio/go-nebulas/util/logging"
 	"github.com/sirupsen/logrus"
 )
@@ -53,6 +55,39 @@ const (
 
 	//LocalWdResetRecordDependencyHeight
 	LocalWsResetRecordDependencyHeight uint64 = 2
+
+	//LocalElectionAvailableHeight
+	LocalElectionAvailableHeight uint64 = 2
+
+	//LocalFinalityAvailableHeight
+	LocalFinalityAvailableHeight uint64 = 2
+
+	//LocalContractUpgradeAvailableHeight
+	LocalContractUpgradeAvailableHeight uint64 = 2
+
+	//LocalStorageIterationAvailableHeight
+	LocalStorageIterationAvailableHeight uint64 = 2
+
+	//LocalGasScheduleAvailableHeight
+	LocalGasScheduleAvailableHeight uint64 = 2
+
+	//LocalRecentBlockAvailableHeight
+	LocalRecentBlockAvailableHeight uint64 = 2
+
+	//LocalInnerContractCallAvailableHeight
+	LocalInnerContractCallAvailableHeight uint64 = 2
+
+	//LocalCryptoAvailableHeight
+	LocalCryptoAvailableHeight uint64 = 2
+
+	//LocalDipAvailableHeight
+	LocalDipAvailableHeight uint64 = 2
+
+	//LocalDevotionAvailableHeight
+	LocalDevotionAvailableHeight uint64 = 2
+
+	//LocalAuthorityAvailableHeight
+	LocalAuthorityAvailableHeight uint64 = 2
 )
 
 // TestNet
@@ -77,6 +112,39 @@ const (
 
 	//TestNetWdResetRecordDependencyHeight
 	TestNetWsResetRecordDependencyHeight uint64 = 281800
+
+	//TestNetElectionAvailableHeight, not scheduled yet
+	TestNetElectionAvailableHeight uint64 = math.MaxUint64
+
+	//TestNetFinalityAvailableHeight, not scheduled yet
+	TestNetFinalityAvailableHeight uint64 = math.MaxUint64
+
+	//TestNetContractUpgradeAvailableHeight, not scheduled yet
+	TestNetContractUpgradeAvailableHeight uint64 = math.MaxUint64
+
+	//TestNetStorageIterationAvailableHeight, not scheduled yet
+	TestNetStorageIterationAvailableHeight uint64 = math.MaxUint64
+
+	//TestNetGasScheduleAvailableHeight, not scheduled yet
+	TestNetGasScheduleAvailableHeight uint64 = math.MaxUint64
+
+	//TestNetRecentBlockAvailableHeight, not scheduled yet
+	TestNetRecentBlockAvailableHeight uint64 = math.MaxUint64
+
+	//TestNetInnerContractCallAvailableHeight, not scheduled yet
+	TestNetInnerContractCallAvailableHeight uint64 = math.MaxUint64
+
+	//TestNetCryptoAvailableHeight, not scheduled yet
+	TestNetCryptoAvailableHeight uint64 = math.MaxUint64
+
+	//TestNetDipAvailableHeight, not scheduled yet
+	TestNetDipAvailableHeight uint64 = math.MaxUint64
+
+	//TestNetDevotionAvailableHeight, not scheduled yet
+	TestNetDevotionAvailableHeight uint64 = math.MaxUint64
+
+	//TestNetAuthorityAvailableHeight, not scheduled yet
+	TestNetAuthorityAvailableHeight uint64 = math.MaxUint64
 )
 
 // MainNet
@@ -101,6 +169,39 @@ const (
 
 	//MainNetWdResetRecordDependencyHeight
 	MainNetWsResetRecordDependencyHeight uint64 = 306800
+
+	//MainNetElectionAvailableHeight, not scheduled yet
+	MainNetElectionAvailableHeight uint64 = math.MaxUint64
+
+	//MainNetFinalityAvailableHeight, not scheduled yet
+	MainNetFinalityAvailableHeight uint64 = math.MaxUint64
+
+	//MainNetContractUpgradeAvailableHeight, not scheduled yet
+	MainNetContractUpgradeAvailableHeight uint64 = math.MaxUint64
+
+	//MainNetStorageIterationAvailableHeight, not scheduled yet
+	MainNetStorageIterationAvailableHeight uint64 = math.MaxUint64
+
+	//MainNetGasScheduleAvailableHeight, not scheduled yet
+	MainNetGasScheduleAvailableHeight uint64 = math.MaxUint64
+
+	//MainNetRecentBlockAvailableHeight, not scheduled yet
+	MainNetRecentBlockAvailableHeight uint64 = math.MaxUint64
+
+	//MainNetInnerContractCallAvailableHeight, not scheduled yet
+	MainNetInnerContractCallAvailableHeight uint64 = math.MaxUint64
+
+	//MainNetCryptoAvailableHeight, not scheduled yet
+	MainNetCryptoAvailableHeight uint64 = math.MaxUint64
+
+	//MainNetDipAvailableHeight, not scheduled yet
+	MainNetDipAvailableHeight uint64 = math.MaxUint64
+
+	//MainNetDevotionAvailableHeight, not scheduled yet
+	MainNetDevotionAvailableHeight uint64 = math.MaxUint64
+
+	//MainNetAuthorityAvailableHeight, not scheduled yet
+	MainNetAuthorityAvailableHeight uint64 = math.MaxUint64
 )
 
 var (
@@ -124,6 +225,39 @@ var (
 
 	//WdResetRecordDependencyHeight if tx execute faied, worldstate reset and need to record to address dependency
 	WsResetRecordDependencyHeight = TestNetWsResetRecordDependencyHeight
+
+	// ElectionAvailableHeight candidate, delegate and evidence transactions of DPoS are available since this height
+	ElectionAvailableHeight = TestNetElectionAvailableHeight
+
+	// FinalityAvailableHeight the LIB is decided by pre-commit signatures since this height
+	FinalityAvailableHeight = TestNetFinalityAvailableHeight
+
+	// ContractUpgradeAvailableHeight contracts can be upgraded by their owners since this height
+	ContractUpgradeAvailableHeight = TestNetContractUpgradeAvailableHeight
+
+	// StorageIterationAvailableHeight the keys of contract storage maps are indexed and iterable since this height
+	StorageIterationAvailableHeight = TestNetStorageIterationAvailableHeight
+
+	// GasScheduleAvailableHeight the gas schedules are governed and activated by height since this height
+	GasScheduleAvailableHeight = TestNetGasScheduleAvailableHeight
+
+	// RecentBlockAvailableHeight the recent blocks are readable by contracts since this height
+	RecentBlockAvailableHeight = TestNetRecentBlockAvailableHeight
+
+	// InnerContractCallAvailableHeight contracts can call the functions of other contracts since this height
+	InnerContractCallAvailableHeight = TestNetInnerContractCallAvailableHeight
+
+	// CryptoAvailableHeight the 'Crypto' hash and recover functions are available in contract since this height
+	CryptoAvailableHeight = TestNetCryptoAvailableHeight
+
+	// DipAvailableHeight dip transactions of the developer incentive protocol are available since this height
+	DipAvailableHeight = TestNetDipAvailableHeight
+
+	// DevotionAvailableHeight devotion transactions of PoD are available since this height
+	DevotionAvailableHeight = TestNetDevotionAvailableHeight
+
+	// AuthorityAvailableHeight authority transactions of PoA are available since this height
+	AuthorityAvailableHeight = TestNetAuthorityAvailableHeight
 )
 
 // SetCompatibilityOptions set compatibility height according to chain_id
@@ -137,6 +271,17 @@ func SetCompatibilityOptions(chainID uint32) {
 		RecordCallContractResultHeight = MainNetRecordCallContractResultHeight
 		NvmMemoryLimitWithoutInjectHeight = MainNetNvmMemoryLimitWithoutInjectHeight
 		WsResetRecordDependencyHeight = MainNetWsResetRecordDependencyHeight
+		ElectionAvailableHeight = MainNetElectionAvailableHeight
+		FinalityAvailableHeight = MainNetFinalityAvailableHeight
+		ContractUpgradeAvailableHeight = MainNetContractUpgradeAvailableHeight
+		StorageIterationAvailableHeight = MainNetStorageIterationAvailableHeight
+		GasScheduleAvailableHeight = MainNetGasScheduleAvailableHeight
+		RecentBlockAvailableHeight = MainNetRecentBlockAvailableHeight
+		InnerContractCallAvailableHeight = MainNetInnerContractCallAvailableHeight
+		CryptoAvailableHeight = MainNetCryptoAvailableHeight
+		DipAvailableHeight = MainNetDipAvailableHeight
+		DevotionAvailableHeight = MainNetDevotionAvailableHeight
+		AuthorityAvailableHeight = MainNetAuthorityAvailableHeight
 	} else if chainID == TestNetID {
 
 		TransferFromContractEventRecordableHeight = TestNetTransferFromContractEventRecordableHeight
@@ -146,6 +291,17 @@ func SetCompatibilityOptions(chainID uint32) {
 		RecordCallContractResultHeight = TestNetRecordCallContractResultHeight
 		NvmMemoryLimitWithoutInjectHeight = TestNetNvmMemoryLimitWithoutInjectHeight
 		WsResetRecordDependencyHeight = TestNetWsResetRecordDependencyHeight
+		ElectionAvailableHeight = TestNetElectionAvailableHeight
+		FinalityAvailableHeight = TestNetFinalityAvailableHeight
+		ContractUpgradeAvailableHeight = TestNetContractUpgradeAvailableHeight
+		StorageIterationAvailableHeight = TestNetStorageIterationAvailableHeight
+		GasScheduleAvailableHeight = TestNetGasScheduleAvailableHeight
+		RecentBlockAvailableHeight = TestNetRecentBlockAvailableHeight
+		InnerContractCallAvailableHeight = TestNetInnerContractCallAvailableHeight
+		CryptoAvailableHeight = TestNetCryptoAvailableHeight
+		DipAvailableHeight = TestNetDipAvailableHeight
+		DevotionAvailableHeight = TestNetDevotionAvailableHeight
+		AuthorityAvailableHeight = TestNetAuthorityAvailableHeight
 	} else {
 
 		TransferFromContractEventRecordableHeight = LocalTransferFromContractEventRecordableHeight
@@ -155,6 +311,17 @@ func SetCompatibilityOptions(chainID uint32) {
 		RecordCallContractResultHeight = LocalRecordCallContractResultHeight
 		NvmMemoryLimitWithoutInjectHeight = LocalNvmMemoryLimitWithoutInjectHeight
 		WsResetRecordDependencyHeight = LocalWsResetRecordDependencyHeight
+		ElectionAvailableHeight = LocalElectionAvailableHeight
+		FinalityAvailableHeight = LocalFinalityAvailableHeight
+		ContractUpgradeAvailableHeight = LocalContractUpgradeAvailableHeight
+		StorageIterationAvailableHeight = LocalStorageIterationAvailableHeight
+		GasScheduleAvailableHeight = LocalGasScheduleAvailableHeight
+		RecentBlockAvailableHeight = LocalRecentBlockAvailableHeight
+		InnerContractCallAvailableHeight = LocalInnerContractCallAvailableHeight
+		CryptoAvailableHeight = LocalCryptoAvailableHeight
+		DipAvailableHeight = LocalDipAvailableHeight
+		DevotionAvailableHeight = LocalDevotionAvailableHeight
+		AuthorityAvailableHeight = LocalAuthorityAvailableHeight
 	}
 	logging.VLog().WithFields(logrus.Fields{
 		"chain_id": chainID,
@@ -165,5 +332,16 @@ func SetCompatibilityOptions(chainID uint32) {
 		"RecordCallContractResultHeight":            RecordCallContractResultHeight,
 		"NvmMemoryLimitWithoutInjectHeight":         NvmMemoryLimitWithoutInjectHeight,
 		"WsResetRecordDependencyHeight":             WsResetRecordDependencyHeight,
+		"ElectionAvailableHeight":                   ElectionAvailableHeight,
+		"FinalityAvailableHeight":                   FinalityAvailableHeight,
+		"ContractUpgradeAvailableHeight":            ContractUpgradeAvailableHeight,
+		"StorageIterationAvailableHeight":           StorageIterationAvailableHeight,
+		"GasScheduleAvailableHeight":                GasScheduleAvailableHeight,
+		"RecentBlockAvailableHeight":                RecentBlockAvailableHeight,
+		"InnerContractCallAvailableHeight":          InnerContractCallAvailableHeight,
+		"CryptoAvailableHeight":                     CryptoAvailableHeight,
+		"DipAvailableHeight":                        DipAvailableHeight,
+		"DevotionAvailableHeight":                   DevotionAvailableHeight,
+		"AuthorityAvailableHeight":                  AuthorityAvailableHeight,
 	}).Info("Set compatibility options.")
 }
@@ -49,6 +49,30 @@ const (
 
 	// TopicTransferFromContract transfer from contract
 	TopicTransferFromContract = "chain.transferFromContract"
+
+	// TopicInnerContractCall the topic of a contract called by another contract
+	TopicInnerContractCall = "chain.innerContractCall"
+
+	// TopicContractUpgraded the topic of a contract upgraded or frozen by its owner
+	TopicContractUpgraded = "chain.contractUpgraded"
+
+	// TopicGasScheduleUpdated the topic of a gas schedule passed by governance proposal
+	TopicGasScheduleUpdated = "chain.gasScheduleUpdated"
+
+	// TopicDoubleMint the topic of a miner disqualified for double mint
+	TopicDoubleMint = "chain.doubleMint"
+
+	// TopicValidatorChanged the topic of a validator added or removed by governance proposal
+	TopicValidatorChanged = "chain.validatorChanged"
+
+	// TopicDepositChanged the topic of a deposit locked, unlocked or slashed
+	TopicDepositChanged = "chain.depositChanged"
+
+	// TopicDipReward the topic of the contract deployers rewarded for a dip period
+	TopicDipReward = "chain.dipReward"
+
+	// TopicMissedSlot the topic of a slot in which its proposer minted no block
+	TopicMissedSlot = "chain.missedSlot"
 )
 
 // EventSubscriber subscriber object
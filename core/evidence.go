@@ -0,0 +1,190 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"bytes"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/dag/pb"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"golang.org/x/crypto/sha3"
+)
+
+// DoubleMintEvent the event of a miner disqualified for double mint, Slashed is the confiscated deposit if any.
+type DoubleMintEvent struct {
+	Miner     string   `json:"miner"`
+	Timestamp int64    `json:"timestamp"`
+	Blocks    []string `json:"blocks"`
+	Slashed   string   `json:"slashed,omitempty"`
+}
+
+// DoubleMintEvidence proves that a miner signed two different blocks for the same slot.
+// Each block is carried as its header, tx hashes and dependency, enough to recalculate its hash.
+type DoubleMintEvidence struct {
+	first  *corepb.BlockProof
+	second *corepb.BlockProof
+}
+
+// NewDoubleMintEvidence create the evidence of two blocks minted at the same time.
+func NewDoubleMintEvidence(first *Block, second *Block) (*DoubleMintEvidence, error) {
+	if first == nil || second == nil {
+		return nil, ErrNilArgument
+	}
+	// keep the order of blocks stable, the same evidence always has the same hash.
+	if byteutils.Less(second.Hash(), first.Hash()) {
+		first, second = second, first
+	}
+	p1, err := first.proof()
+	if err != nil {
+		return nil, err
+	}
+	p2, err := second.proof()
+	if err != nil {
+		return nil, err
+	}
+	return &DoubleMintEvidence{
+		first:  p1,
+		second: p2,
+	}, nil
+}
+
+// LoadDoubleMintEvidence from bytes
+func LoadDoubleMintEvidence(data []byte) (*DoubleMintEvidence, error) {
+	pbEvidence := new(corepb.DoubleMintEvidence)
+	if err := proto.Unmarshal(data, pbEvidence); err != nil {
+		return nil, err
+	}
+	evidence := new(DoubleMintEvidence)
+	if err := evidence.FromProto(pbEvidence); err != nil {
+		return nil, err
+	}
+	return evidence, nil
+}
+
+// ToProto converts domain DoubleMintEvidence to proto DoubleMintEvidence
+func (evidence *DoubleMintEvidence) ToProto() (proto.Message, error) {
+	return &corepb.DoubleMintEvidence{
+		First:  evidence.first,
+		Second: evidence.second,
+	}, nil
+}
+
+// FromProto converts proto DoubleMintEvidence to domain DoubleMintEvidence
+func (evidence *DoubleMintEvidence) FromProto(msg proto.Message) error {
+	if msg, ok := msg.(*corepb.DoubleMintEvidence); ok {
+		if msg != nil && msg.First != nil && msg.Second != nil &&
+			msg.First.Header != nil && msg.Second.Header != nil {
+			evidence.first = msg.First
+			evidence.second = msg.Second
+			return nil
+		}
+	}
+	return ErrInvalidProtoToDoubleMintEvidence
+}
+
+// ToBytes serialize the evidence
+func (evidence *DoubleMintEvidence) ToBytes() ([]byte, error) {
+	pbEvidence, err := evidence.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(pbEvidence)
+}
+
+// Hash return the hash of evidence, made up of the hashes of both blocks.
+func (evidence *DoubleMintEvidence) Hash() byteutils.Hash {
+	hasher := sha3.New256()
+	hasher.Write(evidence.first.Header.Hash)
+	hasher.Write(evidence.second.Header.Hash)
+	return hasher.Sum(nil)
+}
+
+// Timestamp return the slot both blocks are minted at.
+func (evidence *DoubleMintEvidence) Timestamp() int64 {
+	return evidence.first.Header.Timestamp
+}
+
+// Blocks return the hashes of both blocks.
+func (evidence *DoubleMintEvidence) Blocks() []byteutils.Hash {
+	return []byteutils.Hash{evidence.first.Header.Hash, evidence.second.Header.Hash}
+}
+
+// Verify check the evidence on the chain, return the miner who signed both blocks.
+func (evidence *DoubleMintEvidence) Verify(chainID uint32) (*Address, error) {
+	first, err := verifyBlockProof(evidence.first, chainID)
+	if err != nil {
+		return nil, err
+	}
+	second, err := verifyBlockProof(evidence.second, chainID)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(evidence.first.Header.Hash, evidence.second.Header.Hash) ||
+		evidence.first.Header.Timestamp != evidence.second.Header.Timestamp ||
+		!first.Equals(second) {
+		return nil, ErrInvalidDoubleMintEvidence
+	}
+	return first, nil
+}
+
+// verifyBlockProof recalculate the hash of block in proof, return the signer of block.
+func verifyBlockProof(proof *corepb.BlockProof, chainID uint32) (*Address, error) {
+	header := new(BlockHeader)
+	if err := header.FromProto(proof.Header); err != nil {
+		return nil, err
+	}
+	if header.chainID != chainID {
+		return nil, ErrInvalidChainID
+	}
+	var pbDep proto.Message = proof.Dependency
+	if proof.Dependency == nil {
+		pbDep = new(dagpb.Dag)
+	}
+	hash, err := hashBlock(header, pbDep, proof.TxHashes)
+	if err != nil {
+		return nil, err
+	}
+	if !hash.Equals(header.hash) {
+		return nil, ErrInvalidBlockHash
+	}
+	return RecoverSignerFromSignature(header.alg, header.hash, header.sign)
+}
+
+// proof return the block proof, which is enough to recalculate the block hash.
+func (block *Block) proof() (*corepb.BlockProof, error) {
+	header, err := block.header.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	pbDep, err := block.dependency.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	txHashes := make([][]byte, len(block.transactions))
+	for idx, tx := range block.transactions {
+		txHashes[idx] = tx.Hash()
+	}
+	return &corepb.BlockProof{
+		Header:     header.(*corepb.BlockHeader),
+		TxHashes:   txHashes,
+		Dependency: pbDep.(*dagpb.Dag),
+	}, nil
+}
@@ -0,0 +1,110 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockSignedBlock(t *testing.T, bc *BlockChain, miner *Address, timestamp int64, data []byte) *Block {
+	key, err := keystore.DefaultKS.GetUnlocked(miner.String())
+	assert.Nil(t, err)
+	signature, err := crypto.NewSignature(keystore.SECP256K1)
+	assert.Nil(t, err)
+	signature.InitSign(key.(keystore.PrivateKey))
+
+	block, err := bc.NewBlock(miner)
+	assert.Nil(t, err)
+	block.SetTimestamp(timestamp)
+	if data != nil {
+		gasLimit, _ := util.NewUint128FromInt(200000)
+		tx, err := NewTransaction(bc.ChainID(), miner, miner, util.NewUint128(), 1, TxPayloadBinaryType, data, TransactionGasPrice, gasLimit)
+		assert.Nil(t, err)
+		assert.Nil(t, tx.Sign(signature))
+		block.transactions = append(block.transactions, tx)
+	}
+	assert.Nil(t, block.Seal())
+	assert.Nil(t, block.Sign(signature))
+	return block
+}
+
+func TestDoubleMintEvidence(t *testing.T) {
+	neb := testNeb(t)
+	bc := neb.chain
+	miner := mockAddress()
+	timestamp := bc.TailBlock().Timestamp() + 15
+
+	first := mockSignedBlock(t, bc, miner, timestamp, []byte("nas"))
+	second := mockSignedBlock(t, bc, miner, timestamp, nil)
+
+	evidence, err := NewDoubleMintEvidence(first, second)
+	assert.Nil(t, err)
+	signer, err := evidence.Verify(bc.ChainID())
+	assert.Nil(t, err)
+	assert.Equal(t, miner.String(), signer.String())
+	assert.Equal(t, timestamp, evidence.Timestamp())
+
+	// the order of blocks doesn't change the evidence.
+	swapped, err := NewDoubleMintEvidence(second, first)
+	assert.Nil(t, err)
+	assert.Equal(t, evidence.Hash(), swapped.Hash())
+
+	data, err := evidence.ToBytes()
+	assert.Nil(t, err)
+	loaded, err := LoadDoubleMintEvidence(data)
+	assert.Nil(t, err)
+	assert.Equal(t, evidence.Hash(), loaded.Hash())
+	signer, err = loaded.Verify(bc.ChainID())
+	assert.Nil(t, err)
+	assert.Equal(t, miner.String(), signer.String())
+
+	_, err = evidence.Verify(bc.ChainID() + 1)
+	assert.Equal(t, ErrInvalidChainID, err)
+
+	same, err := NewDoubleMintEvidence(first, first)
+	assert.Nil(t, err)
+	_, err = same.Verify(bc.ChainID())
+	assert.Equal(t, ErrInvalidDoubleMintEvidence, err)
+
+	later := mockSignedBlock(t, bc, miner, timestamp+15, nil)
+	evidence, err = NewDoubleMintEvidence(first, later)
+	assert.Nil(t, err)
+	_, err = evidence.Verify(bc.ChainID())
+	assert.Equal(t, ErrInvalidDoubleMintEvidence, err)
+
+	other := mockSignedBlock(t, bc, mockAddress(), timestamp, nil)
+	evidence, err = NewDoubleMintEvidence(first, other)
+	assert.Nil(t, err)
+	_, err = evidence.Verify(bc.ChainID())
+	assert.Equal(t, ErrInvalidDoubleMintEvidence, err)
+
+	evidence, err = NewDoubleMintEvidence(first, second)
+	assert.Nil(t, err)
+	evidence.first.Header.StateRoot = []byte("tampered")
+	_, err = evidence.Verify(bc.ChainID())
+	assert.Equal(t, ErrInvalidBlockHash, err)
+
+	_, err = LoadDoubleMintEvidence([]byte("data"))
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,164 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"golang.org/x/crypto/sha3"
+)
+
+// preCommitPrefix keeps the signed hash of pre-commit apart from the block hash signed by miner.
+var preCommitPrefix = []byte("nebulas-precommit:")
+
+// PreCommit the vote of a dynasty member for the finality of a block.
+type PreCommit struct {
+	blockHash byteutils.Hash
+	alg       keystore.Algorithm
+	sign      byteutils.Hash
+}
+
+// NewPreCommit create an unsigned pre-commit of block.
+func NewPreCommit(blockHash byteutils.Hash) *PreCommit {
+	return &PreCommit{
+		blockHash: blockHash,
+	}
+}
+
+// PreCommitHash return the hash signed by the voters of block.
+func PreCommitHash(blockHash byteutils.Hash) byteutils.Hash {
+	hasher := sha3.New256()
+	hasher.Write(preCommitPrefix)
+	hasher.Write(blockHash)
+	return hasher.Sum(nil)
+}
+
+// ToProto converts domain PreCommit to proto PreCommit
+func (v *PreCommit) ToProto() (proto.Message, error) {
+	return &corepb.PreCommit{
+		BlockHash: v.blockHash,
+		Alg:       uint32(v.alg),
+		Sign:      v.sign,
+	}, nil
+}
+
+// FromProto converts proto PreCommit to domain PreCommit
+func (v *PreCommit) FromProto(msg proto.Message) error {
+	if msg, ok := msg.(*corepb.PreCommit); ok {
+		if msg != nil && len(msg.BlockHash) == BlockHashLength {
+			v.blockHash = msg.BlockHash
+			v.alg = keystore.Algorithm(msg.Alg)
+			v.sign = msg.Sign
+			return nil
+		}
+	}
+	return ErrInvalidProtoToPreCommit
+}
+
+// BlockHash return the hash of voted block.
+func (v *PreCommit) BlockHash() byteutils.Hash {
+	return v.blockHash
+}
+
+// SetSignature set the signature of pre-commit.
+func (v *PreCommit) SetSignature(alg keystore.Algorithm, sign byteutils.Hash) {
+	v.alg = alg
+	v.sign = sign
+}
+
+// Signer return the voter who signed the pre-commit.
+func (v *PreCommit) Signer() (*Address, error) {
+	return RecoverSignerFromSignature(v.alg, PreCommitHash(v.blockHash), v.sign)
+}
+
+// NewFinalityProof aggregate the pre-commits of block.
+func NewFinalityProof(blockHash byteutils.Hash, votes []*PreCommit) (*corepb.FinalityProof, error) {
+	proof := &corepb.FinalityProof{
+		BlockHash: blockHash,
+	}
+	for _, v := range votes {
+		if !v.blockHash.Equals(blockHash) {
+			return nil, ErrInvalidFinalityProof
+		}
+		pbVote, err := v.ToProto()
+		if err != nil {
+			return nil, err
+		}
+		proof.Votes = append(proof.Votes, pbVote.(*corepb.PreCommit))
+	}
+	return proof, nil
+}
+
+// VerifyFinalityProof check the proof is signed by at least threshold members of the dynasty.
+func VerifyFinalityProof(proof *corepb.FinalityProof, dynasty []byteutils.Hash, threshold int) error {
+	if proof == nil {
+		return ErrNilArgument
+	}
+	members := make(map[byteutils.HexHash]bool)
+	for _, member := range dynasty {
+		members[member.Hex()] = true
+	}
+	signers := make(map[byteutils.HexHash]bool)
+	for _, pbVote := range proof.Votes {
+		v := new(PreCommit)
+		if err := v.FromProto(pbVote); err != nil {
+			return err
+		}
+		if !v.blockHash.Equals(proof.BlockHash) {
+			return ErrInvalidFinalityProof
+		}
+		signer, err := v.Signer()
+		if err != nil {
+			return err
+		}
+		key := byteutils.Hash(signer.Bytes()).Hex()
+		if !members[key] {
+			return ErrInvalidFinalityProof
+		}
+		signers[key] = true
+	}
+	if len(signers) < threshold {
+		return ErrInvalidFinalityProof
+	}
+	return nil
+}
+
+// StoreFinalityProof store the finality proof of block.
+func (bc *BlockChain) StoreFinalityProof(proof *corepb.FinalityProof) error {
+	value, err := proto.Marshal(proof)
+	if err != nil {
+		return err
+	}
+	return bc.finalityStorage.Put(proof.BlockHash, value)
+}
+
+// GetFinalityProof return the finality proof of block, storage.ErrKeyNotFound if the block isn't finalized.
+func (bc *BlockChain) GetFinalityProof(blockHash byteutils.Hash) (*corepb.FinalityProof, error) {
+	value, err := bc.finalityStorage.Get(blockHash)
+	if err != nil {
+		return nil, err
+	}
+	proof := new(corepb.FinalityProof)
+	if err := proto.Unmarshal(value, proof); err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
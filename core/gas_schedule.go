@@ -0,0 +1,286 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+const (
+	// MaxGasTableCount the max gas count of an operation in gas tables.
+	MaxGasTableCount = 1 << 20
+)
+
+// GasTable the gas counts of NVM operations. The counts of Expressions are injected into
+// contract source as executed instructions, the others are counted by the natives.
+type GasTable struct {
+	Expressions map[string]uint64 `json:"expressions"`
+
+	// per byte of storage keys and values written, and keys iterated.
+	StorageByte         uint64 `json:"storage_byte"`
+	StorageIterateBase  uint64 `json:"storage_iterate_base"`
+	StorageIterateEntry uint64 `json:"storage_iterate_entry"`
+
+	EventBase uint64 `json:"event_base"`
+	EventByte uint64 `json:"event_byte"`
+
+	GetTxByHash           uint64 `json:"get_tx_by_hash"`
+	GetAccountState       uint64 `json:"get_account_state"`
+	Transfer              uint64 `json:"transfer"`
+	VerifyAddress         uint64 `json:"verify_address"`
+	InnerContractCallBase uint64 `json:"inner_contract_call_base"`
+	GetBlock              uint64 `json:"get_block"`
+
+	CryptoHash           uint64 `json:"crypto_hash"`
+	CryptoRecoverAddress uint64 `json:"crypto_recover_address"`
+}
+
+// GasSchedule a version of gas table, it is activated since the height.
+type GasSchedule struct {
+	Version uint64    `json:"version"`
+	Height  uint64    `json:"height"`
+	Table   *GasTable `json:"table"`
+}
+
+// defaultGasSchedule the version 0 of gas table, it is used before any schedule passed by governance.
+var defaultGasSchedule = &GasSchedule{
+	Version: 0,
+	Height:  0,
+	Table: &GasTable{
+		Expressions: map[string]uint64{
+			"CallExpression":        8,
+			"AssignmentExpression":  3,
+			"BinaryExpression":      3,
+			"UpdateExpression":      3,
+			"UnaryExpression":       3,
+			"LogicalExpression":     3,
+			"MemberExpression":      4,
+			"NewExpression":         8,
+			"ThrowStatement":        6,
+			"MetaProperty":          4,
+			"ConditionalExpression": 3,
+			"YieldExpression":       6,
+		},
+		StorageByte:           1,
+		StorageIterateBase:    100,
+		StorageIterateEntry:   20,
+		EventBase:             20,
+		EventByte:             1,
+		GetTxByHash:           1000,
+		GetAccountState:       1000,
+		Transfer:              2000,
+		VerifyAddress:         100,
+		InnerContractCallBase: 1000,
+		GetBlock:              1000,
+		CryptoHash:            1000,
+		CryptoRecoverAddress:  10000,
+	},
+}
+
+// GasScheduleAddress the account keeping gas schedules in its storage, it is created as a
+// contract without source once the first schedule passes or is approved by a dynasty member.
+var (
+	GasScheduleAddress, _ = newAddress(ContractAddress, []byte("nebulas gas schedule"))
+	gasScheduleBirthPlace = hash.Sha3256([]byte("nebulas gas schedule"))
+)
+
+// the keys of gas schedules in the storage of GasScheduleAddress.
+const gasScheduleDomain = ".gas"
+
+var gasScheduleCountKey = trie.HashDomains(gasScheduleDomain, "count")
+
+func gasScheduleKey(version uint64) []byte {
+	return trie.HashDomains(gasScheduleDomain, "schedule", strconv.FormatUint(version, 10))
+}
+
+// DefaultGasSchedule return the version 0 of gas schedule, it must not be modified.
+func DefaultGasSchedule() *GasSchedule {
+	return defaultGasSchedule
+}
+
+// Verify check the table has a count for each expression of the default table, and all counts are in range.
+func (t *GasTable) Verify() error {
+	if len(t.Expressions) != len(defaultGasSchedule.Table.Expressions) {
+		return ErrInvalidGasTable
+	}
+	for name, count := range t.Expressions {
+		if _, ok := defaultGasSchedule.Table.Expressions[name]; !ok {
+			return ErrInvalidGasTable
+		}
+		if count == 0 || count > MaxGasTableCount {
+			return ErrInvalidGasTable
+		}
+	}
+	for _, count := range []uint64{
+		t.StorageByte, t.StorageIterateBase, t.StorageIterateEntry, t.EventBase, t.EventByte,
+		t.GetTxByHash, t.GetAccountState, t.Transfer, t.VerifyAddress, t.InnerContractCallBase,
+		t.GetBlock, t.CryptoHash, t.CryptoRecoverAddress,
+	} {
+		if count > MaxGasTableCount {
+			return ErrInvalidGasTable
+		}
+	}
+	return nil
+}
+
+// ExpressionsJSON return the counts of expressions in JSON, which are injected into contract source.
+func (t *GasTable) ExpressionsJSON() (string, error) {
+	data, err := json.Marshal(t.Expressions)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// gasScheduleAccount return the account keeping gas schedules, nil if no schedule passed.
+func gasScheduleAccount(ws WorldState) (state.Account, error) {
+	account, err := ws.GetContractAccount(GasScheduleAddress.address)
+	if err == state.ErrContractAccountNotFound {
+		return nil, nil
+	}
+	return account, err
+}
+
+// latestGasScheduleVersion return the version of the latest schedule passed by governance, 0 if none.
+func latestGasScheduleVersion(account state.Account) (uint64, error) {
+	if account == nil {
+		return 0, nil
+	}
+	bytes, err := account.Get(gasScheduleCountKey)
+	if err == storage.ErrKeyNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return byteutils.Uint64(bytes), nil
+}
+
+func loadGasSchedule(account state.Account, version uint64) (*GasSchedule, error) {
+	if version == 0 {
+		return defaultGasSchedule, nil
+	}
+	bytes, err := account.Get(gasScheduleKey(version))
+	if err != nil {
+		return nil, err
+	}
+	schedule := &GasSchedule{}
+	if err := json.Unmarshal(bytes, schedule); err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+// GasSchedules return all versions of gas schedule in ascending order, the first is the default one.
+func GasSchedules(ws WorldState) ([]*GasSchedule, error) {
+	account, err := gasScheduleAccount(ws)
+	if err != nil {
+		return nil, err
+	}
+	latest, err := latestGasScheduleVersion(account)
+	if err != nil {
+		return nil, err
+	}
+	schedules := make([]*GasSchedule, 0, latest+1)
+	for v := uint64(0); v <= latest; v++ {
+		schedule, err := loadGasSchedule(account, v)
+		if err != nil {
+			return nil, err
+		}
+		schedules = append(schedules, schedule)
+	}
+	return schedules, nil
+}
+
+// GasScheduleAt return the gas schedule activated at the height, the latest one whose height is not above it.
+func GasScheduleAt(ws WorldState, height uint64) (*GasSchedule, error) {
+	if height < GasScheduleAvailableHeight {
+		return defaultGasSchedule, nil
+	}
+	account, err := gasScheduleAccount(ws)
+	if err != nil {
+		return nil, err
+	}
+	latest, err := latestGasScheduleVersion(account)
+	if err != nil {
+		return nil, err
+	}
+	for v := latest; v > 0; v-- {
+		schedule, err := loadGasSchedule(account, v)
+		if err != nil {
+			return nil, err
+		}
+		if schedule.Height <= height {
+			return schedule, nil
+		}
+	}
+	return defaultGasSchedule, nil
+}
+
+// ensureGasScheduleAccount return the account keeping gas schedules, create it if not exist.
+func ensureGasScheduleAccount(ws WorldState) (state.Account, error) {
+	account, err := gasScheduleAccount(ws)
+	if err != nil || account != nil {
+		return account, err
+	}
+	return ws.CreateContractAccount(GasScheduleAddress.address, gasScheduleBirthPlace)
+}
+
+// appendGasSchedule store the table as the next version of gas schedule activated since the height.
+func appendGasSchedule(ws WorldState, height uint64, table *GasTable) (*GasSchedule, error) {
+	account, err := ensureGasScheduleAccount(ws)
+	if err != nil {
+		return nil, err
+	}
+	latest, err := latestGasScheduleVersion(account)
+	if err != nil {
+		return nil, err
+	}
+	last, err := loadGasSchedule(account, latest)
+	if err != nil {
+		return nil, err
+	}
+	if height <= last.Height {
+		return nil, ErrInvalidGasScheduleHeight
+	}
+
+	schedule := &GasSchedule{
+		Version: latest + 1,
+		Height:  height,
+		Table:   table,
+	}
+	bytes, err := json.Marshal(schedule)
+	if err != nil {
+		return nil, err
+	}
+	if err := account.Put(gasScheduleKey(schedule.Version), bytes); err != nil {
+		return nil, err
+	}
+	if err := account.Put(gasScheduleCountKey, byteutils.FromUint64(schedule.Version)); err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
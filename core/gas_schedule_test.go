@@ -0,0 +1,134 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockGasTable(transfer uint64) *GasTable {
+	table := *DefaultGasSchedule().Table
+	table.Transfer = transfer
+	return &table
+}
+
+func TestGasSchedule(t *testing.T) {
+	height := GasScheduleAvailableHeight
+	GasScheduleAvailableHeight = LocalGasScheduleAvailableHeight
+	defer func() { GasScheduleAvailableHeight = height }()
+
+	neb := testNeb(t)
+	block := neb.chain.tailBlock
+	block.Begin()
+	ws := block.WorldState()
+
+	schedule, err := GasScheduleAt(ws, 100)
+	assert.Nil(t, err)
+	assert.Equal(t, DefaultGasSchedule(), schedule)
+
+	schedule, err = appendGasSchedule(ws, 10, mockGasTable(3000))
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), schedule.Version)
+
+	_, err = appendGasSchedule(ws, 10, mockGasTable(4000))
+	assert.Equal(t, ErrInvalidGasScheduleHeight, err)
+
+	schedule, err = appendGasSchedule(ws, 20, mockGasTable(4000))
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2), schedule.Version)
+
+	tests := []struct {
+		height   uint64
+		version  uint64
+		transfer uint64
+	}{
+		{1, 0, 2000},
+		{9, 0, 2000},
+		{10, 1, 3000},
+		{19, 1, 3000},
+		{20, 2, 4000},
+		{100, 2, 4000},
+	}
+	for _, tt := range tests {
+		schedule, err := GasScheduleAt(ws, tt.height)
+		assert.Nil(t, err)
+		assert.Equal(t, tt.version, schedule.Version, "height %d", tt.height)
+		assert.Equal(t, tt.transfer, schedule.Table.Transfer, "height %d", tt.height)
+	}
+
+	schedules, err := GasSchedules(ws)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(schedules))
+	for i, s := range schedules {
+		assert.Equal(t, uint64(i), s.Version)
+	}
+	block.RollBack()
+}
+
+func TestDynastyGovernance(t *testing.T) {
+	neb := testNeb(t)
+	block := neb.chain.tailBlock
+	block.Begin()
+	ws := block.WorldState()
+
+	account, err := ensureGasScheduleAccount(ws)
+	assert.Nil(t, err)
+	dynasty := []byteutils.Hash{}
+	for i := 0; i < 4; i++ {
+		dynasty = append(dynasty, mockAddress().Bytes())
+	}
+	gov := newDynastyGovernance(dynasty, account)
+	proposal := byteutils.Hash("proposal")
+
+	_, _, err = approveProposal(gov, proposal, mockAddress().Bytes())
+	assert.Equal(t, ErrApproveFromNonValidator, err)
+
+	approvers, passed, err := approveProposal(gov, proposal, dynasty[0])
+	assert.Nil(t, err)
+	assert.False(t, passed)
+	assert.Equal(t, 1, len(approvers))
+
+	_, _, err = approveProposal(gov, proposal, dynasty[0])
+	assert.Equal(t, ErrDuplicatedApproval, err)
+
+	_, passed, err = approveProposal(gov, proposal, dynasty[1])
+	assert.Nil(t, err)
+	assert.False(t, passed)
+
+	// the approvals from the members out of dynasty don't count.
+	removed := newDynastyGovernance(dynasty[1:], account)
+	approvers, passed, err = approveProposal(removed, proposal, dynasty[2])
+	assert.Nil(t, err)
+	assert.False(t, passed)
+	assert.Equal(t, 2, len(approvers))
+
+	approvers, passed, err = approveProposal(gov, proposal, dynasty[3])
+	assert.Nil(t, err)
+	assert.True(t, passed)
+	assert.Equal(t, 4, len(approvers))
+
+	assert.Nil(t, gov.ClearApprovals(proposal))
+	approvals, err := gov.Approvals(proposal)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(approvals))
+	block.RollBack()
+}
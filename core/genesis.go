@@ -29,6 +29,7 @@ import (
 	"github.com/nebulasio/go-nebulas/consensus/pb"
 	"github.com/nebulasio/go-nebulas/core/pb"
 	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/storage"
 	"github.com/nebulasio/go-nebulas/util"
 	"github.com/nebulasio/go-nebulas/util/logging"
 	"github.com/sirupsen/logrus"
@@ -89,6 +90,7 @@ func NewGenesisBlock(conf *corepb.Genesis, chain *BlockChain) (*Block, error) {
 		storage:      chain.storage,
 		eventEmitter: chain.eventEmitter,
 		nvm:          chain.nvm,
+		dip:          chain.dip,
 		height:       1,
 		sealed:       false,
 	}
@@ -253,13 +255,65 @@ func DumpGenesis(chain *BlockChain) (*corepb.Genesis, error) {
 			Value:   balance.String(),
 		})
 	}
-	return &corepb.Genesis{
-		Meta: &corepb.GenesisMeta{ChainId: genesis.ChainID()},
-		Consensus: &corepb.GenesisConsensus{
-			Dpos: &corepb.GenesisConsensusDpos{Dynasty: bootstrap},
-		},
-		TokenDistribution: distribution,
-	}, nil
+	// the params are stored since the chain is created, the chains created
+	// before keep the default params.
+	conf := &corepb.Genesis{}
+	value, err := chain.metaStorage.Get([]byte(GenesisConf))
+	if err != nil && err != storage.ErrKeyNotFound {
+		return nil, err
+	}
+	if err == nil {
+		if err := proto.Unmarshal(value, conf); err != nil {
+			return nil, err
+		}
+	} else {
+		conf.Consensus = &corepb.GenesisConsensus{
+			Engine: &corepb.GenesisConsensus_Dpos{Dpos: &corepb.GenesisConsensusDpos{}},
+		}
+		if chain.genesis.GetConsensus().GetPoa() != nil {
+			conf.Consensus.Engine = &corepb.GenesisConsensus_Poa{Poa: &corepb.GenesisConsensusPoa{}}
+		}
+		if chain.genesis.GetConsensus().GetPod() != nil {
+			conf.Consensus.Engine = &corepb.GenesisConsensus_Pod{Pod: &corepb.GenesisConsensusPod{}}
+		}
+	}
+	conf.Meta = &corepb.GenesisMeta{ChainId: genesis.ChainID()}
+	conf.TokenDistribution = distribution
+	setGenesisDynasty(conf, bootstrap)
+	return conf, nil
+}
+
+// setGenesisDynasty set the initial miners of the consensus engine in genesis conf.
+func setGenesisDynasty(conf *corepb.Genesis, dynasty []string) {
+	if poa := conf.GetConsensus().GetPoa(); poa != nil {
+		poa.Validators = dynasty
+	}
+	if pod := conf.GetConsensus().GetPod(); pod != nil {
+		pod.Dynasty = dynasty
+	}
+	if dpos := conf.GetConsensus().GetDpos(); dpos != nil {
+		dpos.Dynasty = dynasty
+	}
+}
+
+// genesisParams return the genesis conf without chain id, initial miners and token distribution.
+func genesisParams(conf *corepb.Genesis) *corepb.Genesis {
+	params := proto.Clone(conf).(*corepb.Genesis)
+	params.Meta = nil
+	params.TokenDistribution = nil
+	setGenesisDynasty(params, nil)
+	return params
+}
+
+// GenesisDynasty return the initial miners in genesis conf, the dpos or pod dynasty, or the poa validators.
+func GenesisDynasty(conf *corepb.Genesis) []string {
+	if poa := conf.GetConsensus().GetPoa(); poa != nil {
+		return poa.Validators
+	}
+	if pod := conf.GetConsensus().GetPod(); pod != nil {
+		return pod.Dynasty
+	}
+	return conf.GetConsensus().GetDpos().GetDynasty()
 }
 
 //CheckGenesisConfByDB check mem and genesis.conf if equal return nil
@@ -270,7 +324,8 @@ func CheckGenesisConfByDB(pGenesisDB *corepb.Genesis, pGenesis *corepb.Genesis)
 			return ErrGenesisNotEqualChainIDInDB
 		}
 
-		if len(pGenesis.Consensus.Dpos.Dynasty) != len(pGenesisDB.Consensus.Dpos.Dynasty) {
+		dynasty, dynastyDB := GenesisDynasty(pGenesis), GenesisDynasty(pGenesisDB)
+		if len(dynasty) != len(dynastyDB) {
 			return ErrGenesisNotEqualDynastyLenInDB
 		}
 
@@ -279,9 +334,9 @@ func CheckGenesisConfByDB(pGenesisDB *corepb.Genesis, pGenesis *corepb.Genesis)
 		}
 
 		// check dpos equal
-		for _, confDposAddr := range pGenesis.Consensus.Dpos.Dynasty {
+		for _, confDposAddr := range dynasty {
 			contains := false
-			for _, dposAddr := range pGenesisDB.Consensus.Dpos.Dynasty {
+			for _, dposAddr := range dynastyDB {
 				if dposAddr == confDposAddr {
 					contains = true
 					break
@@ -307,6 +362,11 @@ func CheckGenesisConfByDB(pGenesisDB *corepb.Genesis, pGenesis *corepb.Genesis)
 				return ErrGenesisNotEqualTokenInDB
 			}
 		}
+
+		// check consensus engine and its params equal
+		if !proto.Equal(genesisParams(pGenesis), genesisParams(pGenesisDB)) {
+			return ErrGenesisNotEqualParamsInDB
+		}
 	}
 	return nil
 }
@@ -21,6 +21,7 @@ package core
 import (
 	"testing"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -41,6 +42,7 @@ func TestNewGenesisBlock(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, dumpConf.Meta.ChainId, conf.Meta.ChainId)
 	assert.Equal(t, dumpConf.TokenDistribution, conf.TokenDistribution)
+	assert.True(t, proto.Equal(genesisParams(conf), genesisParams(dumpConf)))
 }
 
 func TestInvalidAddressInTokenDistribution(t *testing.T) {
@@ -0,0 +1,166 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// governance the approvers of governance proposals and their approvals.
+type governance interface {
+	// IsApprover return true if addr can approve proposals.
+	IsApprover(addr byteutils.Hash) (bool, error)
+
+	// Threshold return the approvals needed to pass a proposal.
+	Threshold() (int, error)
+	// Approvals return the approvers who approved the proposal.
+	Approvals(proposal byteutils.Hash) ([]byteutils.Hash, error)
+	// Approve record the approval of approver.
+	Approve(proposal byteutils.Hash, approver byteutils.Hash) error
+	// ClearApprovals delete the approvals of the proposal.
+	ClearApprovals(proposal byteutils.Hash) error
+}
+
+// validatorGovernance the validators of poa approve proposals.
+type validatorGovernance struct {
+	state.AuthorityState
+}
+
+// IsApprover return true if addr is a validator.
+func (g *validatorGovernance) IsApprover(addr byteutils.Hash) (bool, error) {
+	return g.IsValidator(addr)
+}
+
+// dynastyGovernance the members of current dynasty approve proposals, which is used by
+// the engines electing dynasties. A proposal passes once more than 2/3 of the members
+// approved it, the approvals are kept in the storage of account.
+type dynastyGovernance struct {
+	dynasty []byteutils.Hash
+	account state.Account
+}
+
+func newDynastyGovernance(dynasty []byteutils.Hash, account state.Account) *dynastyGovernance {
+	return &dynastyGovernance{
+		dynasty: dynasty,
+		account: account,
+	}
+}
+
+func approvalsKey(proposal byteutils.Hash) []byte {
+	return append([]byte(".approvals."), proposal...)
+}
+
+// IsApprover return true if addr is a member of current dynasty.
+func (g *dynastyGovernance) IsApprover(addr byteutils.Hash) (bool, error) {
+	for _, member := range g.dynasty {
+		if member.Equals(addr) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Threshold return the approvals of more than 2/3 of the dynasty.
+func (g *dynastyGovernance) Threshold() (int, error) {
+	return len(g.dynasty)*2/3 + 1, nil
+}
+
+// Approvals return the approvers who approved the proposal.
+func (g *dynastyGovernance) Approvals(proposal byteutils.Hash) ([]byteutils.Hash, error) {
+	bytes, err := g.account.Get(approvalsKey(proposal))
+	if err != nil {
+		if err == storage.ErrKeyNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	approvals := []byteutils.Hash{}
+	for i := 0; i+AddressLength <= len(bytes); i += AddressLength {
+		approvals = append(approvals, bytes[i:i+AddressLength])
+	}
+	return approvals, nil
+}
+
+// Approve record the approval of approver.
+func (g *dynastyGovernance) Approve(proposal byteutils.Hash, approver byteutils.Hash) error {
+	approvals, err := g.Approvals(proposal)
+	if err != nil {
+		return err
+	}
+	bytes := []byte{}
+	for _, v := range append(approvals, approver) {
+		bytes = append(bytes, v...)
+	}
+	return g.account.Put(approvalsKey(proposal), bytes)
+}
+
+// ClearApprovals delete the approvals of the proposal.
+func (g *dynastyGovernance) ClearApprovals(proposal byteutils.Hash) error {
+	if err := g.account.Del(approvalsKey(proposal)); err != nil && err != storage.ErrKeyNotFound {
+		return err
+	}
+	return nil
+}
+
+// approveProposal record the approval of approver and return the approvers still qualified,
+// the approvals from the approvers removed since don't count. The proposal passes once the
+// count of them reaches the threshold, the caller applies it and clears the approvals.
+func approveProposal(gov governance, proposal byteutils.Hash, approver byteutils.Hash) ([]string, bool, error) {
+	qualified, err := gov.IsApprover(approver)
+	if err != nil {
+		return nil, false, err
+	}
+	if !qualified {
+		return nil, false, ErrApproveFromNonValidator
+	}
+	approvals, err := gov.Approvals(proposal)
+	if err != nil {
+		return nil, false, err
+	}
+	for _, v := range approvals {
+		if v.Equals(approver) {
+			return nil, false, ErrDuplicatedApproval
+		}
+	}
+	if err := gov.Approve(proposal, approver); err != nil {
+		return nil, false, err
+	}
+
+	approvers := []string{}
+	for _, v := range append(approvals, approver) {
+		qualified, err := gov.IsApprover(v)
+		if err != nil {
+			return nil, false, err
+		}
+		if qualified {
+			addr, err := AddressParseFromBytes(v)
+			if err != nil {
+				return nil, false, err
+			}
+			approvers = append(approvers, addr.String())
+		}
+	}
+	threshold, err := gov.Threshold()
+	if err != nil {
+		return nil, false, err
+	}
+	return approvers, len(approvers) >= threshold, nil
+}
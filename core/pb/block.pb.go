@@ -17,6 +17,10 @@ It has these top-level messages:
 	NetBlock
 	DownloadBlock
 	Random
+	BlockProof
+	DoubleMintEvidence
+	PreCommit
+	FinalityProof
 */
 package corepb
 
@@ -469,6 +473,118 @@ func (m *Random) GetVrfProof() []byte {
 	return nil
 }
 
+type BlockProof struct {
+	Header     *BlockHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	TxHashes   [][]byte     `protobuf:"bytes,2,rep,name=tx_hashes,json=txHashes" json:"tx_hashes,omitempty"`
+	Dependency *dagpb.Dag   `protobuf:"bytes,3,opt,name=dependency" json:"dependency,omitempty"`
+}
+
+func (m *BlockProof) Reset()                    { *m = BlockProof{} }
+func (m *BlockProof) String() string            { return proto.CompactTextString(m) }
+func (*BlockProof) ProtoMessage()               {}
+func (*BlockProof) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{9} }
+
+func (m *BlockProof) GetHeader() *BlockHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *BlockProof) GetTxHashes() [][]byte {
+	if m != nil {
+		return m.TxHashes
+	}
+	return nil
+}
+
+func (m *BlockProof) GetDependency() *dagpb.Dag {
+	if m != nil {
+		return m.Dependency
+	}
+	return nil
+}
+
+type DoubleMintEvidence struct {
+	First  *BlockProof `protobuf:"bytes,1,opt,name=first" json:"first,omitempty"`
+	Second *BlockProof `protobuf:"bytes,2,opt,name=second" json:"second,omitempty"`
+}
+
+func (m *DoubleMintEvidence) Reset()                    { *m = DoubleMintEvidence{} }
+func (m *DoubleMintEvidence) String() string            { return proto.CompactTextString(m) }
+func (*DoubleMintEvidence) ProtoMessage()               {}
+func (*DoubleMintEvidence) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{10} }
+
+func (m *DoubleMintEvidence) GetFirst() *BlockProof {
+	if m != nil {
+		return m.First
+	}
+	return nil
+}
+
+func (m *DoubleMintEvidence) GetSecond() *BlockProof {
+	if m != nil {
+		return m.Second
+	}
+	return nil
+}
+
+type PreCommit struct {
+	BlockHash []byte `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Alg       uint32 `protobuf:"varint,2,opt,name=alg,proto3" json:"alg,omitempty"`
+	Sign      []byte `protobuf:"bytes,3,opt,name=sign,proto3" json:"sign,omitempty"`
+}
+
+func (m *PreCommit) Reset()                    { *m = PreCommit{} }
+func (m *PreCommit) String() string            { return proto.CompactTextString(m) }
+func (*PreCommit) ProtoMessage()               {}
+func (*PreCommit) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{11} }
+
+func (m *PreCommit) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *PreCommit) GetAlg() uint32 {
+	if m != nil {
+		return m.Alg
+	}
+	return 0
+}
+
+func (m *PreCommit) GetSign() []byte {
+	if m != nil {
+		return m.Sign
+	}
+	return nil
+}
+
+type FinalityProof struct {
+	BlockHash []byte       `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Votes     []*PreCommit `protobuf:"bytes,2,rep,name=votes" json:"votes,omitempty"`
+}
+
+func (m *FinalityProof) Reset()                    { *m = FinalityProof{} }
+func (m *FinalityProof) String() string            { return proto.CompactTextString(m) }
+func (*FinalityProof) ProtoMessage()               {}
+func (*FinalityProof) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{12} }
+
+func (m *FinalityProof) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *FinalityProof) GetVotes() []*PreCommit {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Account)(nil), "corepb.Account")
 	proto.RegisterType((*Data)(nil), "corepb.Data")
@@ -479,58 +595,70 @@ func init() {
 	proto.RegisterType((*NetBlock)(nil), "corepb.NetBlock")
 	proto.RegisterType((*DownloadBlock)(nil), "corepb.DownloadBlock")
 	proto.RegisterType((*Random)(nil), "corepb.Random")
+	proto.RegisterType((*BlockProof)(nil), "corepb.BlockProof")
+	proto.RegisterType((*DoubleMintEvidence)(nil), "corepb.DoubleMintEvidence")
+	proto.RegisterType((*PreCommit)(nil), "corepb.PreCommit")
+	proto.RegisterType((*FinalityProof)(nil), "corepb.FinalityProof")
 }
 
 func init() { proto.RegisterFile("block.proto", fileDescriptorBlock) }
 
 var fileDescriptorBlock = []byte{
-	// 754 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x5f, 0x8b, 0xdb, 0x46,
-	0x10, 0x47, 0xb6, 0x2c, 0xdb, 0x23, 0xfb, 0x38, 0xb6, 0x47, 0x51, 0xdd, 0x96, 0x33, 0x2a, 0x2d,
-	0xa6, 0xa5, 0x36, 0x5c, 0x0b, 0xd7, 0xc7, 0x5e, 0x72, 0x0f, 0x97, 0x10, 0xc2, 0xb1, 0xc9, 0x4b,
-	0x20, 0x60, 0x56, 0xd2, 0x5a, 0x12, 0x91, 0x76, 0x85, 0x76, 0xed, 0xdc, 0x7d, 0x84, 0x3c, 0xe6,
-	0x7b, 0xe4, 0x25, 0xdf, 0x30, 0xec, 0xac, 0x64, 0xcb, 0x97, 0x83, 0x90, 0x27, 0xed, 0x6f, 0x7e,
-	0x3b, 0xa3, 0xf9, 0xcd, 0x9f, 0x05, 0x3f, 0x2a, 0x64, 0xfc, 0x6e, 0x59, 0xd5, 0x52, 0x4b, 0xe2,
-	0xc5, 0xb2, 0xe6, 0x55, 0x34, 0xbb, 0x4c, 0x73, 0x9d, 0x6d, 0xa3, 0x65, 0x2c, 0xcb, 0x95, 0xe0,
-	0xd1, 0xb6, 0x60, 0x2a, 0x97, 0xab, 0x54, 0xfe, 0xdd, 0x80, 0x55, 0x2c, 0xcb, 0x52, 0x8a, 0x55,
-	0xc2, 0xd2, 0x55, 0x15, 0x99, 0x8f, 0x0d, 0x30, 0xfb, 0xef, 0xdb, 0x8e, 0x42, 0x71, 0xa1, 0xb6,
-	0xca, 0xf8, 0x29, 0xcd, 0x34, 0xb7, 0x9e, 0xe1, 0x47, 0x07, 0x86, 0x57, 0x71, 0x2c, 0xb7, 0x42,
-	0x93, 0x00, 0x86, 0x2c, 0x49, 0x6a, 0xae, 0x54, 0xe0, 0xcc, 0x9d, 0xc5, 0x84, 0xb6, 0xd0, 0x30,
-	0x11, 0x2b, 0x98, 0x88, 0x79, 0xd0, 0xb3, 0x4c, 0x03, 0xc9, 0x19, 0x0c, 0x84, 0x34, 0xf6, 0xfe,
-	0xdc, 0x59, 0xb8, 0xd4, 0x02, 0xf2, 0x33, 0x8c, 0x77, 0xac, 0x56, 0xeb, 0x8c, 0xa9, 0x2c, 0x70,
-	0xd1, 0x63, 0x64, 0x0c, 0x37, 0x4c, 0x65, 0xe4, 0x1c, 0xfc, 0x28, 0xaf, 0x75, 0xb6, 0xae, 0x0a,
-	0x16, 0xf3, 0x60, 0x80, 0x34, 0xa0, 0xe9, 0xd6, 0x58, 0xc2, 0x7f, 0xc1, 0xbd, 0x66, 0x9a, 0x11,
-	0x02, 0xae, 0xbe, 0xaf, 0x38, 0x26, 0x33, 0xa6, 0x78, 0x36, 0x99, 0x54, 0xec, 0xbe, 0x90, 0x2c,
-	0x69, 0x33, 0x69, 0x60, 0xf8, 0xa9, 0x07, 0xfe, 0xeb, 0x9a, 0x09, 0xc5, 0x62, 0x9d, 0x4b, 0x61,
-	0xbc, 0xf1, 0xf7, 0x56, 0x0a, 0x9e, 0x8d, 0x6d, 0x53, 0xcb, 0xb2, 0x71, 0xc5, 0x33, 0x39, 0x81,
-	0x9e, 0x96, 0x98, 0xfe, 0x84, 0xf6, 0xb4, 0x34, 0x8a, 0x76, 0xac, 0xd8, 0xf2, 0x26, 0x6f, 0x0b,
-	0x0e, 0x3a, 0x07, 0x5d, 0x9d, 0xbf, 0xc0, 0x58, 0xe7, 0x25, 0x57, 0x9a, 0x95, 0x55, 0xe0, 0xcd,
-	0x9d, 0x45, 0x9f, 0x1e, 0x0c, 0x64, 0x0e, 0x6e, 0xc2, 0x34, 0x0b, 0x86, 0x73, 0x67, 0xe1, 0x5f,
-	0x4c, 0x96, 0xb6, 0xcb, 0x4b, 0xa3, 0x8d, 0x22, 0x43, 0x7e, 0x82, 0x51, 0x9c, 0xb1, 0x5c, 0xac,
-	0xf3, 0x24, 0x18, 0xcd, 0x9d, 0xc5, 0x94, 0x0e, 0x11, 0x3f, 0x4b, 0x4c, 0x09, 0x53, 0xa6, 0xd6,
-	0x55, 0x9d, 0xc7, 0x3c, 0x18, 0xdb, 0x12, 0xa6, 0x4c, 0xdd, 0x1a, 0xdc, 0x92, 0x45, 0x5e, 0xe6,
-	0x3a, 0x80, 0x3d, 0xf9, 0xc2, 0x60, 0x72, 0x0a, 0x7d, 0x56, 0xa4, 0x81, 0x8f, 0xf1, 0xcc, 0xd1,
-	0xc8, 0x56, 0x79, 0x2a, 0x82, 0x89, 0x95, 0x6d, 0xce, 0xe1, 0x87, 0x3e, 0xf8, 0x4f, 0xcc, 0x0c,
-	0xde, 0x70, 0x96, 0xf0, 0xfa, 0xd1, 0x72, 0x9d, 0x83, 0x5f, 0xb1, 0x9a, 0x0b, 0x6d, 0x1b, 0x69,
-	0xab, 0x06, 0xd6, 0x84, 0xad, 0x9c, 0xc1, 0x28, 0x96, 0xb9, 0x88, 0x98, 0x6a, 0xcb, 0xb5, 0xc7,
-	0xc7, 0xb5, 0x19, 0x3c, 0xac, 0x4d, 0x57, 0xb9, 0x77, 0xac, 0xbc, 0xc9, 0x7f, 0xf8, 0x75, 0xfe,
-	0xa3, 0x43, 0xfe, 0xe4, 0x57, 0x00, 0x9c, 0xe3, 0x75, 0x2d, 0xa5, 0x6e, 0x0a, 0x34, 0x46, 0x0b,
-	0x95, 0x52, 0x9b, 0xf8, 0xfa, 0x4e, 0x59, 0xd2, 0x16, 0x68, 0xa8, 0xef, 0x14, 0x52, 0xe7, 0xe0,
-	0xf3, 0x1d, 0x17, 0xba, 0x61, 0x7d, 0xab, 0xca, 0x9a, 0xf0, 0xc2, 0x15, 0x9c, 0xec, 0xf7, 0xc5,
-	0xde, 0x99, 0x60, 0x07, 0x67, 0xcb, 0xbd, 0xb9, 0x8a, 0x96, 0x4f, 0xdb, 0xb3, 0xf1, 0xa1, 0xd3,
-	0xb8, 0x0b, 0xc9, 0x1f, 0xe0, 0xd5, 0x4c, 0x24, 0xb2, 0x0c, 0xa6, 0xe8, 0x7a, 0xd2, 0x36, 0x9f,
-	0xa2, 0x95, 0x36, 0xec, 0x73, 0x77, 0xd4, 0x3f, 0x75, 0xc3, 0xcf, 0x0e, 0x0c, 0xb0, 0x17, 0xe4,
-	0x2f, 0xf0, 0x32, 0xec, 0x07, 0xf6, 0xc1, 0xbf, 0xf8, 0xa1, 0xf5, 0xeb, 0xb4, 0x8a, 0x36, 0x57,
-	0xc8, 0x25, 0x4c, 0xf4, 0x61, 0xe0, 0x55, 0xd0, 0x9b, 0xf7, 0xbb, 0x2e, 0x9d, 0x65, 0xa0, 0x47,
-	0x17, 0xc9, 0x9f, 0x00, 0x09, 0xaf, 0xb8, 0x48, 0xb8, 0x88, 0xef, 0x71, 0xf4, 0xfd, 0x0b, 0x58,
-	0x26, 0x2c, 0xc5, 0xe9, 0x4c, 0x69, 0x87, 0x25, 0x3f, 0x9a, 0x8c, 0xf2, 0x34, 0xd3, 0xd8, 0x60,
-	0x97, 0x36, 0x28, 0x7c, 0x0b, 0xe3, 0x97, 0x5c, 0x63, 0x5a, 0x6a, 0xbf, 0x57, 0xcd, 0xa6, 0xe2,
-	0x5e, 0x9d, 0xc1, 0x20, 0x62, 0x3a, 0xb6, 0x63, 0xe3, 0x52, 0x0b, 0xc8, 0xef, 0xe0, 0xe1, 0xcb,
-	0xa7, 0x82, 0x3e, 0x66, 0x3b, 0x3d, 0x12, 0x48, 0x1b, 0x32, 0x7c, 0x03, 0xa3, 0x36, 0xfa, 0x77,
-	0x04, 0xff, 0x0d, 0x06, 0xe8, 0xdf, 0x48, 0x7a, 0x10, 0xdb, 0x72, 0xe1, 0x25, 0x4c, 0xaf, 0xe5,
-	0x7b, 0x61, 0xde, 0x8c, 0x7d, 0xfc, 0xc7, 0x1e, 0x0a, 0x9c, 0xb8, 0x5e, 0x67, 0x63, 0xfe, 0x07,
-	0xcf, 0x76, 0xcf, 0x0c, 0xd7, 0xae, 0xde, 0xac, 0x15, 0xe7, 0x49, 0xfb, 0x52, 0xee, 0xea, 0xcd,
-	0x2b, 0xce, 0x71, 0x6d, 0x0d, 0x55, 0xd5, 0x52, 0x6e, 0x1a, 0x6f, 0x73, 0xf7, 0xd6, 0xe0, 0xc8,
-	0xc3, 0x37, 0xf7, 0x9f, 0x2f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb1, 0x18, 0x56, 0x0a, 0xfd, 0x05,
-	0x00, 0x00,
+	// 881 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xdf, 0x8f, 0xe3, 0x34,
+	0x10, 0x56, 0x7f, 0xa5, 0xcd, 0xa4, 0x5d, 0x1d, 0x06, 0xa1, 0xb0, 0xc7, 0x69, 0xab, 0x20, 0xa0,
+	0x3a, 0x44, 0x2b, 0x2d, 0x48, 0xcb, 0x23, 0xc7, 0x2d, 0x68, 0x41, 0x80, 0x2a, 0x83, 0x84, 0x90,
+	0x90, 0x2a, 0x27, 0x71, 0x53, 0x43, 0x62, 0x47, 0xb1, 0x5b, 0x76, 0x5f, 0x79, 0xe3, 0x91, 0xff,
+	0x83, 0x17, 0xfe, 0x43, 0xe4, 0xb1, 0xd3, 0x4d, 0xef, 0x56, 0x3a, 0xed, 0x53, 0xfd, 0xcd, 0x78,
+	0x26, 0xdf, 0xcc, 0x37, 0xe3, 0x42, 0x94, 0x96, 0x2a, 0xfb, 0x63, 0x59, 0x37, 0xca, 0x28, 0x12,
+	0x64, 0xaa, 0xe1, 0x75, 0x7a, 0x7e, 0x55, 0x08, 0xb3, 0xdb, 0xa7, 0xcb, 0x4c, 0x55, 0x2b, 0xc9,
+	0xd3, 0x7d, 0xc9, 0xb4, 0x50, 0xab, 0x42, 0x7d, 0xea, 0xc1, 0x2a, 0x53, 0x55, 0xa5, 0xe4, 0x2a,
+	0x67, 0xc5, 0xaa, 0x4e, 0xed, 0x8f, 0x4b, 0x70, 0xfe, 0xc5, 0x9b, 0x03, 0xa5, 0xe6, 0x52, 0xef,
+	0xb5, 0x8d, 0xd3, 0x86, 0x19, 0xee, 0x22, 0x93, 0x7f, 0x7a, 0x30, 0x7e, 0x91, 0x65, 0x6a, 0x2f,
+	0x0d, 0x89, 0x61, 0xcc, 0xf2, 0xbc, 0xe1, 0x5a, 0xc7, 0xbd, 0x79, 0x6f, 0x31, 0xa5, 0x2d, 0xb4,
+	0x9e, 0x94, 0x95, 0x4c, 0x66, 0x3c, 0xee, 0x3b, 0x8f, 0x87, 0xe4, 0x1d, 0x18, 0x49, 0x65, 0xed,
+	0x83, 0x79, 0x6f, 0x31, 0xa4, 0x0e, 0x90, 0xa7, 0x10, 0x1e, 0x58, 0xa3, 0x37, 0x3b, 0xa6, 0x77,
+	0xf1, 0x10, 0x23, 0x26, 0xd6, 0x70, 0xc3, 0xf4, 0x8e, 0x5c, 0x40, 0x94, 0x8a, 0xc6, 0xec, 0x36,
+	0x75, 0xc9, 0x32, 0x1e, 0x8f, 0xd0, 0x0d, 0x68, 0x5a, 0x5b, 0x4b, 0xf2, 0x39, 0x0c, 0xaf, 0x99,
+	0x61, 0x84, 0xc0, 0xd0, 0xdc, 0xd5, 0x1c, 0xc9, 0x84, 0x14, 0xcf, 0x96, 0x49, 0xcd, 0xee, 0x4a,
+	0xc5, 0xf2, 0x96, 0x89, 0x87, 0xc9, 0xbf, 0x7d, 0x88, 0x7e, 0x6e, 0x98, 0xd4, 0x2c, 0x33, 0x42,
+	0x49, 0x1b, 0x8d, 0x9f, 0x77, 0xa5, 0xe0, 0xd9, 0xda, 0xb6, 0x8d, 0xaa, 0x7c, 0x28, 0x9e, 0xc9,
+	0x19, 0xf4, 0x8d, 0x42, 0xfa, 0x53, 0xda, 0x37, 0xca, 0x56, 0x74, 0x60, 0xe5, 0x9e, 0x7b, 0xde,
+	0x0e, 0xdc, 0xd7, 0x39, 0xea, 0xd6, 0xf9, 0x3e, 0x84, 0x46, 0x54, 0x5c, 0x1b, 0x56, 0xd5, 0x71,
+	0x30, 0xef, 0x2d, 0x06, 0xf4, 0xde, 0x40, 0xe6, 0x30, 0xcc, 0x99, 0x61, 0xf1, 0x78, 0xde, 0x5b,
+	0x44, 0x97, 0xd3, 0xa5, 0x53, 0x79, 0x69, 0x6b, 0xa3, 0xe8, 0x21, 0xef, 0xc1, 0x24, 0xdb, 0x31,
+	0x21, 0x37, 0x22, 0x8f, 0x27, 0xf3, 0xde, 0x62, 0x46, 0xc7, 0x88, 0xbf, 0xcd, 0x6d, 0x0b, 0x0b,
+	0xa6, 0x37, 0x75, 0x23, 0x32, 0x1e, 0x87, 0xae, 0x85, 0x05, 0xd3, 0x6b, 0x8b, 0x5b, 0x67, 0x29,
+	0x2a, 0x61, 0x62, 0x38, 0x3a, 0xbf, 0xb7, 0x98, 0x3c, 0x81, 0x01, 0x2b, 0x8b, 0x38, 0xc2, 0x7c,
+	0xf6, 0x68, 0xcb, 0xd6, 0xa2, 0x90, 0xf1, 0xd4, 0x95, 0x6d, 0xcf, 0xc9, 0xdf, 0x03, 0x88, 0xbe,
+	0xb2, 0x33, 0x78, 0xc3, 0x59, 0xce, 0x9b, 0x07, 0xdb, 0x75, 0x01, 0x51, 0xcd, 0x1a, 0x2e, 0x8d,
+	0x13, 0xd2, 0x75, 0x0d, 0x9c, 0x09, 0xa5, 0x3c, 0x87, 0x49, 0xa6, 0x84, 0x4c, 0x99, 0x6e, 0xdb,
+	0x75, 0xc4, 0xa7, 0xbd, 0x19, 0xbd, 0xda, 0x9b, 0x6e, 0xe5, 0xc1, 0x69, 0xe5, 0x9e, 0xff, 0xf8,
+	0x75, 0xfe, 0x93, 0x7b, 0xfe, 0xe4, 0x19, 0x00, 0xce, 0xf1, 0xa6, 0x51, 0xca, 0xf8, 0x06, 0x85,
+	0x68, 0xa1, 0x4a, 0x19, 0x9b, 0xdf, 0xdc, 0x6a, 0xe7, 0x74, 0x0d, 0x1a, 0x9b, 0x5b, 0x8d, 0xae,
+	0x0b, 0x88, 0xf8, 0x81, 0x4b, 0xe3, 0xbd, 0x91, 0xab, 0xca, 0x99, 0xf0, 0xc2, 0x0b, 0x38, 0x3b,
+	0xee, 0x8b, 0xbb, 0x33, 0x45, 0x05, 0xcf, 0x97, 0x47, 0x73, 0x9d, 0x2e, 0x5f, 0xb6, 0x67, 0x1b,
+	0x43, 0x67, 0x59, 0x17, 0x92, 0x8f, 0x20, 0x68, 0x98, 0xcc, 0x55, 0x15, 0xcf, 0x30, 0xf4, 0xac,
+	0x15, 0x9f, 0xa2, 0x95, 0x7a, 0xef, 0x77, 0xc3, 0xc9, 0xe0, 0xc9, 0x30, 0xf9, 0xaf, 0x07, 0x23,
+	0xd4, 0x82, 0x7c, 0x02, 0xc1, 0x0e, 0xf5, 0x40, 0x1d, 0xa2, 0xcb, 0xb7, 0xdb, 0xb8, 0x8e, 0x54,
+	0xd4, 0x5f, 0x21, 0x57, 0x30, 0x35, 0xf7, 0x03, 0xaf, 0xe3, 0xfe, 0x7c, 0xd0, 0x0d, 0xe9, 0x2c,
+	0x03, 0x3d, 0xb9, 0x48, 0x9e, 0x03, 0xe4, 0xbc, 0xe6, 0x32, 0xe7, 0x32, 0xbb, 0xc3, 0xd1, 0x8f,
+	0x2e, 0x61, 0x99, 0xb3, 0x02, 0xa7, 0xb3, 0xa0, 0x1d, 0x2f, 0x79, 0xd7, 0x32, 0x12, 0xc5, 0xce,
+	0xa0, 0xc0, 0x43, 0xea, 0x51, 0xf2, 0x1b, 0x84, 0x3f, 0x72, 0x83, 0xb4, 0xf4, 0x71, 0xaf, 0xfc,
+	0xa6, 0xda, 0xb3, 0xdd, 0x98, 0x94, 0x99, 0xcc, 0x8d, 0xcd, 0x90, 0x3a, 0x40, 0x3e, 0x84, 0x00,
+	0x5f, 0x3e, 0x1d, 0x0f, 0x90, 0xed, 0xec, 0xa4, 0x40, 0xea, 0x9d, 0xc9, 0xaf, 0x30, 0x69, 0xb3,
+	0x3f, 0x22, 0xf9, 0x07, 0x30, 0xc2, 0x78, 0x5f, 0xd2, 0x2b, 0xb9, 0x9d, 0x2f, 0xb9, 0x82, 0xd9,
+	0xb5, 0xfa, 0x53, 0xda, 0x37, 0xe3, 0x98, 0xff, 0xa1, 0x87, 0x02, 0x27, 0xae, 0xdf, 0xd9, 0x98,
+	0x2f, 0x21, 0x70, 0xea, 0xd9, 0xe1, 0x3a, 0x34, 0xdb, 0x8d, 0xe6, 0x3c, 0x6f, 0x5f, 0xca, 0x43,
+	0xb3, 0xfd, 0x89, 0x73, 0x5c, 0x5b, 0xeb, 0xaa, 0x1b, 0xa5, 0xb6, 0x3e, 0xda, 0xde, 0x5d, 0x5b,
+	0x9c, 0xfc, 0xd5, 0x03, 0xc0, 0x6f, 0x22, 0x7c, 0x9c, 0xd8, 0x4f, 0x21, 0x34, 0xb7, 0xb8, 0x87,
+	0xdc, 0x29, 0x3d, 0xa5, 0x13, 0x73, 0x7b, 0x83, 0xf8, 0x31, 0x82, 0x26, 0xbf, 0x03, 0xb9, 0x56,
+	0xfb, 0xb4, 0xe4, 0x3f, 0x08, 0x69, 0xbe, 0x3e, 0x08, 0x6b, 0xe5, 0x64, 0x01, 0xa3, 0xad, 0x68,
+	0xb4, 0xf1, 0x54, 0xc8, 0x09, 0x15, 0xa4, 0x4b, 0xdd, 0x05, 0xf2, 0x1c, 0x02, 0xcd, 0x33, 0x25,
+	0xdd, 0x03, 0xfc, 0xf0, 0x55, 0x7f, 0x23, 0x59, 0x43, 0xb8, 0x6e, 0xf8, 0x4b, 0x55, 0xd9, 0x77,
+	0xe9, 0x19, 0x00, 0x2a, 0xb0, 0xe9, 0x74, 0x3b, 0x44, 0x0b, 0xbe, 0x25, 0x7e, 0xed, 0xfb, 0xaf,
+	0xaf, 0xfd, 0xa0, 0x23, 0xc2, 0x2f, 0x30, 0xfb, 0x46, 0x48, 0x56, 0x0a, 0x73, 0xe7, 0x9a, 0xf8,
+	0x86, 0xac, 0x1f, 0xc3, 0xe8, 0xa0, 0x0c, 0x6f, 0x97, 0xe3, 0xad, 0x96, 0xec, 0x91, 0x16, 0x75,
+	0xfe, 0x34, 0xc0, 0xff, 0xc3, 0xcf, 0xfe, 0x1f, 0x00, 0x31, 0x8d, 0x93, 0xff, 0x99, 0x07, 0x00,
+	0x00,
 }
@@ -12,7 +12,11 @@ It has these top-level messages:
 	GenesisMeta
 	GenesisConsensus
 	GenesisConsensusDpos
+	GenesisConsensusPoa
+	GenesisConsensusPod
 	GenesisTokenDistribution
+	GenesisNr
+	GenesisDip
 */
 package corepb
 
@@ -39,6 +43,10 @@ type Genesis struct {
 	// genesis token distribution address
 	// map<string, string> token_distribution = 3;
 	TokenDistribution []*GenesisTokenDistribution `protobuf:"bytes,3,rep,name=token_distribution,json=tokenDistribution" json:"token_distribution,omitempty"`
+	// nebulas rank config, the defaults are used if not set.
+	Nr *GenesisNr `protobuf:"bytes,4,opt,name=nr" json:"nr,omitempty"`
+	// developer incentive protocol config, dip is disabled if not set.
+	Dip *GenesisDip `protobuf:"bytes,5,opt,name=dip" json:"dip,omitempty"`
 }
 
 func (m *Genesis) Reset()                    { *m = Genesis{} }
@@ -67,6 +75,20 @@ func (m *Genesis) GetTokenDistribution() []*GenesisTokenDistribution {
 	return nil
 }
 
+func (m *Genesis) GetNr() *GenesisNr {
+	if m != nil {
+		return m.Nr
+	}
+	return nil
+}
+
+func (m *Genesis) GetDip() *GenesisDip {
+	if m != nil {
+		return m.Dip
+	}
+	return nil
+}
+
 type GenesisMeta struct {
 	// ChainID.
 	ChainId uint32 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
@@ -85,8 +107,13 @@ func (m *GenesisMeta) GetChainId() uint32 {
 }
 
 type GenesisConsensus struct {
-	// ChainID.
-	Dpos *GenesisConsensusDpos `protobuf:"bytes,1,opt,name=dpos" json:"dpos,omitempty"`
+	// consensus engine of the chain.
+	//
+	// Types that are valid to be assigned to Engine:
+	//	*GenesisConsensus_Dpos
+	//	*GenesisConsensus_Poa
+	//	*GenesisConsensus_Pod
+	Engine isGenesisConsensus_Engine `protobuf_oneof:"engine"`
 }
 
 func (m *GenesisConsensus) Reset()                    { *m = GenesisConsensus{} }
@@ -94,16 +121,155 @@ func (m *GenesisConsensus) String() string            { return proto.CompactText
 func (*GenesisConsensus) ProtoMessage()               {}
 func (*GenesisConsensus) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{2} }
 
-func (m *GenesisConsensus) GetDpos() *GenesisConsensusDpos {
+type isGenesisConsensus_Engine interface {
+	isGenesisConsensus_Engine()
+}
+
+type GenesisConsensus_Dpos struct {
+	Dpos *GenesisConsensusDpos `protobuf:"bytes,1,opt,name=dpos,oneof"`
+}
+type GenesisConsensus_Poa struct {
+	Poa *GenesisConsensusPoa `protobuf:"bytes,2,opt,name=poa,oneof"`
+}
+type GenesisConsensus_Pod struct {
+	Pod *GenesisConsensusPod `protobuf:"bytes,3,opt,name=pod,oneof"`
+}
+
+func (*GenesisConsensus_Dpos) isGenesisConsensus_Engine() {}
+func (*GenesisConsensus_Poa) isGenesisConsensus_Engine()  {}
+func (*GenesisConsensus_Pod) isGenesisConsensus_Engine()  {}
+
+func (m *GenesisConsensus) GetEngine() isGenesisConsensus_Engine {
 	if m != nil {
-		return m.Dpos
+		return m.Engine
+	}
+	return nil
+}
+
+func (m *GenesisConsensus) GetDpos() *GenesisConsensusDpos {
+	if x, ok := m.GetEngine().(*GenesisConsensus_Dpos); ok {
+		return x.Dpos
+	}
+	return nil
+}
+
+func (m *GenesisConsensus) GetPoa() *GenesisConsensusPoa {
+	if x, ok := m.GetEngine().(*GenesisConsensus_Poa); ok {
+		return x.Poa
+	}
+	return nil
+}
+
+func (m *GenesisConsensus) GetPod() *GenesisConsensusPod {
+	if x, ok := m.GetEngine().(*GenesisConsensus_Pod); ok {
+		return x.Pod
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*GenesisConsensus) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _GenesisConsensus_OneofMarshaler, _GenesisConsensus_OneofUnmarshaler, _GenesisConsensus_OneofSizer, []interface{}{
+		(*GenesisConsensus_Dpos)(nil),
+		(*GenesisConsensus_Poa)(nil),
+		(*GenesisConsensus_Pod)(nil),
+	}
+}
+
+func _GenesisConsensus_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*GenesisConsensus)
+	// engine
+	switch x := m.Engine.(type) {
+	case *GenesisConsensus_Dpos:
+		_ = b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Dpos); err != nil {
+			return err
+		}
+	case *GenesisConsensus_Poa:
+		_ = b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Poa); err != nil {
+			return err
+		}
+	case *GenesisConsensus_Pod:
+		_ = b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Pod); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("GenesisConsensus.Engine has unexpected type %T", x)
 	}
 	return nil
 }
 
+func _GenesisConsensus_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*GenesisConsensus)
+	switch tag {
+	case 1: // engine.dpos
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(GenesisConsensusDpos)
+		err := b.DecodeMessage(msg)
+		m.Engine = &GenesisConsensus_Dpos{msg}
+		return true, err
+	case 2: // engine.poa
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(GenesisConsensusPoa)
+		err := b.DecodeMessage(msg)
+		m.Engine = &GenesisConsensus_Poa{msg}
+		return true, err
+	case 3: // engine.pod
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(GenesisConsensusPod)
+		err := b.DecodeMessage(msg)
+		m.Engine = &GenesisConsensus_Pod{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _GenesisConsensus_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*GenesisConsensus)
+	// engine
+	switch x := m.Engine.(type) {
+	case *GenesisConsensus_Dpos:
+		s := proto.Size(x.Dpos)
+		n += proto.SizeVarint(1<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *GenesisConsensus_Poa:
+		s := proto.Size(x.Poa)
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *GenesisConsensus_Pod:
+		s := proto.Size(x.Pod)
+		n += proto.SizeVarint(3<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
 type GenesisConsensusDpos struct {
 	// dpos genesis dynasty address
 	Dynasty []string `protobuf:"bytes,1,rep,name=dynasty" json:"dynasty,omitempty"`
+	// dpos timing and dynasty size, the defaults are used if not set.
+	BlockIntervalInMs        int64  `protobuf:"varint,2,opt,name=block_interval_in_ms,json=blockIntervalInMs,proto3" json:"block_interval_in_ms,omitempty"`
+	DynastyIntervalInMs      int64  `protobuf:"varint,3,opt,name=dynasty_interval_in_ms,json=dynastyIntervalInMs,proto3" json:"dynasty_interval_in_ms,omitempty"`
+	DynastySize              uint32 `protobuf:"varint,4,opt,name=dynasty_size,json=dynastySize,proto3" json:"dynasty_size,omitempty"`
+	AcceptedNetworkDelayInMs int64  `protobuf:"varint,5,opt,name=accepted_network_delay_in_ms,json=acceptedNetworkDelayInMs,proto3" json:"accepted_network_delay_in_ms,omitempty"`
+	MaxMintDurationInMs      int64  `protobuf:"varint,6,opt,name=max_mint_duration_in_ms,json=maxMintDurationInMs,proto3" json:"max_mint_duration_in_ms,omitempty"`
+	MinMintDurationInMs      int64  `protobuf:"varint,7,opt,name=min_mint_duration_in_ms,json=minMintDurationInMs,proto3" json:"min_mint_duration_in_ms,omitempty"`
 }
 
 func (m *GenesisConsensusDpos) Reset()                    { *m = GenesisConsensusDpos{} }
@@ -118,6 +284,134 @@ func (m *GenesisConsensusDpos) GetDynasty() []string {
 	return nil
 }
 
+func (m *GenesisConsensusDpos) GetBlockIntervalInMs() int64 {
+	if m != nil {
+		return m.BlockIntervalInMs
+	}
+	return 0
+}
+
+func (m *GenesisConsensusDpos) GetDynastyIntervalInMs() int64 {
+	if m != nil {
+		return m.DynastyIntervalInMs
+	}
+	return 0
+}
+
+func (m *GenesisConsensusDpos) GetDynastySize() uint32 {
+	if m != nil {
+		return m.DynastySize
+	}
+	return 0
+}
+
+func (m *GenesisConsensusDpos) GetAcceptedNetworkDelayInMs() int64 {
+	if m != nil {
+		return m.AcceptedNetworkDelayInMs
+	}
+	return 0
+}
+
+func (m *GenesisConsensusDpos) GetMaxMintDurationInMs() int64 {
+	if m != nil {
+		return m.MaxMintDurationInMs
+	}
+	return 0
+}
+
+func (m *GenesisConsensusDpos) GetMinMintDurationInMs() int64 {
+	if m != nil {
+		return m.MinMintDurationInMs
+	}
+	return 0
+}
+
+type GenesisConsensusPoa struct {
+	// poa genesis validator address
+	Validators []string `protobuf:"bytes,1,rep,name=validators" json:"validators,omitempty"`
+	// approvals needed to add or remove a validator, more than half of the validators if not set.
+	Threshold uint32 `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// poa block interval, the default is used if not set.
+	BlockIntervalInMs int64 `protobuf:"varint,3,opt,name=block_interval_in_ms,json=blockIntervalInMs,proto3" json:"block_interval_in_ms,omitempty"`
+}
+
+func (m *GenesisConsensusPoa) Reset()                    { *m = GenesisConsensusPoa{} }
+func (m *GenesisConsensusPoa) String() string            { return proto.CompactTextString(m) }
+func (*GenesisConsensusPoa) ProtoMessage()               {}
+func (*GenesisConsensusPoa) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{4} }
+
+func (m *GenesisConsensusPoa) GetValidators() []string {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *GenesisConsensusPoa) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *GenesisConsensusPoa) GetBlockIntervalInMs() int64 {
+	if m != nil {
+		return m.BlockIntervalInMs
+	}
+	return 0
+}
+
+type GenesisConsensusPod struct {
+	// pod genesis dynasty address, they keep the seats until enough validators joined.
+	Dynasty []string `protobuf:"bytes,1,rep,name=dynasty" json:"dynasty,omitempty"`
+	// minimal deposit in wei to join the validators, the default is used if not set.
+	MinDeposit string `protobuf:"bytes,2,opt,name=min_deposit,json=minDeposit,proto3" json:"min_deposit,omitempty"`
+	// pod timing and dynasty size, the defaults are used if not set.
+	BlockIntervalInMs   int64  `protobuf:"varint,3,opt,name=block_interval_in_ms,json=blockIntervalInMs,proto3" json:"block_interval_in_ms,omitempty"`
+	DynastyIntervalInMs int64  `protobuf:"varint,4,opt,name=dynasty_interval_in_ms,json=dynastyIntervalInMs,proto3" json:"dynasty_interval_in_ms,omitempty"`
+	DynastySize         uint32 `protobuf:"varint,5,opt,name=dynasty_size,json=dynastySize,proto3" json:"dynasty_size,omitempty"`
+}
+
+func (m *GenesisConsensusPod) Reset()                    { *m = GenesisConsensusPod{} }
+func (m *GenesisConsensusPod) String() string            { return proto.CompactTextString(m) }
+func (*GenesisConsensusPod) ProtoMessage()               {}
+func (*GenesisConsensusPod) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{5} }
+
+func (m *GenesisConsensusPod) GetDynasty() []string {
+	if m != nil {
+		return m.Dynasty
+	}
+	return nil
+}
+
+func (m *GenesisConsensusPod) GetMinDeposit() string {
+	if m != nil {
+		return m.MinDeposit
+	}
+	return ""
+}
+
+func (m *GenesisConsensusPod) GetBlockIntervalInMs() int64 {
+	if m != nil {
+		return m.BlockIntervalInMs
+	}
+	return 0
+}
+
+func (m *GenesisConsensusPod) GetDynastyIntervalInMs() int64 {
+	if m != nil {
+		return m.DynastyIntervalInMs
+	}
+	return 0
+}
+
+func (m *GenesisConsensusPod) GetDynastySize() uint32 {
+	if m != nil {
+		return m.DynastySize
+	}
+	return 0
+}
+
 type GenesisTokenDistribution struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Value   string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
@@ -126,7 +420,7 @@ type GenesisTokenDistribution struct {
 func (m *GenesisTokenDistribution) Reset()                    { *m = GenesisTokenDistribution{} }
 func (m *GenesisTokenDistribution) String() string            { return proto.CompactTextString(m) }
 func (*GenesisTokenDistribution) ProtoMessage()               {}
-func (*GenesisTokenDistribution) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{4} }
+func (*GenesisTokenDistribution) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{6} }
 
 func (m *GenesisTokenDistribution) GetAddress() string {
 	if m != nil {
@@ -142,33 +436,92 @@ func (m *GenesisTokenDistribution) GetValue() string {
 	return ""
 }
 
+type GenesisNr struct {
+	// count of blocks in a nr period, the default is used if not set.
+	Window uint64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (m *GenesisNr) Reset()                    { *m = GenesisNr{} }
+func (m *GenesisNr) String() string            { return proto.CompactTextString(m) }
+func (*GenesisNr) ProtoMessage()               {}
+func (*GenesisNr) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{7} }
+
+func (m *GenesisNr) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+type GenesisDip struct {
+	// total reward of a dip period in wei, the default is used if not set.
+	RewardValue string `protobuf:"bytes,1,opt,name=reward_value,json=rewardValue,proto3" json:"reward_value,omitempty"`
+}
+
+func (m *GenesisDip) Reset()                    { *m = GenesisDip{} }
+func (m *GenesisDip) String() string            { return proto.CompactTextString(m) }
+func (*GenesisDip) ProtoMessage()               {}
+func (*GenesisDip) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{8} }
+
+func (m *GenesisDip) GetRewardValue() string {
+	if m != nil {
+		return m.RewardValue
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Genesis)(nil), "corepb.Genesis")
 	proto.RegisterType((*GenesisMeta)(nil), "corepb.GenesisMeta")
 	proto.RegisterType((*GenesisConsensus)(nil), "corepb.GenesisConsensus")
 	proto.RegisterType((*GenesisConsensusDpos)(nil), "corepb.GenesisConsensusDpos")
+	proto.RegisterType((*GenesisConsensusPoa)(nil), "corepb.GenesisConsensusPoa")
+	proto.RegisterType((*GenesisConsensusPod)(nil), "corepb.GenesisConsensusPod")
 	proto.RegisterType((*GenesisTokenDistribution)(nil), "corepb.GenesisTokenDistribution")
+	proto.RegisterType((*GenesisNr)(nil), "corepb.GenesisNr")
+	proto.RegisterType((*GenesisDip)(nil), "corepb.GenesisDip")
 }
 
 func init() { proto.RegisterFile("genesis.proto", fileDescriptorGenesis) }
 
 var fileDescriptorGenesis = []byte{
-	// 264 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x51, 0x4b, 0xc3, 0x30,
-	0x14, 0x85, 0xa9, 0x9d, 0xab, 0xbd, 0x65, 0xa0, 0x71, 0x0f, 0x11, 0x7c, 0x28, 0x79, 0xb1, 0x4f,
-	0x65, 0x4c, 0xf0, 0x0f, 0x58, 0x10, 0x05, 0x11, 0x82, 0xef, 0x23, 0x6d, 0x2e, 0x1a, 0xd4, 0xa4,
-	0xf4, 0xa6, 0xc2, 0x7e, 0x9b, 0x7f, 0x4e, 0x96, 0xae, 0x38, 0x8a, 0x7b, 0x3c, 0x39, 0x5f, 0x2e,
-	0xe7, 0xdc, 0x0b, 0x8b, 0x37, 0xb4, 0x48, 0x86, 0xca, 0xb6, 0x73, 0xde, 0xb1, 0x79, 0xe3, 0x3a,
-	0x6c, 0x6b, 0xf1, 0x13, 0x41, 0xf2, 0x30, 0x38, 0xec, 0x06, 0x66, 0x5f, 0xe8, 0x15, 0x8f, 0xf2,
-	0xa8, 0xc8, 0xd6, 0x97, 0xe5, 0x80, 0x94, 0x7b, 0xfb, 0x19, 0xbd, 0x92, 0x01, 0x60, 0x77, 0x90,
-	0x36, 0xce, 0x12, 0x5a, 0xea, 0x89, 0x9f, 0x04, 0x9a, 0x4f, 0xe8, 0xfb, 0xd1, 0x97, 0x7f, 0x28,
-	0x7b, 0x01, 0xe6, 0xdd, 0x07, 0xda, 0x8d, 0x36, 0xe4, 0x3b, 0x53, 0xf7, 0xde, 0x38, 0xcb, 0xe3,
-	0x3c, 0x2e, 0xb2, 0x75, 0x3e, 0x19, 0xf0, 0xba, 0x03, 0xab, 0x03, 0x4e, 0x5e, 0xf8, 0xe9, 0x93,
-	0x28, 0x20, 0x3b, 0x48, 0xc7, 0xae, 0xe0, 0xac, 0x79, 0x57, 0xc6, 0x6e, 0x8c, 0x0e, 0x25, 0x16,
-	0x32, 0x09, 0xfa, 0x51, 0x8b, 0x0a, 0xce, 0xa7, 0xc9, 0xd8, 0x0a, 0x66, 0xba, 0x75, 0xb4, 0xef,
-	0x7b, 0x7d, 0xac, 0x41, 0xd5, 0x3a, 0x92, 0x81, 0x14, 0x2b, 0x58, 0xfe, 0xe7, 0x32, 0x0e, 0x89,
-	0xde, 0x5a, 0x45, 0x7e, 0xcb, 0xa3, 0x3c, 0x2e, 0x52, 0x39, 0x4a, 0xf1, 0x04, 0xfc, 0x58, 0xa1,
-	0xdd, 0x2f, 0xa5, 0x75, 0x87, 0x34, 0x44, 0x48, 0xe5, 0x28, 0xd9, 0x12, 0x4e, 0xbf, 0xd5, 0x67,
-	0x8f, 0x61, 0xb9, 0xa9, 0x1c, 0x44, 0x3d, 0x0f, 0xa7, 0xbb, 0xfd, 0x0d, 0x00, 0x00, 0xff, 0xff,
-	0xbe, 0xf4, 0x0e, 0x3f, 0xcb, 0x01, 0x00, 0x00,
+	// 595 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xdf, 0x6e, 0xd3, 0x3e,
+	0x14, 0xc7, 0xd7, 0xa6, 0x7f, 0x96, 0x93, 0x5f, 0xa5, 0xdf, 0xbc, 0x69, 0x04, 0x31, 0x41, 0x17,
+	0x90, 0xe8, 0xd5, 0x26, 0x6d, 0x88, 0x4b, 0x2e, 0x20, 0x12, 0x1b, 0xd2, 0x06, 0x32, 0x88, 0xdb,
+	0xc8, 0x8d, 0xad, 0xcd, 0x5a, 0x62, 0x47, 0xb6, 0xbb, 0xae, 0xbb, 0xde, 0x13, 0xf1, 0x36, 0x3c,
+	0x00, 0xef, 0x81, 0x62, 0xbb, 0x6b, 0x09, 0xed, 0x80, 0xcb, 0x73, 0xce, 0xf7, 0x73, 0x72, 0xfe,
+	0x38, 0x07, 0x06, 0x17, 0x4c, 0x30, 0xcd, 0xf5, 0x41, 0xa5, 0xa4, 0x91, 0xa8, 0x97, 0x4b, 0xc5,
+	0xaa, 0x71, 0x72, 0xd7, 0x86, 0xfe, 0x7b, 0x17, 0x41, 0x2f, 0xa1, 0x53, 0x32, 0x43, 0xe2, 0xd6,
+	0xb0, 0x35, 0x8a, 0x8e, 0xb6, 0x0f, 0x9c, 0xe4, 0xc0, 0x87, 0xcf, 0x98, 0x21, 0xd8, 0x0a, 0xd0,
+	0x6b, 0x08, 0x73, 0x29, 0x34, 0x13, 0x7a, 0xa2, 0xe3, 0xb6, 0x55, 0xc7, 0x0d, 0xf5, 0xbb, 0x79,
+	0x1c, 0x2f, 0xa4, 0xe8, 0x23, 0x20, 0x23, 0xaf, 0x98, 0xc8, 0x28, 0xd7, 0x46, 0xf1, 0xf1, 0xc4,
+	0x70, 0x29, 0xe2, 0x60, 0x18, 0x8c, 0xa2, 0xa3, 0x61, 0x23, 0xc1, 0x97, 0x5a, 0x98, 0x2e, 0xe9,
+	0xf0, 0x96, 0x69, 0xba, 0xd0, 0x3e, 0xb4, 0x85, 0x8a, 0x3b, 0xb6, 0x82, 0xad, 0x46, 0x82, 0x73,
+	0x85, 0xdb, 0x42, 0xa1, 0x17, 0x10, 0x50, 0x5e, 0xc5, 0x5d, 0xab, 0x41, 0x0d, 0x4d, 0xca, 0x2b,
+	0x5c, 0x87, 0x93, 0x11, 0x44, 0x4b, 0x6d, 0xa2, 0xc7, 0xb0, 0x99, 0x5f, 0x12, 0x2e, 0x32, 0x4e,
+	0xed, 0x34, 0x06, 0xb8, 0x6f, 0xed, 0x53, 0x9a, 0x7c, 0x6b, 0xc1, 0xff, 0xcd, 0x1e, 0xd1, 0x11,
+	0x74, 0x68, 0x25, 0xb5, 0x9f, 0xdc, 0xde, 0xba, 0x59, 0xa4, 0x95, 0xd4, 0x27, 0x1b, 0xd8, 0x6a,
+	0xd1, 0x21, 0x04, 0x95, 0x24, 0x7e, 0x7c, 0x4f, 0xd6, 0x21, 0x9f, 0x24, 0x39, 0xd9, 0xc0, 0xb5,
+	0xd2, 0x01, 0x34, 0x0e, 0xfe, 0x04, 0x50, 0x07, 0xd0, 0xb7, 0x9b, 0xd0, 0x63, 0xe2, 0x82, 0x0b,
+	0x96, 0xfc, 0x68, 0xc3, 0xce, 0xaa, 0x62, 0x50, 0x0c, 0x7d, 0x3a, 0x13, 0x44, 0x9b, 0x59, 0xdc,
+	0x1a, 0x06, 0xa3, 0x10, 0xcf, 0x4d, 0x74, 0x08, 0x3b, 0xe3, 0x42, 0xe6, 0x57, 0x19, 0x17, 0x86,
+	0xa9, 0x6b, 0x52, 0x64, 0x5c, 0x64, 0xa5, 0x5b, 0x77, 0x80, 0xb7, 0x6c, 0xec, 0xd4, 0x87, 0x4e,
+	0xc5, 0x99, 0x46, 0xc7, 0xb0, 0xeb, 0xd9, 0x26, 0x12, 0x58, 0x64, 0xdb, 0x47, 0x7f, 0x81, 0xf6,
+	0xe1, 0xbf, 0x39, 0xa4, 0xf9, 0x2d, 0xb3, 0xab, 0x1c, 0xe0, 0xc8, 0xfb, 0x3e, 0xf3, 0x5b, 0x86,
+	0xde, 0xc0, 0x1e, 0xc9, 0x73, 0x56, 0x19, 0x46, 0x33, 0xc1, 0xcc, 0x54, 0xaa, 0xab, 0x8c, 0xb2,
+	0x82, 0xcc, 0x7c, 0xf6, 0xae, 0xcd, 0x1e, 0xcf, 0x35, 0xe7, 0x4e, 0x92, 0xd6, 0x0a, 0xfb, 0x89,
+	0x57, 0xf0, 0xa8, 0x24, 0x37, 0x59, 0xc9, 0x85, 0xc9, 0xe8, 0x44, 0x91, 0xfa, 0xe1, 0x78, 0xb4,
+	0xe7, 0x0a, 0x2b, 0xc9, 0xcd, 0x19, 0x17, 0x26, 0xf5, 0xc1, 0x7b, 0x8a, 0x8b, 0x95, 0x54, 0xdf,
+	0x53, 0x5c, 0x34, 0xa9, 0xe4, 0xae, 0x05, 0xdb, 0x2b, 0x36, 0x88, 0x9e, 0x02, 0x5c, 0x93, 0x82,
+	0x53, 0x62, 0xa4, 0xd2, 0x7e, 0xd2, 0x4b, 0x1e, 0xb4, 0x07, 0xa1, 0xb9, 0x54, 0x4c, 0x5f, 0xca,
+	0x82, 0xda, 0x09, 0x0f, 0xf0, 0xc2, 0xb1, 0x76, 0x15, 0xc1, 0x9a, 0x55, 0x24, 0xdf, 0x57, 0x96,
+	0x41, 0x1f, 0xd8, 0xf6, 0x33, 0x88, 0xea, 0x76, 0x29, 0xab, 0xa4, 0xe6, 0xc6, 0x96, 0x10, 0x62,
+	0x28, 0xb9, 0x48, 0x9d, 0xe7, 0x9f, 0x6b, 0x78, 0xe0, 0x39, 0x74, 0xfe, 0xfe, 0x39, 0x74, 0x7f,
+	0x7b, 0x0e, 0xc9, 0x07, 0x88, 0xd7, 0x5d, 0x88, 0xba, 0x3f, 0x42, 0xa9, 0x62, 0xda, 0xfd, 0x89,
+	0x21, 0x9e, 0x9b, 0x68, 0x07, 0xba, 0xd7, 0xa4, 0x98, 0x30, 0xdf, 0x99, 0x33, 0x92, 0xe7, 0x10,
+	0xde, 0x1f, 0x0b, 0xb4, 0x0b, 0xbd, 0x29, 0x17, 0x54, 0x4e, 0x2d, 0xdb, 0xc1, 0xde, 0x4a, 0x0e,
+	0x01, 0x16, 0xd7, 0xa2, 0xae, 0x50, 0xb1, 0x29, 0x51, 0x34, 0x73, 0xf9, 0xdc, 0x77, 0x22, 0xe7,
+	0xfb, 0x5a, 0xbb, 0xc6, 0x3d, 0x7b, 0x61, 0x8f, 0x7f, 0x0e, 0x00, 0x63, 0x1a, 0xe5, 0x7d, 0x72,
+	0x05, 0x00, 0x00,
 }
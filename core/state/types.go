@@ -39,6 +39,9 @@ var (
 	ErrCannotUpdateTxStateBeforePrepare    = errors.New("cannot update a tx state before prepare")
 	ErrCannotResetTxStateBeforePrepare     = errors.New("cannot reset a tx state before prepare")
 	ErrContractCheckFailed                 = errors.New("contract check failed")
+	ErrConsensusWithoutElection            = errors.New("consensus doesn't support candidates and votes")
+	ErrConsensusWithoutAuthority           = errors.New("consensus doesn't support validators governance")
+	ErrConsensusWithoutDeposit             = errors.New("consensus doesn't support validators deposit")
 )
 
 // Iterator Variables in Account Storage
@@ -113,6 +116,69 @@ type ConsensusState interface {
 	DynastyRoot() byteutils.Hash
 }
 
+// ChainConsensusState interface of consensus state depending on the chain history,
+// its next state is derived from the ancestors of the block it belongs to.
+type ChainConsensusState interface {
+	NextConsensusStateOf(byteutils.Hash, int64, WorldState) (ConsensusState, error)
+}
+
+// ElectionState interface of consensus state electing dynasty by candidates and votes
+type ElectionState interface {
+	IsCandidate(addr byteutils.Hash) (bool, error)
+	AddCandidate(addr byteutils.Hash) error
+	DelCandidate(addr byteutils.Hash) error
+	Candidates() ([]byteutils.Hash, error)
+
+	// VoteOf return the candidate voted by voter, nil if the voter doesn't vote.
+	VoteOf(voter byteutils.Hash) (byteutils.Hash, error)
+	Vote(voter byteutils.Hash, candidate byteutils.Hash) error
+	Unvote(voter byteutils.Hash) error
+
+	// IsDisqualified return true if the miner is not eligible for dynasties.
+	IsDisqualified(miner byteutils.Hash) (bool, error)
+	Disqualify(miner byteutils.Hash) error
+}
+
+// AuthorityState interface of consensus state managing validators by governance proposals
+type AuthorityState interface {
+	IsValidator(addr byteutils.Hash) (bool, error)
+	AddValidator(addr byteutils.Hash) error
+	DelValidator(addr byteutils.Hash) error
+	Validators() ([]byteutils.Hash, error)
+
+	// Threshold return the approvals needed to pass a proposal.
+	Threshold() (int, error)
+	// Approvals return the validators who approved the proposal.
+	Approvals(proposal byteutils.Hash) ([]byteutils.Hash, error)
+	Approve(proposal byteutils.Hash, approver byteutils.Hash) error
+	ClearApprovals(proposal byteutils.Hash) error
+}
+
+// DepositState interface of consensus state electing validators from participants with deposit
+type DepositState interface {
+	// MinDeposit return the minimal deposit to join the participants.
+	MinDeposit() *util.Uint128
+
+	IsParticipant(addr byteutils.Hash) (bool, error)
+	// Join register the addr as a participant, the deposit is added to its locked deposit.
+	Join(addr byteutils.Hash, deposit *util.Uint128) error
+	// Quit unregister the participant, the deposit stays locked until withdrawn.
+	Quit(addr byteutils.Hash) error
+
+	// DepositOf return the locked deposit of addr, zero if nothing locked.
+	DepositOf(addr byteutils.Hash) (*util.Uint128, error)
+	// IsUnbonding return true if the deposit of addr can't be withdrawn yet, it unbonds for
+	// one dynasty after the participant quit or served its last dynasty.
+	IsUnbonding(addr byteutils.Hash) (bool, error)
+	// Withdraw unlock and return the whole deposit of addr.
+	Withdraw(addr byteutils.Hash) (*util.Uint128, error)
+
+	// IsSlashed return true if the addr is not eligible for dynasties.
+	IsSlashed(addr byteutils.Hash) (bool, error)
+	// Slash confiscate the deposit of addr and return it, the addr is not eligible any more.
+	Slash(addr byteutils.Hash) (*util.Uint128, error)
+}
+
 // WorldState interface of world state
 type WorldState interface {
 	Begin() error
@@ -130,6 +196,7 @@ type WorldState interface {
 	LoadConsensusRoot(*consensuspb.ConsensusRoot) error
 
 	NextConsensusState(int64) (ConsensusState, error)
+	NextConsensusStateOf(byteutils.Hash, int64) (ConsensusState, error)
 	SetConsensusState(ConsensusState)
 
 	Clone() (WorldState, error)
@@ -152,6 +219,9 @@ type WorldState interface {
 
 	Dynasty() ([]byteutils.Hash, error)
 	DynastyRoot() byteutils.Hash
+	ElectionState() (ElectionState, error)
+	AuthorityState() (AuthorityState, error)
+	DepositState() (DepositState, error)
 
 	RecordGas(from string, gas *util.Uint128) error
 	GetGas() map[string]*util.Uint128
@@ -164,6 +234,7 @@ type TxWorldState interface {
 	EventsRoot() byteutils.Hash
 	ConsensusRoot() *consensuspb.ConsensusRoot
 
+	Prepare(interface{}) (TxWorldState, error)
 	CheckAndUpdate() ([]interface{}, error)
 	Reset(addr byteutils.Hash) error
 	Close() error
@@ -181,6 +252,9 @@ type TxWorldState interface {
 
 	Dynasty() ([]byteutils.Hash, error)
 	DynastyRoot() byteutils.Hash
+	ElectionState() (ElectionState, error)
+	AuthorityState() (AuthorityState, error)
+	DepositState() (DepositState, error)
 
 	RecordGas(from string, gas *util.Uint128) error
 }
@@ -356,6 +356,25 @@ func (s *states) Close() error {
 	return nil
 }
 
+// dirtyAddresses return the addresses of the accounts changed in states.
+func (s *states) dirtyAddresses() []byteutils.Hash {
+	addrs := []byteutils.Hash{}
+	for _, acc := range s.accState.(*accountState).dirtyAccount {
+		addrs = append(addrs, acc.Address())
+	}
+	return addrs
+}
+
+// reloadAccounts load the accounts from the accounts root and mark them changed.
+func (s *states) reloadAccounts(addrs []byteutils.Hash) error {
+	for _, addr := range addrs {
+		if _, err := s.accState.GetOrCreateUserAccount(addr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *states) AccountsRoot() byteutils.Hash {
 	return s.accState.RootHash()
 }
@@ -478,6 +497,30 @@ func (s *states) DynastyRoot() byteutils.Hash {
 	return s.consensusState.DynastyRoot()
 }
 
+func (s *states) ElectionState() (ElectionState, error) {
+	es, ok := s.consensusState.(ElectionState)
+	if !ok {
+		return nil, ErrConsensusWithoutElection
+	}
+	return es, nil
+}
+
+func (s *states) AuthorityState() (AuthorityState, error) {
+	as, ok := s.consensusState.(AuthorityState)
+	if !ok {
+		return nil, ErrConsensusWithoutAuthority
+	}
+	return as, nil
+}
+
+func (s *states) DepositState() (DepositState, error) {
+	ds, ok := s.consensusState.(DepositState)
+	if !ok {
+		return nil, ErrConsensusWithoutDeposit
+	}
+	return ds, nil
+}
+
 func (s *states) Accounts() ([]Account, error) { // TODO delete
 	return s.accState.Accounts()
 }
@@ -616,6 +659,14 @@ func (ws *worldState) NextConsensusState(elapsedSecond int64) (ConsensusState, e
 	return ws.states.consensusState.NextConsensusState(elapsedSecond, ws)
 }
 
+// NextConsensusStateOf return the next consensus state of the block owning the world state.
+func (ws *worldState) NextConsensusStateOf(block byteutils.Hash, elapsedSecond int64) (ConsensusState, error) {
+	if cs, ok := ws.states.consensusState.(ChainConsensusState); ok {
+		return cs.NextConsensusStateOf(block, elapsedSecond, ws)
+	}
+	return ws.states.consensusState.NextConsensusState(elapsedSecond, ws)
+}
+
 func (ws *worldState) SetConsensusState(consensusState ConsensusState) {
 	ws.states.consensusState = consensusState
 }
@@ -624,9 +675,37 @@ type txWorldState struct {
 	*states
 	txid   interface{}
 	parent *worldState
+
+	// outer is the tx state a nested tx state is prepared in, and touched the
+	// accounts changed in outer before the nested tx state is prepared.
+	outer   *states
+	touched []byteutils.Hash
+}
+
+// Prepare a nested tx state in the tx state, such as the one of an inner contract call.
+// The accounts in tx state are flushed and loaded again, so the ones got before are stale.
+func (tws *txWorldState) Prepare(txid interface{}) (TxWorldState, error) {
+	touched := tws.dirtyAddresses()
+	s, err := tws.states.Prepare(txid)
+	if err != nil {
+		return nil, err
+	}
+	if err := tws.reloadAccounts(touched); err != nil {
+		return nil, err
+	}
+	txState := &txWorldState{
+		states:  s,
+		txid:    txid,
+		outer:   tws.states,
+		touched: touched,
+	}
+	return txState, nil
 }
 
 func (tws *txWorldState) CheckAndUpdate() ([]interface{}, error) {
+	if tws.outer != nil {
+		return tws.checkAndUpdateToOuter()
+	}
 	dependencies, err := tws.states.CheckAndUpdateTo(tws.parent.states)
 	if err != nil {
 		return nil, err
@@ -635,6 +714,45 @@ func (tws *txWorldState) CheckAndUpdate() ([]interface{}, error) {
 	return dependencies, nil
 }
 
+// checkAndUpdateToOuter merge the nested tx state into its outer tx state and close it.
+// The accounts in outer tx state are loaded from the nested one's accounts root.
+func (tws *txWorldState) checkAndUpdateToOuter() ([]interface{}, error) {
+	touched := append(tws.touched, tws.dirtyAddresses()...)
+	if err := tws.states.Flush(); err != nil {
+		return nil, err
+	}
+	dependencies, err := tws.changelog.CheckAndUpdate()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tws.stateDB.CheckAndUpdate(); err != nil {
+		return nil, err
+	}
+
+	outer := tws.outer
+	accState, err := NewAccountState(tws.AccountsRoot(), outer.stateDB)
+	if err != nil {
+		return nil, err
+	}
+	outer.accState = accState
+	if err := outer.reloadAccounts(touched); err != nil {
+		return nil, err
+	}
+	for txHash, events := range tws.events {
+		outer.events[txHash] = append(outer.events[txHash], events...)
+	}
+	for from, gas := range tws.gasConsumed {
+		if err := outer.RecordGas(from, gas); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tws.Close(); err != nil {
+		return nil, err
+	}
+	return dependencies, nil
+}
+
 func (tws *txWorldState) Reset(addr byteutils.Hash) error {
 	if err := tws.states.Reset(addr); err != nil {
 		return err
@@ -647,6 +765,7 @@ func (tws *txWorldState) Close() error {
 		return err
 	}
 	tws.parent = nil
+	tws.outer = nil
 	return nil
 }
 
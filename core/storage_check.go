@@ -0,0 +1,302 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/consensus/pb"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// Kinds of missing node
+const (
+	MissingIndex     = "index"
+	MissingBlock     = "block"
+	MissingState     = "state"
+	MissingTxs       = "txs"
+	MissingEvents    = "events"
+	MissingConsensus = "consensus"
+)
+
+// MissingNode a node referenced by the canonical chain but missing in storage
+type MissingNode struct {
+	Height uint64
+	Block  byteutils.Hash
+	Kind   string
+	Hash   byteutils.Hash
+}
+
+// IntegrityReport the result of storage integrity check
+type IntegrityReport struct {
+	LIBHeight  uint64
+	LIBHash    byteutils.Hash
+	TailHeight uint64
+	TailHash   byteutils.Hash
+
+	// Checked count of blocks checked from LIB
+	Checked int
+
+	// ConsistentHash the last block that all blocks from LIB to it are fully consistent, nil if LIB is broken
+	ConsistentHeight uint64
+	ConsistentHash   byteutils.Hash
+
+	Missing []*MissingNode
+}
+
+// Consistent return if all blocks from LIB to tail are consistent
+func (r *IntegrityReport) Consistent() bool {
+	return len(r.Missing) == 0
+}
+
+func (r *IntegrityReport) miss(height uint64, block byteutils.Hash, kind string, hash byteutils.Hash) {
+	r.Missing = append(r.Missing, &MissingNode{
+		Height: height,
+		Block:  block,
+		Kind:   kind,
+		Hash:   hash,
+	})
+}
+
+func (cs *chainStorage) loadBlock(hash byteutils.Hash) (*corepb.Block, error) {
+	value, err := cs.block.Get(hash)
+	if err != nil {
+		return nil, err
+	}
+	pbBlock := new(corepb.Block)
+	if err := proto.Unmarshal(value, pbBlock); err != nil {
+		return nil, err
+	}
+	if pbBlock.Header == nil {
+		return nil, ErrInvalidProtoToBlockHeader
+	}
+	return pbBlock, nil
+}
+
+// CheckStorageIntegrity walk the canonical chain from LIB to tail, verify the
+// state/txs/events/consensus roots of every block resolve in storage.
+func CheckStorageIntegrity(stor storage.Storage) (*IntegrityReport, error) {
+	cs, err := newChainStorage(stor)
+	if err != nil {
+		return nil, err
+	}
+
+	tailHash, err := cs.meta.Get([]byte(Tail))
+	if err != nil {
+		return nil, err
+	}
+	libHash, err := cs.meta.Get([]byte(LIB))
+	if err == storage.ErrKeyNotFound {
+		libHash = GenesisHash
+	} else if err != nil {
+		return nil, err
+	}
+
+	lib, err := cs.loadBlock(libHash)
+	if err != nil {
+		return nil, ErrCannotLoadLIBBlock
+	}
+
+	report := &IntegrityReport{
+		LIBHeight: lib.Height,
+		LIBHash:   libHash,
+		TailHash:  tailHash,
+	}
+
+	// the height of tail is unknown if the tail block is lost,
+	// then the chain is walked until the height index ends.
+	tail, err := cs.loadBlock(tailHash)
+	if err != nil && err != storage.ErrKeyNotFound {
+		return nil, err
+	}
+	if tail != nil {
+		report.TailHeight = tail.Height
+	} else {
+		report.miss(0, tailHash, MissingBlock, tailHash)
+	}
+
+	verifier := trie.NewVerifier(cs.state)
+	consistent := true
+	var parentHash byteutils.Hash
+	for height := lib.Height; tail == nil || height <= tail.Height; height++ {
+		hash := libHash
+		if height > lib.Height {
+			hash, err = cs.index.Get(byteutils.FromUint64(height))
+			if err == storage.ErrKeyNotFound {
+				if tail != nil {
+					report.miss(height, nil, MissingIndex, nil)
+				}
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		block, err := cs.loadBlock(hash)
+		if err == storage.ErrKeyNotFound {
+			report.miss(height, hash, MissingBlock, hash)
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// the index is stale if it points to a block on another fork.
+		if (parentHash != nil && !byteutils.Equal(block.Header.ParentHash, parentHash)) ||
+			(tail != nil && height == tail.Height && !byteutils.Equal(hash, tailHash)) {
+			report.miss(height, hash, MissingIndex, hash)
+			break
+		}
+
+		missing, err := cs.verifyBlock(verifier, block)
+		if err != nil {
+			return nil, err
+		}
+		report.Missing = append(report.Missing, missing...)
+		report.Checked++
+
+		if len(missing) > 0 {
+			consistent = false
+		}
+		if consistent {
+			report.ConsistentHeight = height
+			report.ConsistentHash = hash
+		}
+		parentHash = hash
+	}
+	return report, nil
+}
+
+func (cs *chainStorage) verifyBlock(verifier *trie.Verifier, block *corepb.Block) ([]*MissingNode, error) {
+	header := block.Header
+	consensusRoot := header.ConsensusRoot
+	if consensusRoot == nil {
+		consensusRoot = &consensuspb.ConsensusRoot{}
+	}
+
+	missing := []*MissingNode{}
+	for _, root := range []struct {
+		kind string
+		hash []byte
+		refs trie.LeafRefs
+	}{
+		{MissingState, header.StateRoot, accountRefs},
+		{MissingTxs, header.TxsRoot, nil},
+		{MissingEvents, header.EventsRoot, nil},
+		{MissingConsensus, consensusRoot.DynastyRoot, nil},
+		{MissingConsensus, consensusRoot.CandidateRoot, nil},
+		{MissingConsensus, consensusRoot.VoteRoot, nil},
+		{MissingConsensus, consensusRoot.DisqualifiedRoot, nil},
+		{MissingConsensus, consensusRoot.ProposalRoot, nil},
+		{MissingConsensus, consensusRoot.DepositRoot, nil},
+	} {
+		hashes, err := verifier.Verify(root.hash, root.refs)
+		if err != nil {
+			return nil, err
+		}
+		for _, hash := range hashes {
+			missing = append(missing, &MissingNode{
+				Height: block.Height,
+				Block:  header.Hash,
+				Kind:   root.kind,
+				Hash:   hash,
+			})
+		}
+	}
+	return missing, nil
+}
+
+// accountRefs return the variables root of an account in state trie.
+func accountRefs(value []byte) ([][]byte, error) {
+	pbAcc := new(corepb.Account)
+	if err := proto.Unmarshal(value, pbAcc); err != nil {
+		return nil, err
+	}
+	return [][]byte{pbAcc.VarsHash}, nil
+}
+
+// RepairStorage roll the tail back to the last fully consistent block in report,
+// the LIB is rolled back too if it is above, and the height indexes above are removed.
+func RepairStorage(stor storage.Storage, report *IntegrityReport) error {
+	if report.Consistent() {
+		return nil
+	}
+	if report.ConsistentHash == nil {
+		return ErrCannotRepairStorage
+	}
+
+	cs, err := newChainStorage(stor)
+	if err != nil {
+		return err
+	}
+
+	libHash, err := cs.meta.Get([]byte(LIB))
+	if err == storage.ErrKeyNotFound {
+		libHash = GenesisHash
+	} else if err != nil {
+		return err
+	}
+	lib, err := cs.loadBlock(libHash)
+	if err != nil && err != storage.ErrKeyNotFound {
+		return err
+	}
+	if lib == nil || lib.Height > report.ConsistentHeight {
+		if err := cs.meta.Put([]byte(LIB), report.ConsistentHash); err != nil {
+			return err
+		}
+	}
+
+	if err := cs.deleteIndexesAbove(report.ConsistentHeight); err != nil {
+		return err
+	}
+	return cs.meta.Put([]byte(Tail), report.ConsistentHash)
+}
+
+// deleteIndexesAbove remove the height indexes above the height.
+func (cs *chainStorage) deleteIndexesAbove(height uint64) error {
+	iter, err := cs.index.NewRangeIterator(byteutils.FromUint64(height+1), nil)
+	if err != nil {
+		return err
+	}
+	keys := [][]byte{}
+	for {
+		exist, err := iter.Next()
+		if err != nil {
+			iter.Release()
+			return err
+		}
+		if !exist {
+			break
+		}
+		if key := iter.Key(); len(key) == 8 {
+			keys = append(keys, append([]byte{}, key...))
+		}
+	}
+	iter.Release()
+
+	for _, key := range keys {
+		if err := cs.index.Del(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckStorageIntegrity(t *testing.T) {
+	neb := testNeb(t)
+	bc := neb.chain
+
+	coinbase, _ := AddressParse("n1FF1nz6tarkDVwWQkMnnwFPuPKUaQTdptE")
+	var blocks []*Block
+	for i := int64(1); i <= 2; i++ {
+		block, err := bc.NewBlock(coinbase)
+		assert.Nil(t, err)
+		block.header.timestamp = BlockInterval * i
+		assert.Nil(t, block.Seal())
+		signBlock(block)
+		assert.Nil(t, bc.BlockPool().Push(block))
+		blocks = append(blocks, block)
+	}
+	assert.Equal(t, blocks[1].Hash(), bc.TailBlock().Hash())
+
+	report, err := CheckStorageIntegrity(neb.storage)
+	assert.Nil(t, err)
+	assert.True(t, report.Consistent())
+	assert.Equal(t, 3, report.Checked)
+	assert.Equal(t, bc.LIB().Hash(), report.LIBHash)
+	assert.Equal(t, blocks[1].Hash(), report.TailHash)
+	assert.Equal(t, blocks[1].Hash(), report.ConsistentHash)
+
+	// lose the state root of tail.
+	assert.Nil(t, bc.Storage().Del(blocks[1].StateRoot()))
+	report, err = CheckStorageIntegrity(neb.storage)
+	assert.Nil(t, err)
+	assert.False(t, report.Consistent())
+	assert.Equal(t, 1, len(report.Missing))
+	assert.Equal(t, MissingState, report.Missing[0].Kind)
+	assert.Equal(t, blocks[1].Hash(), report.Missing[0].Block)
+	assert.Equal(t, blocks[1].StateRoot(), report.Missing[0].Hash)
+	assert.Equal(t, blocks[0].Height(), report.ConsistentHeight)
+	assert.Equal(t, blocks[0].Hash(), report.ConsistentHash)
+
+	// the LIB above the consistent block is rolled back.
+	cs, err := newChainStorage(neb.storage)
+	assert.Nil(t, err)
+	assert.Nil(t, cs.meta.Put([]byte(LIB), blocks[1].Hash()))
+
+	assert.Nil(t, RepairStorage(neb.storage, report))
+	tail, err := bc.LoadTailFromStorage()
+	assert.Nil(t, err)
+	assert.Equal(t, blocks[0].Hash(), tail.Hash())
+	lib, err := cs.meta.Get([]byte(LIB))
+	assert.Nil(t, err)
+	assert.Equal(t, blocks[0].Hash(), byteutils.Hash(lib))
+	_, err = cs.index.Get(byteutils.FromUint64(blocks[1].Height()))
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+	value, err := cs.index.Get(byteutils.FromUint64(blocks[0].Height()))
+	assert.Nil(t, err)
+	assert.Equal(t, blocks[0].Hash(), byteutils.Hash(value))
+	report, err = CheckStorageIntegrity(neb.storage)
+	assert.Nil(t, err)
+	assert.True(t, report.Consistent())
+}
+
+func TestRepairStorageWithBrokenLIB(t *testing.T) {
+	neb := testNeb(t)
+	bc := neb.chain
+
+	assert.Nil(t, bc.Storage().Del(bc.GenesisBlock().StateRoot()))
+	report, err := CheckStorageIntegrity(neb.storage)
+	assert.Nil(t, err)
+	assert.False(t, report.Consistent())
+	assert.Nil(t, report.ConsistentHash)
+	assert.Equal(t, ErrCannotRepairStorage, RepairStorage(neb.storage, report))
+}
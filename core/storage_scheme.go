@@ -0,0 +1,221 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"bytes"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// storage scheme:
+// meta keyspace:  scheme -> scheme version, blockchain_tail -> tail block hash, blockchain_lib -> lib block hash
+// block keyspace: block hash -> block
+// index keyspace: height -> block hash
+// state keyspace: trie node hash -> trie node
+// finality keyspace: block hash -> finality proof
+const (
+	// Scheme Key in storage
+	Scheme = "scheme"
+
+	// StorageSchemeVersion the version of keyspace separated storage scheme
+	StorageSchemeVersion = "1"
+
+	// migrateBatchSize count of entries flushed in one batch during migration
+	migrateBatchSize = 10000
+)
+
+// StorageMigration the result of storage migration
+type StorageMigration struct {
+	Blocks  int
+	Indexes int
+	Metas   int
+	States  int
+	Dropped int
+	Skipped int
+}
+
+// chainStorage the keyspaces used by chain.
+type chainStorage struct {
+	root  storage.Storage
+	block storage.Storage
+	index storage.Storage
+	meta  storage.Storage
+	state storage.Storage
+
+	finality storage.Storage
+}
+
+func newChainStorage(stor storage.Storage) (*chainStorage, error) {
+	cs := &chainStorage{root: stor}
+	for _, v := range []struct {
+		name string
+		stor *storage.Storage
+	}{
+		{storage.BlockKeyspace, &cs.block},
+		{storage.IndexKeyspace, &cs.index},
+		{storage.MetaKeyspace, &cs.meta},
+		{storage.StateKeyspace, &cs.state},
+		{storage.FinalityKeyspace, &cs.finality},
+	} {
+		ks, err := storage.OpenKeyspace(stor, v.name)
+		if err != nil {
+			return nil, err
+		}
+		*v.stor = ks
+	}
+	return cs, nil
+}
+
+// CheckStorageScheme check the scheme version of storage, a new storage is marked with current version.
+func CheckStorageScheme(stor storage.Storage) error {
+	cs, err := newChainStorage(stor)
+	if err != nil {
+		return err
+	}
+
+	version, err := cs.meta.Get([]byte(Scheme))
+	if err != nil && err != storage.ErrKeyNotFound {
+		return err
+	}
+	if err == nil {
+		if string(version) != StorageSchemeVersion {
+			return ErrIncompatibleStorageScheme
+		}
+		return nil
+	}
+
+	// the flat storage of old version keeps tail in default keyspace.
+	if _, ok := stor.(storage.KeyspaceStorage); ok {
+		if _, err := stor.Get([]byte(Tail)); err == nil {
+			return ErrIncompatibleStorageScheme
+		}
+	}
+	return cs.meta.Put([]byte(Scheme), []byte(StorageSchemeVersion))
+}
+
+// MigrateStorageScheme move the entries of flat storage into keyspaces.
+func MigrateStorageScheme(stor storage.Storage) (*StorageMigration, error) {
+	if _, ok := stor.(storage.KeyspaceStorage); !ok {
+		return nil, ErrStorageWithoutKeyspace
+	}
+	cs, err := newChainStorage(stor)
+	if err != nil {
+		return nil, err
+	}
+
+	iter, err := stor.NewIterator(nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Release()
+
+	stor.EnableBatch()
+	defer stor.DisableBatch()
+
+	result := new(StorageMigration)
+	count := 0
+	for {
+		exist, err := iter.Next()
+		if err != nil {
+			return nil, err
+		}
+		if !exist {
+			break
+		}
+
+		key, value := iter.Key(), iter.Value()
+
+		// the scheme version of flat storage is obsolete, the new one is marked in meta keyspace.
+		if bytes.Equal(key, []byte(Scheme)) {
+			if err := stor.Del(key); err != nil {
+				return nil, err
+			}
+			result.Dropped++
+			continue
+		}
+
+		var target storage.Storage
+		switch cs.classify(key, value) {
+		case storage.MetaKeyspace:
+			target = cs.meta
+			result.Metas++
+		case storage.IndexKeyspace:
+			target = cs.index
+			result.Indexes++
+		case storage.BlockKeyspace:
+			target = cs.block
+			result.Blocks++
+		case storage.StateKeyspace:
+			target = cs.state
+			result.States++
+		default:
+			result.Skipped++
+			logging.VLog().WithFields(logrus.Fields{
+				"key": byteutils.Hex(key),
+			}).Warn("Skip unknown entry in storage migration.")
+			continue
+		}
+
+		if err := target.Put(key, value); err != nil {
+			return nil, err
+		}
+		if err := stor.Del(key); err != nil {
+			return nil, err
+		}
+
+		count++
+		if count%migrateBatchSize == 0 {
+			if err := stor.Flush(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := cs.meta.Put([]byte(Scheme), []byte(StorageSchemeVersion)); err != nil {
+		return nil, err
+	}
+	if err := stor.Flush(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// classify return the keyspace name of an entry in flat storage.
+func (cs *chainStorage) classify(key []byte, value []byte) string {
+	switch {
+	case bytes.Equal(key, []byte(Tail)), bytes.Equal(key, []byte(LIB)):
+		return storage.MetaKeyspace
+	case len(key) == 8:
+		return storage.IndexKeyspace
+	case len(key) == BlockHashLength:
+		pbBlock := new(corepb.Block)
+		if err := proto.Unmarshal(value, pbBlock); err == nil &&
+			pbBlock.Header != nil && bytes.Equal(pbBlock.Header.Hash, key) {
+			return storage.BlockKeyspace
+		}
+		return storage.StateKeyspace
+	}
+	return storage.DefaultKeyspace
+}
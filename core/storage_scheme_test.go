@@ -0,0 +1,96 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckStorageScheme(t *testing.T) {
+	stor, err := storage.NewMemoryStorage()
+	assert.Nil(t, err)
+
+	// new storage is marked with current version.
+	assert.Nil(t, CheckStorageScheme(stor))
+	meta, err := storage.OpenKeyspace(stor, storage.MetaKeyspace)
+	assert.Nil(t, err)
+	version, err := meta.Get([]byte(Scheme))
+	assert.Nil(t, err)
+	assert.Equal(t, StorageSchemeVersion, string(version))
+	assert.Nil(t, CheckStorageScheme(stor))
+
+	assert.Nil(t, meta.Put([]byte(Scheme), []byte("0")))
+	assert.Equal(t, ErrIncompatibleStorageScheme, CheckStorageScheme(stor))
+}
+
+func TestMigrateStorageScheme(t *testing.T) {
+	neb := testNeb(t)
+	genesis := neb.chain.GenesisBlock()
+	pbBlock, err := genesis.ToProto()
+	assert.Nil(t, err)
+	blockBytes, err := proto.Marshal(pbBlock)
+	assert.Nil(t, err)
+
+	// flat storage of old version.
+	stor, err := storage.NewMemoryStorage()
+	assert.Nil(t, err)
+	stateKey := hash.Sha3256([]byte("trie node"))
+	assert.Nil(t, stor.Put([]byte(Tail), genesis.Hash()))
+	assert.Nil(t, stor.Put(byteutils.FromUint64(genesis.Height()), genesis.Hash()))
+	assert.Nil(t, stor.Put(genesis.Hash(), blockBytes))
+	assert.Nil(t, stor.Put(stateKey, []byte("node")))
+	assert.Nil(t, stor.Put([]byte("unknown"), []byte("value")))
+	assert.Nil(t, stor.Put([]byte(Scheme), []byte("0")))
+
+	assert.Equal(t, ErrIncompatibleStorageScheme, CheckStorageScheme(stor))
+
+	result, err := MigrateStorageScheme(stor)
+	assert.Nil(t, err)
+	assert.Equal(t, &StorageMigration{Blocks: 1, Indexes: 1, Metas: 1, States: 1, Dropped: 1, Skipped: 1}, result)
+	assert.Nil(t, CheckStorageScheme(stor))
+
+	cs, err := newChainStorage(stor)
+	assert.Nil(t, err)
+	tail, err := cs.meta.Get([]byte(Tail))
+	assert.Nil(t, err)
+	assert.Equal(t, genesis.Hash(), byteutils.Hash(tail))
+	value, err := cs.index.Get(byteutils.FromUint64(genesis.Height()))
+	assert.Nil(t, err)
+	assert.Equal(t, genesis.Hash(), byteutils.Hash(value))
+	value, err = cs.block.Get(genesis.Hash())
+	assert.Nil(t, err)
+	assert.Equal(t, blockBytes, value)
+	value, err = cs.state.Get(stateKey)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("node"), value)
+
+	_, err = stor.Get([]byte(Tail))
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+	_, err = stor.Get([]byte(Scheme))
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+	value, err = stor.Get([]byte("unknown"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), value)
+}
@@ -379,6 +379,25 @@ func (tx *Transaction) DataLen() int {
 	return len(tx.data.Payload)
 }
 
+// payloadAvailable check if the payload type is available at the height
+func payloadAvailable(payloadType string, height uint64) bool {
+	switch payloadType {
+	case TxPayloadCandidateType, TxPayloadDelegateType, TxPayloadEvidenceType:
+		return height >= ElectionAvailableHeight
+	case TxPayloadAuthorityType:
+		return height >= AuthorityAvailableHeight
+	case TxPayloadDevotionType:
+		return height >= DevotionAvailableHeight
+	case TxPayloadDipType:
+		return height >= DipAvailableHeight
+	case TxPayloadUpgradeType:
+		return height >= ContractUpgradeAvailableHeight
+	case TxPayloadGasScheduleType:
+		return height >= GasScheduleAvailableHeight
+	}
+	return true
+}
+
 // LoadPayload returns tx's payload
 func (tx *Transaction) LoadPayload() (TxPayload, error) {
 	// execute payload
@@ -393,6 +412,22 @@ func (tx *Transaction) LoadPayload() (TxPayload, error) {
 		payload, err = LoadDeployPayload(tx.data.Payload)
 	case TxPayloadCallType:
 		payload, err = LoadCallPayload(tx.data.Payload)
+	case TxPayloadCandidateType:
+		payload, err = LoadCandidatePayload(tx.data.Payload)
+	case TxPayloadDelegateType:
+		payload, err = LoadDelegatePayload(tx.data.Payload)
+	case TxPayloadEvidenceType:
+		payload, err = LoadEvidencePayload(tx.data.Payload)
+	case TxPayloadAuthorityType:
+		payload, err = LoadAuthorityPayload(tx.data.Payload)
+	case TxPayloadDevotionType:
+		payload, err = LoadDevotionPayload(tx.data.Payload)
+	case TxPayloadDipType:
+		payload, err = LoadDipPayload(tx.data.Payload)
+	case TxPayloadUpgradeType:
+		payload, err = LoadUpgradePayload(tx.data.Payload)
+	case TxPayloadGasScheduleType:
+		payload, err = LoadGasSchedulePayload(tx.data.Payload)
 	default:
 		err = ErrInvalidTxPayloadType
 	}
@@ -491,9 +526,20 @@ func VerifyExecution(tx *Transaction, block *Block, ws WorldState) (bool, error)
 		return false, ErrOutOfGasLimit
 	}
 
+	// the dip txs are protocol txs packed by the proposer of block, the invalid ones are not on chain.
+	if tx.data.Type == TxPayloadDipType && payloadAvailable(tx.data.Type, block.height) {
+		if giveback, err := verifyDipTransaction(tx, block, ws); err != nil {
+			return giveback, err
+		}
+	}
+
 	// !!!!!!Attention: all txs passed here will be on chain.
 
 	// step3. check payload vaild.
+	// the payload types not available yet fail as unknown types, whatever the payload is.
+	if !payloadAvailable(tx.data.Type, block.height) {
+		return submitTx(tx, block, ws, gasUsed, ErrInvalidTxPayloadType, "Failed to load payload.", "")
+	}
 	payload, payloadErr := tx.LoadPayload()
 	if payloadErr != nil {
 		return submitTx(tx, block, ws, gasUsed, payloadErr, "Failed to load payload.", "")
@@ -0,0 +1,167 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"golang.org/x/crypto/sha3"
+)
+
+// Authority payload actions
+const (
+	AddValidatorAction    = "add"
+	RemoveValidatorAction = "remove"
+)
+
+// ValidatorChangedEvent event of a validator added or removed by governance proposal
+type ValidatorChangedEvent struct {
+	Action    string   `json:"action"`
+	Validator string   `json:"validator"`
+	Approvers []string `json:"approvers"`
+}
+
+// AuthorityPayload carry the approval of a governance proposal, the sender of tx approves
+// to add or remove the validator. The proposal passes once threshold validators approved it.
+type AuthorityPayload struct {
+	Action    string
+	Validator string
+}
+
+// LoadAuthorityPayload from bytes
+func LoadAuthorityPayload(bytes []byte) (*AuthorityPayload, error) {
+	payload := &AuthorityPayload{}
+	if err := json.Unmarshal(bytes, payload); err != nil {
+		return nil, ErrInvalidArgument
+	}
+	return NewAuthorityPayload(payload.Action, payload.Validator)
+}
+
+// NewAuthorityPayload with action & validator
+func NewAuthorityPayload(action string, validator string) (*AuthorityPayload, error) {
+	if action != AddValidatorAction && action != RemoveValidatorAction {
+		return nil, ErrInvalidAuthorityPayloadAction
+	}
+	if _, err := AddressParse(validator); err != nil {
+		return nil, err
+	}
+	return &AuthorityPayload{
+		Action:    action,
+		Validator: validator,
+	}, nil
+}
+
+// ToBytes serialize payload
+func (payload *AuthorityPayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// BaseGasCount returns base gas count
+func (payload *AuthorityPayload) BaseGasCount() *util.Uint128 {
+	base, _ := util.NewUint128FromInt(60)
+	return base
+}
+
+// ProposalHash return the hash identifying the proposal approved by the payload.
+func (payload *AuthorityPayload) ProposalHash(validator *Address) byteutils.Hash {
+	hasher := sha3.New256()
+	hasher.Write([]byte(payload.Action))
+	hasher.Write(validator.Bytes())
+	return hasher.Sum(nil)
+}
+
+// Execute the authority payload in tx, record the approval of sender and apply the proposal if it passes
+func (payload *AuthorityPayload) Execute(limitedGas *util.Uint128, tx *Transaction, block *Block, ws WorldState) (*util.Uint128, string, error) {
+	if block == nil || tx == nil || tx.from == nil {
+		return util.NewUint128(), "", ErrNilArgument
+	}
+
+	validator, err := AddressParse(payload.Validator)
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+	authority, err := ws.AuthorityState()
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+	registered, err := authority.IsValidator(validator.address)
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+	switch payload.Action {
+	case AddValidatorAction:
+		if registered {
+			return util.NewUint128(), "", ErrDuplicatedValidator
+		}
+	case RemoveValidatorAction:
+		if !registered {
+			return util.NewUint128(), "", ErrRemoveNonValidator
+		}
+	default:
+		return util.NewUint128(), "", ErrInvalidAuthorityPayloadAction
+	}
+
+	proposal := payload.ProposalHash(validator)
+	approvers, passed, err := approveProposal(&validatorGovernance{authority}, proposal, tx.from.address)
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+	if !passed {
+		return util.NewUint128(), "", nil
+	}
+
+	if payload.Action == AddValidatorAction {
+		err = authority.AddValidator(validator.address)
+	} else {
+		err = authority.DelValidator(validator.address)
+	}
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+	if err := authority.ClearApprovals(proposal); err != nil {
+		return util.NewUint128(), "", err
+	}
+	// the changes are reverted with the failed tx if the rest validators cannot pass proposals.
+	validators, err := authority.Validators()
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+	threshold, err := authority.Threshold()
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+	if len(validators) == 0 || len(validators) < threshold {
+		return util.NewUint128(), "", ErrTooFewValidators
+	}
+
+	event := &ValidatorChangedEvent{
+		Action:    payload.Action,
+		Validator: validator.String(),
+		Approvers: approvers,
+	}
+	eData, err := json.Marshal(event)
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+	ws.RecordEvent(tx.hash, &state.Event{Topic: TopicValidatorChanged, Data: string(eData)})
+	return util.NewUint128(), "", nil
+}
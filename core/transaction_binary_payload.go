@@ -70,11 +70,7 @@ func (payload *BinaryPayload) Execute(limitedGas *util.Uint128, tx *Transaction,
 			return util.NewUint128(), "", err
 		}
 
-		birthTx, err := GetTransaction(contract.BirthPlace(), ws)
-		if err != nil {
-			return util.NewUint128(), "", err
-		}
-		deploy, err := LoadDeployPayload(birthTx.data.Payload) // ToConfirm: move deploy payload in ctx.
+		source, sourceType, err := LoadContractSource(contract, ws)
 		if err != nil {
 			return util.NewUint128(), "", err
 		}
@@ -89,7 +85,7 @@ func (payload *BinaryPayload) Execute(limitedGas *util.Uint128, tx *Transaction,
 			return util.NewUint128(), "", err
 		}
 
-		result, exeErr := engine.Call(deploy.Source, deploy.SourceType, ContractAcceptFunc, "")
+		result, exeErr := engine.Call(source, sourceType, ContractAcceptFunc, "")
 		gasCout := engine.ExecutionInstructions()
 		instructions, err := util.NewUint128FromInt(int64(gasCout))
 		if err != nil {
@@ -85,16 +85,7 @@ func (payload *CallPayload) Execute(limitedGas *util.Uint128, tx *Transaction, b
 		return util.NewUint128(), "", err
 	}
 
-	birthTx, err := GetTransaction(contract.BirthPlace(), ws)
-	if err != nil {
-		return util.NewUint128(), "", err
-	}
-	/* // useless owner.
-	owner, err := ws.GetOrCreateUserAccount(birthTx.from.Bytes())
-	if err != nil {
-		return util.NewUint128(), "", err
-	} */
-	deploy, err := LoadDeployPayload(birthTx.data.Payload) // ToConfirm: move deploy payload in ctx.
+	source, sourceType, err := LoadContractSource(contract, ws)
 	if err != nil {
 		return util.NewUint128(), "", err
 	}
@@ -109,7 +100,7 @@ func (payload *CallPayload) Execute(limitedGas *util.Uint128, tx *Transaction, b
 		return util.NewUint128(), "", err
 	}
 
-	result, exeErr := engine.Call(deploy.Source, deploy.SourceType, payload.Function, payload.Args)
+	result, exeErr := engine.Call(source, sourceType, payload.Function, payload.Args)
 	gasCout := engine.ExecutionInstructions()
 	instructions, err := util.NewUint128FromInt(int64(gasCout))
 	if err != nil {
@@ -0,0 +1,99 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+
+	"github.com/nebulasio/go-nebulas/util"
+)
+
+// Candidate payload actions
+const (
+	LoginAction  = "login"
+	LogoutAction = "logout"
+)
+
+// CandidatePayload carry candidate registration, the sender of tx logs in or out the candidates of dynasty.
+type CandidatePayload struct {
+	Action string
+}
+
+// LoadCandidatePayload from bytes
+func LoadCandidatePayload(bytes []byte) (*CandidatePayload, error) {
+	payload := &CandidatePayload{}
+	if err := json.Unmarshal(bytes, payload); err != nil {
+		return nil, ErrInvalidArgument
+	}
+	return NewCandidatePayload(payload.Action)
+}
+
+// NewCandidatePayload with action
+func NewCandidatePayload(action string) (*CandidatePayload, error) {
+	if action != LoginAction && action != LogoutAction {
+		return nil, ErrInvalidCandidatePayloadAction
+	}
+	return &CandidatePayload{
+		Action: action,
+	}, nil
+}
+
+// ToBytes serialize payload
+func (payload *CandidatePayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// BaseGasCount returns base gas count
+func (payload *CandidatePayload) BaseGasCount() *util.Uint128 {
+	base, _ := util.NewUint128FromInt(60)
+	return base
+}
+
+// Execute the candidate payload in tx, log in or out the sender
+func (payload *CandidatePayload) Execute(limitedGas *util.Uint128, tx *Transaction, block *Block, ws WorldState) (*util.Uint128, string, error) {
+	if block == nil || tx == nil || tx.from == nil {
+		return util.NewUint128(), "", ErrNilArgument
+	}
+
+	election, err := ws.ElectionState()
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+	candidate := tx.from.address
+	registered, err := election.IsCandidate(candidate)
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+
+	switch payload.Action {
+	case LoginAction:
+		if registered {
+			return util.NewUint128(), "", ErrDuplicatedCandidate
+		}
+		err = election.AddCandidate(candidate)
+	case LogoutAction:
+		if !registered {
+			return util.NewUint128(), "", ErrInvalidLogoutFromNonCandidate
+		}
+		err = election.DelCandidate(candidate)
+	default:
+		err = ErrInvalidCandidatePayloadAction
+	}
+	return util.NewUint128(), "", err
+}
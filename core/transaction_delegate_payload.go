@@ -0,0 +1,111 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+
+	"github.com/nebulasio/go-nebulas/util"
+)
+
+// Delegate payload actions
+const (
+	DelegateAction   = "do"
+	UnDelegateAction = "undo"
+)
+
+// DelegatePayload carry vote, the sender of tx votes for or withdraws the vote from a candidate.
+type DelegatePayload struct {
+	Action    string
+	Delegatee string
+}
+
+// LoadDelegatePayload from bytes
+func LoadDelegatePayload(bytes []byte) (*DelegatePayload, error) {
+	payload := &DelegatePayload{}
+	if err := json.Unmarshal(bytes, payload); err != nil {
+		return nil, ErrInvalidArgument
+	}
+	return NewDelegatePayload(payload.Action, payload.Delegatee)
+}
+
+// NewDelegatePayload with action & delegatee
+func NewDelegatePayload(action string, delegatee string) (*DelegatePayload, error) {
+	if action != DelegateAction && action != UnDelegateAction {
+		return nil, ErrInvalidDelegatePayloadAction
+	}
+	if _, err := AddressParse(delegatee); err != nil {
+		return nil, err
+	}
+	return &DelegatePayload{
+		Action:    action,
+		Delegatee: delegatee,
+	}, nil
+}
+
+// ToBytes serialize payload
+func (payload *DelegatePayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// BaseGasCount returns base gas count
+func (payload *DelegatePayload) BaseGasCount() *util.Uint128 {
+	base, _ := util.NewUint128FromInt(60)
+	return base
+}
+
+// Execute the delegate payload in tx, a voter votes for one candidate at most,
+// voting again moves the vote to the new delegatee.
+func (payload *DelegatePayload) Execute(limitedGas *util.Uint128, tx *Transaction, block *Block, ws WorldState) (*util.Uint128, string, error) {
+	if block == nil || tx == nil || tx.from == nil {
+		return util.NewUint128(), "", ErrNilArgument
+	}
+
+	delegatee, err := AddressParse(payload.Delegatee)
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+	election, err := ws.ElectionState()
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+	voter := tx.from.address
+
+	switch payload.Action {
+	case DelegateAction:
+		registered, err := election.IsCandidate(delegatee.address)
+		if err != nil {
+			return util.NewUint128(), "", err
+		}
+		if !registered {
+			return util.NewUint128(), "", ErrInvalidDelegateToNonCandidate
+		}
+		return util.NewUint128(), "", election.Vote(voter, delegatee.address)
+	case UnDelegateAction:
+		voted, err := election.VoteOf(voter)
+		if err != nil {
+			return util.NewUint128(), "", err
+		}
+		if !voted.Equals(delegatee.address) {
+			return util.NewUint128(), "", ErrInvalidUnDelegateFromNonDelegatee
+		}
+		return util.NewUint128(), "", election.Unvote(voter)
+	}
+	return util.NewUint128(), "", ErrInvalidDelegatePayloadAction
+}
@@ -0,0 +1,187 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/util"
+)
+
+// Devotion payload actions
+const (
+	JoinAction     = "join"
+	QuitAction     = "quit"
+	WithdrawAction = "withdraw"
+)
+
+// DepositChangedEvent event of a deposit locked by join, or unlocked by withdraw
+type DepositChangedEvent struct {
+	Action  string `json:"action"`
+	Address string `json:"address"`
+	Deposit string `json:"deposit"`
+}
+
+// DevotionPayload carry the participation of validators election, the sender of tx
+// joins with the value of tx as deposit, quits, or withdraws the deposit once it unbonds
+// after quit.
+type DevotionPayload struct {
+	Action string
+}
+
+// LoadDevotionPayload from bytes
+func LoadDevotionPayload(bytes []byte) (*DevotionPayload, error) {
+	payload := &DevotionPayload{}
+	if err := json.Unmarshal(bytes, payload); err != nil {
+		return nil, ErrInvalidArgument
+	}
+	return NewDevotionPayload(payload.Action)
+}
+
+// NewDevotionPayload with action
+func NewDevotionPayload(action string) (*DevotionPayload, error) {
+	if action != JoinAction && action != QuitAction && action != WithdrawAction {
+		return nil, ErrInvalidDevotionPayloadAction
+	}
+	return &DevotionPayload{
+		Action: action,
+	}, nil
+}
+
+// ToBytes serialize payload
+func (payload *DevotionPayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// BaseGasCount returns base gas count
+func (payload *DevotionPayload) BaseGasCount() *util.Uint128 {
+	base, _ := util.NewUint128FromInt(60)
+	return base
+}
+
+// Execute the devotion payload in tx, lock or unlock the deposit of sender
+func (payload *DevotionPayload) Execute(limitedGas *util.Uint128, tx *Transaction, block *Block, ws WorldState) (*util.Uint128, string, error) {
+	if block == nil || tx == nil || tx.from == nil {
+		return util.NewUint128(), "", ErrNilArgument
+	}
+
+	deposit, err := ws.DepositState()
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+	acc, err := ws.GetOrCreateUserAccount(tx.from.address)
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+	participant := tx.from.address
+	registered, err := deposit.IsParticipant(participant)
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+
+	var amount *util.Uint128
+	switch payload.Action {
+	case JoinAction:
+		// the value has been transferred back to the sender, lock it from the balance.
+		if !tx.to.Equals(tx.from) {
+			return util.NewUint128(), "", ErrInvalidDepositReceiver
+		}
+		if registered {
+			return util.NewUint128(), "", ErrDuplicatedParticipant
+		}
+		slashed, err := deposit.IsSlashed(participant)
+		if err != nil {
+			return util.NewUint128(), "", err
+		}
+		if slashed {
+			return util.NewUint128(), "", ErrJoinFromSlashed
+		}
+		locked, err := deposit.DepositOf(participant)
+		if err != nil {
+			return util.NewUint128(), "", err
+		}
+		if amount, err = locked.Add(tx.value); err != nil {
+			return util.NewUint128(), "", err
+		}
+		if amount.Cmp(deposit.MinDeposit()) < 0 {
+			return util.NewUint128(), "", ErrInsufficientDeposit
+		}
+		if err := acc.SubBalance(tx.value); err != nil {
+			return util.NewUint128(), "", err
+		}
+		if err := deposit.Join(participant, tx.value); err != nil {
+			return util.NewUint128(), "", err
+		}
+	case QuitAction:
+		if !registered {
+			return util.NewUint128(), "", ErrQuitFromNonParticipant
+		}
+		if amount, err = deposit.DepositOf(participant); err != nil {
+			return util.NewUint128(), "", err
+		}
+		if err := deposit.Quit(participant); err != nil {
+			return util.NewUint128(), "", err
+		}
+	case WithdrawAction:
+		if registered {
+			return util.NewUint128(), "", ErrWithdrawFromParticipant
+		}
+		// the members of current dynasty are still responsible for their blocks.
+		dynasty, err := ws.Dynasty()
+		if err != nil {
+			return util.NewUint128(), "", err
+		}
+		for _, member := range dynasty {
+			if member.Equals(participant) {
+				return util.NewUint128(), "", ErrWithdrawInDynasty
+			}
+		}
+		unbonding, err := deposit.IsUnbonding(participant)
+		if err != nil {
+			return util.NewUint128(), "", err
+		}
+		if unbonding {
+			return util.NewUint128(), "", ErrWithdrawInUnbonding
+		}
+		if amount, err = deposit.Withdraw(participant); err != nil {
+			return util.NewUint128(), "", err
+		}
+		if amount.Cmp(util.NewUint128()) == 0 {
+			return util.NewUint128(), "", ErrNoDepositToWithdraw
+		}
+		if err := acc.AddBalance(amount); err != nil {
+			return util.NewUint128(), "", err
+		}
+	default:
+		return util.NewUint128(), "", ErrInvalidDevotionPayloadAction
+	}
+
+	event := &DepositChangedEvent{
+		Action:  payload.Action,
+		Address: tx.from.String(),
+		Deposit: amount.String(),
+	}
+	eData, err := json.Marshal(event)
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+	ws.RecordEvent(tx.hash, &state.Event{Topic: TopicDepositChanged, Data: string(eData)})
+	return util.NewUint128(), "", nil
+}
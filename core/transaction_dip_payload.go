@@ -0,0 +1,208 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+var (
+	// DipRewardAddress the account paying the dip rewards, n1Ukg9kpiFT7RBypBjZE6CVBP7A6SdCmYgE.
+	// It's derived from no key, so nobody is able to sign for it and its balance is only spent by
+	// dip txs. It's funded by the token distribution in genesis or by the transfers from anyone,
+	// a period is paid once the balance covers its reward value.
+	DipRewardAddress, _ = newAddress(AccountAddress, []byte("nebulas dip reward pool"))
+
+	// dipPeriodKey in the storage of reward address, the latest rewarded period
+	dipPeriodKey = []byte("dip_period")
+	// dipHeightKey in the storage of reward address, the height of the latest block packing a dip tx
+	dipHeightKey = []byte("dip_height")
+)
+
+// DipRewardEvent event of the contract deployers rewarded for a dip period
+type DipRewardEvent struct {
+	Period  uint64            `json:"period"`
+	Rewards []*DipRewardEntry `json:"rewards"`
+}
+
+// DipRewardEntry the reward of a deployer in DipRewardEvent
+type DipRewardEntry struct {
+	Contract string `json:"contract"`
+	Deployer string `json:"deployer"`
+	Value    string `json:"value"`
+}
+
+// DipPayload carry the dip period to reward, the rewards are computed on chain and paid
+// from the reward address. The dip tx is a protocol tx packed by the proposer of block,
+// see verifyDipTransaction, so each period is paid once by at most one tx in a block.
+type DipPayload struct {
+	Period uint64
+}
+
+// LoadDipPayload from bytes
+func LoadDipPayload(bytes []byte) (*DipPayload, error) {
+	payload := &DipPayload{}
+	if err := json.Unmarshal(bytes, payload); err != nil {
+		return nil, ErrInvalidArgument
+	}
+	return NewDipPayload(payload.Period)
+}
+
+// NewDipPayload with period
+func NewDipPayload(period uint64) (*DipPayload, error) {
+	if period == 0 {
+		return nil, ErrInvalidDipPeriod
+	}
+	return &DipPayload{
+		Period: period,
+	}, nil
+}
+
+// ToBytes serialize payload
+func (payload *DipPayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// BaseGasCount returns base gas count
+func (payload *DipPayload) BaseGasCount() *util.Uint128 {
+	base, _ := util.NewUint128FromInt(60)
+	return base
+}
+
+// Execute the dip payload in tx, pay the rewards of period from the reward address
+func (payload *DipPayload) Execute(limitedGas *util.Uint128, tx *Transaction, block *Block, ws WorldState) (*util.Uint128, string, error) {
+	if block == nil || tx == nil {
+		return util.NewUint128(), "", ErrNilArgument
+	}
+	if block.dip == nil || block.dip.RewardAddress() == nil {
+		return util.NewUint128(), "", ErrDipNotEnabled
+	}
+
+	pool, err := ws.GetOrCreateUserAccount(block.dip.RewardAddress().address)
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+	rewards, err := block.dip.Rewards(payload.Period, block)
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+
+	event := &DipRewardEvent{
+		Period:  payload.Period,
+		Rewards: []*DipRewardEntry{},
+	}
+	for _, reward := range rewards {
+		if err := pool.SubBalance(reward.Value); err != nil {
+			return util.NewUint128(), "", err
+		}
+		deployer, err := ws.GetOrCreateUserAccount(reward.Deployer.address)
+		if err != nil {
+			return util.NewUint128(), "", err
+		}
+		if err := deployer.AddBalance(reward.Value); err != nil {
+			return util.NewUint128(), "", err
+		}
+		event.Rewards = append(event.Rewards, &DipRewardEntry{
+			Contract: reward.Contract.String(),
+			Deployer: reward.Deployer.String(),
+			Value:    reward.Value.String(),
+		})
+	}
+	if err := pool.Put(dipPeriodKey, byteutils.FromUint64(payload.Period)); err != nil {
+		return util.NewUint128(), "", err
+	}
+	if err := pool.Put(dipHeightKey, byteutils.FromUint64(block.height)); err != nil {
+		return util.NewUint128(), "", err
+	}
+
+	eData, err := json.Marshal(event)
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+	ws.RecordEvent(tx.hash, &state.Event{Topic: TopicDipReward, Data: string(eData)})
+	return util.NewUint128(), "", nil
+}
+
+// verifyDipTransaction check the dip tx before it's on chain. It should be sent by the proposer
+// of block, be the only one in block, follow the latest rewarded period, and the pool should
+// cover the reward value, so the rewards are only computed for the tx paying them. The dip txs
+// of other senders are given back to wait for their own blocks.
+func verifyDipTransaction(tx *Transaction, block *Block, ws WorldState) (bool, error) {
+	if block.dip == nil || block.dip.RewardAddress() == nil {
+		return false, ErrDipNotEnabled
+	}
+	if !tx.from.address.Equals(block.WorldState().ConsensusRoot().Proposer) {
+		return true, ErrInvalidDipSender
+	}
+	payload, err := LoadDipPayload(tx.data.Payload)
+	if err != nil {
+		return false, err
+	}
+	pool, err := ws.GetOrCreateUserAccount(block.dip.RewardAddress().address)
+	if err != nil {
+		return true, err
+	}
+	height, err := dipStorageUint64(pool, dipHeightKey)
+	if err != nil {
+		return true, err
+	}
+	if height == block.height {
+		return false, ErrDuplicatedDipTransaction
+	}
+	latest, err := rewardedDipPeriod(pool)
+	if err != nil {
+		return true, err
+	}
+	if payload.Period != latest+1 {
+		return false, ErrInvalidDipPeriod
+	}
+	if pool.Balance().Cmp(block.dip.RewardValue()) < 0 {
+		return false, ErrInsufficientDipPool
+	}
+	return false, nil
+}
+
+// RewardedDipPeriod return the latest dip period rewarded from the reward address at block, 0 if none.
+func RewardedDipPeriod(block *Block, rewardAddress *Address) (uint64, error) {
+	pool, err := block.GetAccount(rewardAddress.address)
+	if err != nil {
+		return 0, err
+	}
+	return rewardedDipPeriod(pool)
+}
+
+func rewardedDipPeriod(pool state.Account) (uint64, error) {
+	return dipStorageUint64(pool, dipPeriodKey)
+}
+
+func dipStorageUint64(pool state.Account, key []byte) (uint64, error) {
+	value, err := pool.Get(key)
+	if err != nil {
+		if err == storage.ErrKeyNotFound {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return byteutils.Uint64(value), nil
+}
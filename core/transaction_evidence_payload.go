@@ -0,0 +1,136 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/util"
+)
+
+// EvidencePayload carry the evidence of double mint, the miner who minted the blocks is disqualified,
+// and its deposit is slashed if the consensus requires deposits.
+type EvidencePayload struct {
+	Evidence []byte
+}
+
+// LoadEvidencePayload from bytes
+func LoadEvidencePayload(bytes []byte) (*EvidencePayload, error) {
+	payload := &EvidencePayload{}
+	if err := json.Unmarshal(bytes, payload); err != nil {
+		return nil, ErrInvalidArgument
+	}
+	if _, err := LoadDoubleMintEvidence(payload.Evidence); err != nil {
+		return nil, ErrInvalidDoubleMintEvidence
+	}
+	return payload, nil
+}
+
+// NewEvidencePayload with the evidence of double mint
+func NewEvidencePayload(evidence *DoubleMintEvidence) (*EvidencePayload, error) {
+	if evidence == nil {
+		return nil, ErrNilArgument
+	}
+	data, err := evidence.ToBytes()
+	if err != nil {
+		return nil, err
+	}
+	return &EvidencePayload{
+		Evidence: data,
+	}, nil
+}
+
+// ToBytes serialize payload
+func (payload *EvidencePayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// BaseGasCount returns base gas count
+func (payload *EvidencePayload) BaseGasCount() *util.Uint128 {
+	base, _ := util.NewUint128FromInt(60)
+	return base
+}
+
+// Execute the evidence payload in tx, disqualify or slash the miner who double minted
+func (payload *EvidencePayload) Execute(limitedGas *util.Uint128, tx *Transaction, block *Block, ws WorldState) (*util.Uint128, string, error) {
+	if block == nil || tx == nil {
+		return util.NewUint128(), "", ErrNilArgument
+	}
+
+	evidence, err := LoadDoubleMintEvidence(payload.Evidence)
+	if err != nil {
+		return util.NewUint128(), "", ErrInvalidDoubleMintEvidence
+	}
+	miner, err := evidence.Verify(block.ChainID())
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+
+	event := &DoubleMintEvent{
+		Miner:     miner.String(),
+		Timestamp: evidence.Timestamp(),
+	}
+	if deposit, err := ws.DepositState(); err == nil {
+		slashed, err := slash(deposit, miner)
+		if err != nil {
+			return util.NewUint128(), "", err
+		}
+		event.Slashed = slashed.String()
+	} else if err := disqualify(ws, miner); err != nil {
+		return util.NewUint128(), "", err
+	}
+	for _, hash := range evidence.Blocks() {
+		event.Blocks = append(event.Blocks, hash.String())
+	}
+	eData, err := json.Marshal(event)
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+	ws.RecordEvent(tx.hash, &state.Event{Topic: TopicDoubleMint, Data: string(eData)})
+	return util.NewUint128(), "", nil
+}
+
+// disqualify make the miner ineligible for subsequent dynasties
+func disqualify(ws WorldState, miner *Address) error {
+	election, err := ws.ElectionState()
+	if err != nil {
+		return err
+	}
+	disqualified, err := election.IsDisqualified(miner.address)
+	if err != nil {
+		return err
+	}
+	if disqualified {
+		return ErrDuplicatedEvidence
+	}
+	return election.Disqualify(miner.address)
+}
+
+// slash confiscate the deposit of the miner and make it ineligible for subsequent dynasties
+func slash(deposit state.DepositState, miner *Address) (*util.Uint128, error) {
+	slashed, err := deposit.IsSlashed(miner.address)
+	if err != nil {
+		return nil, err
+	}
+	if slashed {
+		return nil, ErrDuplicatedEvidence
+	}
+	return deposit.Slash(miner.address)
+}
@@ -0,0 +1,157 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"golang.org/x/crypto/sha3"
+)
+
+// GasScheduleEvent event of a gas schedule passed by governance proposal
+type GasScheduleEvent struct {
+	Version   uint64   `json:"version"`
+	Height    uint64   `json:"height"`
+	Approvers []string `json:"approvers"`
+}
+
+// GasSchedulePayload carry the approval of a gas schedule proposal, the sender of tx approves
+// to activate the table since the height. The proposal is approved by the validators of poa,
+// or by the members of current dynasty of the engines electing dynasties, and passes once
+// threshold of them approved it. Then the table becomes the next version of gas schedule.
+type GasSchedulePayload struct {
+	Height uint64
+	Table  *GasTable
+}
+
+// LoadGasSchedulePayload from bytes
+func LoadGasSchedulePayload(bytes []byte) (*GasSchedulePayload, error) {
+	payload := &GasSchedulePayload{}
+	if err := json.Unmarshal(bytes, payload); err != nil {
+		return nil, ErrInvalidArgument
+	}
+	return NewGasSchedulePayload(payload.Height, payload.Table)
+}
+
+// NewGasSchedulePayload with height & table
+func NewGasSchedulePayload(height uint64, table *GasTable) (*GasSchedulePayload, error) {
+	if table == nil {
+		return nil, ErrInvalidGasTable
+	}
+	if err := table.Verify(); err != nil {
+		return nil, err
+	}
+	return &GasSchedulePayload{
+		Height: height,
+		Table:  table,
+	}, nil
+}
+
+// ToBytes serialize payload
+func (payload *GasSchedulePayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// BaseGasCount returns base gas count
+func (payload *GasSchedulePayload) BaseGasCount() *util.Uint128 {
+	base, _ := util.NewUint128FromInt(60)
+	return base
+}
+
+// ProposalHash return the hash identifying the proposal approved by the payload.
+func (payload *GasSchedulePayload) ProposalHash() (byteutils.Hash, error) {
+	bytes, err := payload.ToBytes()
+	if err != nil {
+		return nil, err
+	}
+	hasher := sha3.New256()
+	hasher.Write([]byte(TxPayloadGasScheduleType))
+	hasher.Write(bytes)
+	return hasher.Sum(nil), nil
+}
+
+// Execute the gas schedule payload in tx, record the approval of sender and append the schedule if it passes
+func (payload *GasSchedulePayload) Execute(limitedGas *util.Uint128, tx *Transaction, block *Block, ws WorldState) (*util.Uint128, string, error) {
+	if block == nil || tx == nil || tx.from == nil {
+		return util.NewUint128(), "", ErrNilArgument
+	}
+	if payload.Height <= block.Height() {
+		return util.NewUint128(), "", ErrInvalidGasScheduleHeight
+	}
+
+	gov, err := gasScheduleGovernance(ws)
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+	proposal, err := payload.ProposalHash()
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+	approvers, passed, err := approveProposal(gov, proposal, tx.from.address)
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+	if !passed {
+		return util.NewUint128(), "", nil
+	}
+
+	schedule, err := appendGasSchedule(ws, payload.Height, payload.Table)
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+	if err := gov.ClearApprovals(proposal); err != nil {
+		return util.NewUint128(), "", err
+	}
+
+	event := &GasScheduleEvent{
+		Version:   schedule.Version,
+		Height:    schedule.Height,
+		Approvers: approvers,
+	}
+	eData, err := json.Marshal(event)
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+	ws.RecordEvent(tx.hash, &state.Event{Topic: TopicGasScheduleUpdated, Data: string(eData)})
+	return util.NewUint128(), "", nil
+}
+
+// gasScheduleGovernance return the validators of poa, or the members of current dynasty of
+// other engines whose approvals are kept in the account of gas schedules.
+func gasScheduleGovernance(ws WorldState) (governance, error) {
+	authority, err := ws.AuthorityState()
+	if err == nil {
+		return &validatorGovernance{authority}, nil
+	}
+	if err != state.ErrConsensusWithoutAuthority {
+		return nil, err
+	}
+	dynasty, err := ws.Dynasty()
+	if err != nil {
+		return nil, err
+	}
+	account, err := ensureGasScheduleAccount(ws)
+	if err != nil {
+		return nil, err
+	}
+	return newDynastyGovernance(dynasty, account), nil
+}
@@ -19,6 +19,8 @@
 package core
 
 import (
+	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/nebulasio/go-nebulas/util"
@@ -297,3 +299,153 @@ func TestPayload_Execute(t *testing.T) {
 
 	block.RollBack()
 }
+
+func TestLoadElectionPayload(t *testing.T) {
+	candidate, err := LoadCandidatePayload([]byte(`{"Action":"login"}`))
+	assert.Nil(t, err)
+	assert.Equal(t, LoginAction, candidate.Action)
+	bytes, err := candidate.ToBytes()
+	assert.Nil(t, err)
+	got, err := LoadCandidatePayload(bytes)
+	assert.Nil(t, err)
+	assert.Equal(t, candidate, got)
+
+	_, err = LoadCandidatePayload([]byte(`{"Action":"join"}`))
+	assert.Equal(t, ErrInvalidCandidatePayloadAction, err)
+	_, err = LoadCandidatePayload([]byte("data"))
+	assert.Equal(t, ErrInvalidArgument, err)
+
+	delegate, err := LoadDelegatePayload([]byte(`{"Action":"do","Delegatee":"n1FF1nz6tarkDVwWQkMnnwFPuPKUaQTdptE"}`))
+	assert.Nil(t, err)
+	assert.Equal(t, DelegateAction, delegate.Action)
+	bytes, err = delegate.ToBytes()
+	assert.Nil(t, err)
+	loaded, err := LoadDelegatePayload(bytes)
+	assert.Nil(t, err)
+	assert.Equal(t, delegate, loaded)
+
+	_, err = LoadDelegatePayload([]byte(`{"Action":"redo","Delegatee":"n1FF1nz6tarkDVwWQkMnnwFPuPKUaQTdptE"}`))
+	assert.Equal(t, ErrInvalidDelegatePayloadAction, err)
+	_, err = LoadDelegatePayload([]byte(`{"Action":"do","Delegatee":"n1FF1"}`))
+	assert.NotNil(t, err)
+}
+
+func TestLoadDipPayload(t *testing.T) {
+	payload, err := NewDipPayload(3)
+	assert.Nil(t, err)
+	bytes, err := payload.ToBytes()
+	assert.Nil(t, err)
+	got, err := LoadDipPayload(bytes)
+	assert.Nil(t, err)
+	assert.Equal(t, payload, got)
+
+	_, err = NewDipPayload(0)
+	assert.Equal(t, ErrInvalidDipPeriod, err)
+	_, err = LoadDipPayload([]byte(`{"Period":0}`))
+	assert.Equal(t, ErrInvalidDipPeriod, err)
+	_, err = LoadDipPayload([]byte("data"))
+	assert.Equal(t, ErrInvalidArgument, err)
+
+	// dip txs fail on chains without dip.
+	block := &Block{}
+	tx := &Transaction{}
+	_, _, err = payload.Execute(util.NewUint128(), tx, block, nil)
+	assert.Equal(t, ErrDipNotEnabled, err)
+}
+
+func TestLoadUpgradePayload(t *testing.T) {
+	tests := []struct {
+		name    string
+		bytes   []byte
+		want    *UpgradePayload
+		wantErr error
+	}{
+		{
+			name:    "parse faild",
+			bytes:   []byte("data"),
+			wantErr: ErrInvalidArgument,
+		},
+
+		{
+			name:    "no source",
+			bytes:   []byte(`{"SourceType":"js"}`),
+			wantErr: ErrInvalidUpgradePayload,
+		},
+
+		{
+			name:    "invalid source type",
+			bytes:   []byte(`{"Source":"source","SourceType":"go"}`),
+			wantErr: ErrInvalidDeploySourceType,
+		},
+
+		{
+			name:  "upgrade",
+			bytes: []byte(`{"Source":"source","SourceType":"js"}`),
+			want:  &UpgradePayload{Source: "source", SourceType: "js"},
+		},
+
+		{
+			name:  "freeze",
+			bytes: []byte(`{"Freeze":true}`),
+			want:  &UpgradePayload{Freeze: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LoadUpgradePayload(tt.bytes)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLoadGasSchedulePayload(t *testing.T) {
+	table, _ := json.Marshal(DefaultGasSchedule().Table)
+
+	tests := []struct {
+		name    string
+		bytes   []byte
+		wantErr error
+	}{
+		{
+			name:    "parse faild",
+			bytes:   []byte("data"),
+			wantErr: ErrInvalidArgument,
+		},
+
+		{
+			name:    "no table",
+			bytes:   []byte(`{"Height":10}`),
+			wantErr: ErrInvalidGasTable,
+		},
+
+		{
+			name:    "missing expressions",
+			bytes:   []byte(`{"Height":10,"Table":{"expressions":{"CallExpression":8}}}`),
+			wantErr: ErrInvalidGasTable,
+		},
+
+		{
+			name:    "count out of range",
+			bytes:   []byte(`{"Height":10,"Table":` + strings.Replace(string(table), `"transfer":2000`, `"transfer":2000000`, 1) + `}`),
+			wantErr: ErrInvalidGasTable,
+		},
+
+		{
+			name:  "gas schedule",
+			bytes: []byte(`{"Height":10,"Table":` + string(table) + `}`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LoadGasSchedulePayload(tt.bytes)
+			assert.Equal(t, tt.wantErr, err)
+			if tt.wantErr == nil {
+				assert.Equal(t, uint64(10), got.Height)
+				assert.Equal(t, DefaultGasSchedule().Table, got.Table)
+			}
+		})
+	}
+}
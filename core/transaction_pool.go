@@ -222,6 +222,23 @@ func (pool *TransactionPool) GetTransaction(hash byteutils.Hash) *Transaction {
 	return pool.all[hash.Hex()]
 }
 
+// NextNonce return the nonce for the next tx of addr, skipping the nonces
+// of its pending txs in pool after the given account nonce.
+func (pool *TransactionPool) NextNonce(addr *Address, nonce uint64) uint64 {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	next := nonce + 1
+	if bucket, ok := pool.buckets[addr.address.Hex()]; ok {
+		for i := 0; i < bucket.Len(); i++ {
+			if bucket.Index(i).(*Transaction).nonce == next {
+				next++
+			}
+		}
+	}
+	return next
+}
+
 // PushAndRelay push tx into pool and relay it
 func (pool *TransactionPool) PushAndRelay(tx *Transaction) error {
 	if err := pool.Push(tx); err != nil {
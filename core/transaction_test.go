@@ -21,6 +21,7 @@ package core
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"reflect"
 	"testing"
 	"time"
@@ -764,6 +765,150 @@ func TestDeployAndCall(t *testing.T) {
 	}
 }
 
+func mockSignature(addr *Address) keystore.Signature {
+	key, _ := keystore.DefaultKS.GetUnlocked(addr.String())
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(key.(keystore.PrivateKey))
+	return signature
+}
+
+func executeTestTx(t *testing.T, tx *Transaction, block *Block) *TransactionEvent {
+	txWorldState, err := block.WorldState().Prepare(tx.Hash().String())
+	assert.Nil(t, err)
+	giveback, err := VerifyExecution(tx, block, txWorldState)
+	assert.False(t, giveback)
+	assert.Nil(t, err)
+	giveback, err = AcceptTransaction(tx, txWorldState)
+	assert.False(t, giveback)
+	assert.Nil(t, err)
+	_, err = txWorldState.CheckAndUpdate()
+	assert.Nil(t, err)
+
+	events, err := block.worldState.FetchEvents(tx.Hash())
+	assert.Nil(t, err)
+	event := events[len(events)-1]
+	assert.Equal(t, TopicTransactionExecutionResult, event.Topic)
+	txEvent := new(TransactionEvent)
+	assert.Nil(t, json.Unmarshal([]byte(event.Data), txEvent))
+	return txEvent
+}
+
+func TestUpgradeContract(t *testing.T) {
+	height := ContractUpgradeAvailableHeight
+	ContractUpgradeAvailableHeight = LocalContractUpgradeAvailableHeight
+	defer func() { ContractUpgradeAvailableHeight = height }()
+
+	neb := testNeb(t)
+	bc := neb.chain
+
+	owner := mockAddress()
+	other := mockAddress()
+	balance, _ := util.NewUint128FromString("1000000000000000000")
+
+	block, err := bc.NewBlock(mockAddress())
+	assert.Nil(t, err)
+	for _, addr := range []*Address{owner, other} {
+		acc, err := block.worldState.GetOrCreateUserAccount(addr.address)
+		assert.Nil(t, err)
+		assert.Nil(t, acc.AddBalance(balance))
+	}
+	block.Commit()
+
+	block, err = bc.NewBlockFromParent(bc.tailBlock.header.coinbase, block)
+	assert.Nil(t, err)
+
+	deployTx := mockDeployTransaction(bc.chainID, 1)
+	deployTx.from = owner
+	deployTx.to = owner
+	assert.Nil(t, deployTx.Sign(mockSignature(owner)))
+	assert.Equal(t, TxExecutionSuccess, int(executeTestTx(t, deployTx, block).Status))
+	contractAddr, err := deployTx.GenerateContractAddress()
+	assert.Nil(t, err)
+
+	mockUpgradeTx := func(from *Address, nonce uint64, source string, freeze bool) *Transaction {
+		payload, err := NewUpgradePayload(source, SourceTypeJavaScript, freeze)
+		assert.Nil(t, err)
+		data, err := payload.ToBytes()
+		assert.Nil(t, err)
+		tx, err := NewTransaction(bc.chainID, from, contractAddr, util.NewUint128(), nonce, TxPayloadUpgradeType, data, TransactionGasPrice, TransactionMaxGas)
+		assert.Nil(t, err)
+		assert.Nil(t, tx.Sign(mockSignature(from)))
+		return tx
+	}
+	source := `var Contract = function() {}; module.exports = Contract;`
+
+	// only the owner can upgrade the contract.
+	txEvent := executeTestTx(t, mockUpgradeTx(other, 1, source, false), block)
+	assert.Equal(t, TxExecutionFailed, int(txEvent.Status))
+	assert.Equal(t, ErrUpgradeFromNonOwner.Error(), txEvent.Error)
+
+	txEvent = executeTestTx(t, mockUpgradeTx(owner, 2, source, false), block)
+	assert.Equal(t, TxExecutionSuccess, int(txEvent.Status))
+	contract, err := block.worldState.GetContractAccount(contractAddr.address)
+	assert.Nil(t, err)
+	version, err := ContractVersion(contract)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), version)
+	got, _, err := LoadContractSource(contract, block.worldState)
+	assert.Nil(t, err)
+	assert.Equal(t, source, got)
+
+	// a frozen contract cannot be upgraded anymore.
+	txEvent = executeTestTx(t, mockUpgradeTx(owner, 3, "", true), block)
+	assert.Equal(t, TxExecutionSuccess, int(txEvent.Status))
+	txEvent = executeTestTx(t, mockUpgradeTx(owner, 4, source, false), block)
+	assert.Equal(t, TxExecutionFailed, int(txEvent.Status))
+	assert.Equal(t, ErrUpgradeFrozenContract.Error(), txEvent.Error)
+
+	contract, err = block.worldState.GetContractAccount(contractAddr.address)
+	assert.Nil(t, err)
+	frozen, err := ContractFrozen(contract)
+	assert.Nil(t, err)
+	assert.True(t, frozen)
+	version, err = ContractVersion(contract)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), version)
+}
+
+func TestUnavailablePayloads(t *testing.T) {
+	election, authority, devotion := ElectionAvailableHeight, AuthorityAvailableHeight, DevotionAvailableHeight
+	dip, upgrade, gasSchedule := DipAvailableHeight, ContractUpgradeAvailableHeight, GasScheduleAvailableHeight
+	ElectionAvailableHeight, AuthorityAvailableHeight, DevotionAvailableHeight = math.MaxUint64, math.MaxUint64, math.MaxUint64
+	DipAvailableHeight, ContractUpgradeAvailableHeight, GasScheduleAvailableHeight = math.MaxUint64, math.MaxUint64, math.MaxUint64
+	defer func() {
+		ElectionAvailableHeight, AuthorityAvailableHeight, DevotionAvailableHeight = election, authority, devotion
+		DipAvailableHeight, ContractUpgradeAvailableHeight, GasScheduleAvailableHeight = dip, upgrade, gasSchedule
+	}()
+
+	neb := testNeb(t)
+	bc := neb.chain
+	balance, _ := util.NewUint128FromString("1000000000000000000")
+
+	// the malformed payloads of the types not available yet fail as unknown types.
+	payloadTypes := []string{
+		TxPayloadCandidateType, TxPayloadDelegateType, TxPayloadEvidenceType, TxPayloadAuthorityType,
+		TxPayloadDevotionType, TxPayloadDipType, TxPayloadUpgradeType, TxPayloadGasScheduleType,
+	}
+	for _, payloadType := range payloadTypes {
+		tx := mockTransaction(bc.chainID, 1, payloadType, []byte("malformed"))
+		assert.Nil(t, tx.Sign(mockSignature(tx.from)))
+
+		block, err := bc.NewBlock(mockAddress())
+		assert.Nil(t, err)
+		acc, err := block.worldState.GetOrCreateUserAccount(tx.from.address)
+		assert.Nil(t, err)
+		assert.Nil(t, acc.AddBalance(balance))
+
+		txEvent := executeTestTx(t, tx, block)
+		assert.Equal(t, TxExecutionFailed, int(txEvent.Status), payloadType)
+		assert.Equal(t, ErrInvalidTxPayloadType.Error(), txEvent.Error, payloadType)
+		baseGas, err := tx.GasCountOfTxBase()
+		assert.Nil(t, err)
+		assert.Equal(t, baseGas.String(), txEvent.GasUsed, payloadType)
+		block.RollBack()
+	}
+}
+
 func Test1(t *testing.T) {
 	fmt.Println(len(hash.Sha3256([]byte("abc"))))
 }
@@ -0,0 +1,222 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// the keys of upgrade records in contract storage, the domain cannot be reached by
+// contract code since it is not a valid js identifier.
+const contractMetaDomain = ".contract"
+
+var (
+	contractVersionKey = trie.HashDomains(contractMetaDomain, "version")
+	contractFrozenKey  = trie.HashDomains(contractMetaDomain, "frozen")
+)
+
+func contractHistoryKey(version uint64) []byte {
+	return trie.HashDomains(contractMetaDomain, "history", strconv.FormatUint(version, 10))
+}
+
+// ContractUpgradeEvent event of a contract upgraded or frozen by its owner
+type ContractUpgradeEvent struct {
+	Contract string `json:"contract"`
+	Version  uint64 `json:"version"`
+	Frozen   bool   `json:"frozen"`
+}
+
+// UpgradePayload carry the new source of a contract, only the owner deploying the
+// contract can send it. The storage of the contract is kept, and the contract cannot
+// be upgraded anymore once frozen.
+type UpgradePayload struct {
+	SourceType string
+	Source     string
+	Freeze     bool
+}
+
+// LoadUpgradePayload from bytes
+func LoadUpgradePayload(bytes []byte) (*UpgradePayload, error) {
+	payload := &UpgradePayload{}
+	if err := json.Unmarshal(bytes, payload); err != nil {
+		return nil, ErrInvalidArgument
+	}
+	return NewUpgradePayload(payload.Source, payload.SourceType, payload.Freeze)
+}
+
+// NewUpgradePayload with source & freeze, the source can be empty only to freeze the contract
+func NewUpgradePayload(source, sourceType string, freeze bool) (*UpgradePayload, error) {
+	if len(source) == 0 {
+		if !freeze {
+			return nil, ErrInvalidUpgradePayload
+		}
+	} else if sourceType != SourceTypeTypeScript && sourceType != SourceTypeJavaScript {
+		return nil, ErrInvalidDeploySourceType
+	}
+
+	return &UpgradePayload{
+		Source:     source,
+		SourceType: sourceType,
+		Freeze:     freeze,
+	}, nil
+}
+
+// ToBytes serialize payload
+func (payload *UpgradePayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// BaseGasCount returns base gas count, the source is charged by its bytes as the data of tx,
+// and it's only parsed, no contract code runs in upgrade.
+func (payload *UpgradePayload) BaseGasCount() *util.Uint128 {
+	base, _ := util.NewUint128FromInt(60)
+	return base
+}
+
+// Execute the upgrade payload in tx, replace the source of the contract and record the version
+func (payload *UpgradePayload) Execute(limitedGas *util.Uint128, tx *Transaction, block *Block, ws WorldState) (*util.Uint128, string, error) {
+	if block == nil || tx == nil {
+		return util.NewUint128(), "", ErrNilArgument
+	}
+
+	// contract address is tx.to.
+	contract, err := CheckContract(tx.to, ws)
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+	birthTx, err := GetTransaction(contract.BirthPlace(), ws)
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+	if !birthTx.from.Equals(tx.from) {
+		return util.NewUint128(), "", ErrUpgradeFromNonOwner
+	}
+	frozen, err := ContractFrozen(contract)
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+	if frozen {
+		return util.NewUint128(), "", ErrUpgradeFrozenContract
+	}
+
+	// the source is transpiled and parsed before anything is recorded, the contract is
+	// neither upgraded nor frozen with a broken source.
+	if len(payload.Source) > 0 {
+		if _, err := block.nvm.PublicFunctions(payload.Source, payload.SourceType); err != nil {
+			return util.NewUint128(), "", ErrInvalidUpgradeSource
+		}
+	}
+
+	version, err := ContractVersion(contract)
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+	if len(payload.Source) > 0 {
+		version++
+		if err := contract.Put(contractHistoryKey(version), tx.hash); err != nil {
+			return util.NewUint128(), "", err
+		}
+		if err := contract.Put(contractVersionKey, byteutils.FromUint64(version)); err != nil {
+			return util.NewUint128(), "", err
+		}
+	}
+	if payload.Freeze {
+		if err := contract.Put(contractFrozenKey, byteutils.FromUint64(1)); err != nil {
+			return util.NewUint128(), "", err
+		}
+	}
+
+	eData, err := json.Marshal(&ContractUpgradeEvent{
+		Contract: tx.to.String(),
+		Version:  version,
+		Frozen:   payload.Freeze,
+	})
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+	ws.RecordEvent(tx.hash, &state.Event{Topic: TopicContractUpgraded, Data: string(eData)})
+	return util.NewUint128(), "", nil
+}
+
+// ContractVersion return the latest version of the contract, 0 if never upgraded.
+func ContractVersion(contract state.Account) (uint64, error) {
+	value, err := contract.Get(contractVersionKey)
+	if err != nil {
+		if err == storage.ErrKeyNotFound {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return byteutils.Uint64(value), nil
+}
+
+// ContractFrozen return whether the contract is frozen by its owner.
+func ContractFrozen(contract state.Account) (bool, error) {
+	if _, err := contract.Get(contractFrozenKey); err != nil {
+		if err == storage.ErrKeyNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// ContractHistory return the hash of the tx carrying the source of the contract at version,
+// the deploy tx at version 0.
+func ContractHistory(contract state.Account, version uint64) (byteutils.Hash, error) {
+	if version == 0 {
+		return contract.BirthPlace(), nil
+	}
+	return contract.Get(contractHistoryKey(version))
+}
+
+// LoadContractSource return the source of the latest version of the contract.
+func LoadContractSource(contract state.Account, ws WorldState) (string, string, error) {
+	version, err := ContractVersion(contract)
+	if err != nil {
+		return "", "", err
+	}
+	hash, err := ContractHistory(contract, version)
+	if err != nil {
+		return "", "", err
+	}
+	sourceTx, err := GetTransaction(hash, ws)
+	if err != nil {
+		return "", "", err
+	}
+	if version == 0 {
+		deploy, err := LoadDeployPayload(sourceTx.data.Payload)
+		if err != nil {
+			return "", "", err
+		}
+		return deploy.Source, deploy.SourceType, nil
+	}
+	upgrade, err := LoadUpgradePayload(sourceTx.data.Payload)
+	if err != nil {
+		return "", "", err
+	}
+	return upgrade.Source, upgrade.SourceType, nil
+}
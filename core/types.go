@@ -43,6 +43,20 @@ const (
 	TxPayloadBinaryType = "binary"
 	TxPayloadDeployType = "deploy"
 	TxPayloadCallType   = "call"
+
+	TxPayloadCandidateType = "candidate"
+	TxPayloadDelegateType  = "delegate"
+	TxPayloadEvidenceType  = "evidence"
+
+	TxPayloadAuthorityType = "authority"
+
+	TxPayloadDevotionType = "devotion"
+
+	TxPayloadDipType = "dip"
+
+	TxPayloadUpgradeType = "upgrade"
+
+	TxPayloadGasScheduleType = "gasschedule"
 )
 
 // Const.
@@ -89,6 +103,7 @@ var (
 	ErrGenesisNotEqualTokenInDB      = errors.New("Failed to check. genesis TokenDistribution not equal in db")
 	ErrGenesisNotEqualDynastyLenInDB = errors.New("Failed to check. genesis dynasty length not equal in db")
 	ErrGenesisNotEqualTokenLenInDB   = errors.New("Failed to check. genesis TokenDistribution length not equal in db")
+	ErrGenesisNotEqualParamsInDB     = errors.New("Failed to check. genesis consensus params not equal in db")
 
 	ErrLinkToWrongParentBlock = errors.New("link the block to a block who is not its parent")
 	ErrMissingParentBlock     = errors.New("cannot find the block's parent block in storage")
@@ -140,6 +155,37 @@ var (
 	ErrInvalidDelegatePayloadAction      = errors.New("invalid transaction vote payload action")
 	ErrInvalidDelegateToNonCandidate     = errors.New("cannot delegate to non-candidate")
 	ErrInvalidUnDelegateFromNonDelegatee = errors.New("cannot un-delegate from non-delegatee")
+	ErrInvalidLogoutFromNonCandidate     = errors.New("cannot logout from non-candidate")
+	ErrDuplicatedCandidate               = errors.New("duplicated candidate")
+	ErrInvalidDoubleMintEvidence         = errors.New("invalid double mint evidence")
+	ErrDuplicatedEvidence                = errors.New("the miner in evidence is already disqualified")
+	ErrInvalidProtoToDoubleMintEvidence  = errors.New("protobuf message cannot be converted into DoubleMintEvidence")
+	ErrInvalidProtoToPreCommit           = errors.New("protobuf message cannot be converted into PreCommit")
+	ErrInvalidFinalityProof              = errors.New("invalid finality proof")
+	ErrInvalidAuthorityPayloadAction     = errors.New("invalid transaction authority payload action")
+	ErrApproveFromNonValidator           = errors.New("cannot approve proposal from non-validator")
+	ErrDuplicatedApproval                = errors.New("the proposal is already approved by the validator")
+	ErrDuplicatedValidator               = errors.New("duplicated validator")
+	ErrRemoveNonValidator                = errors.New("cannot remove non-validator")
+	ErrTooFewValidators                  = errors.New("the validators are too few to pass proposals")
+	ErrInvalidDevotionPayloadAction      = errors.New("invalid transaction devotion payload action")
+	ErrInvalidDepositReceiver            = errors.New("the deposit should be sent to the sender itself")
+	ErrInsufficientDeposit               = errors.New("the deposit is less than the minimal deposit")
+	ErrDuplicatedParticipant             = errors.New("duplicated participant")
+	ErrQuitFromNonParticipant            = errors.New("cannot quit from non-participant")
+	ErrWithdrawFromParticipant           = errors.New("cannot withdraw the deposit before quit")
+	ErrWithdrawInDynasty                 = errors.New("cannot withdraw the deposit before leaving the dynasty")
+	ErrWithdrawInUnbonding               = errors.New("cannot withdraw the deposit before its unbonding period ends")
+	ErrNoDepositToWithdraw               = errors.New("no deposit to withdraw")
+	ErrJoinFromSlashed                   = errors.New("cannot join from slashed address")
+	ErrDipNotEnabled                     = errors.New("developer incentive protocol is not enabled")
+	ErrInvalidDipPeriod                  = errors.New("invalid dip period, should follow the latest rewarded one")
+	ErrInvalidDipSender                  = errors.New("dip tx should be sent by the proposer of block")
+	ErrDuplicatedDipTransaction          = errors.New("a block packs at most one dip tx")
+	ErrInsufficientDipPool               = errors.New("the balance of dip reward pool is insufficient for a period")
+	ErrUpgradeFromNonOwner               = errors.New("only the owner deploying the contract can upgrade it")
+	ErrUpgradeFrozenContract             = errors.New("cannot upgrade the frozen contract")
+	ErrInvalidUpgradeSource              = errors.New("the upgrade source cannot be parsed as a contract")
 
 	ErrCloneWorldState           = errors.New("Failed to clone world state")
 	ErrCloneAccountState         = errors.New("Failed to clone account state")
@@ -157,13 +203,21 @@ var (
 
 	ErrCannotRevertLIB        = errors.New("cannot revert latest irreversible block")
 	ErrCannotLoadGenesisBlock = errors.New("cannot load genesis block from storage")
-	ErrCannotLoadLIBBlock     = errors.New("cannot load tail block from storage")
-	ErrCannotLoadTailBlock    = errors.New("cannot load latest irreversible block from storage")
+	ErrCannotLoadLIBBlock     = errors.New("cannot load latest irreversible block from storage")
+	ErrCannotLoadTailBlock    = errors.New("cannot load tail block from storage")
 	ErrGenesisConfNotMatch    = errors.New("Failed to load genesis from storage, different with genesis conf")
 
-	ErrInvalidDeploySource     = errors.New("invalid source of deploy payload")
-	ErrInvalidDeploySourceType = errors.New("invalid source type of deploy payload")
-	ErrInvalidCallFunction     = errors.New("invalid function of call payload")
+	ErrIncompatibleStorageScheme = errors.New("incompatible storage scheme, the storage should be migrated")
+	ErrStorageWithoutKeyspace    = errors.New("storage doesn't support keyspaces")
+	ErrCannotRepairStorage       = errors.New("cannot repair storage, latest irreversible block is inconsistent")
+
+	ErrInvalidDeploySource      = errors.New("invalid source of deploy payload")
+	ErrInvalidDeploySourceType  = errors.New("invalid source type of deploy payload")
+	ErrInvalidCallFunction      = errors.New("invalid function of call payload")
+	ErrInvalidUpgradePayload    = errors.New("invalid upgrade payload, should carry a source or freeze the contract")
+	ErrInvalidGasTable          = errors.New("invalid gas table, should count each expression in range")
+	ErrInvalidGasScheduleHeight = errors.New("invalid gas schedule height, should be above the current block and the latest schedule")
+	ErrInvalidRecentBlockHeight = errors.New("invalid recent block height, should be below the current block in the recent window")
 
 	ErrInvalidTransactionResultEvent  = errors.New("invalid transaction result event, the last event in tx's events should be result event")
 	ErrNotFoundTransactionResultEvent = errors.New("transaction result event is not found ")
@@ -196,6 +250,8 @@ const (
 	MessageTypeParentBlockDownloadRequest = "dlblock"
 	MessageTypeBlockDownloadResponse      = "dlreply"
 	MessageTypeNewTx                      = "newtx"
+	MessageTypeDoubleMintEvidence         = "dmevidence"
+	MessageTypePreCommit                  = "precommit"
 )
 
 // Consensus interface of consensus algorithm.
@@ -224,6 +280,17 @@ type Consensus interface {
 	NumberOfBlocksInDynasty() uint64
 }
 
+// BlockMinter interface of consensus minting blocks on demand.
+type BlockMinter interface {
+	MintBlock() (*Block, error)
+}
+
+// MinerStatsRecorder interface of consensus recording the blocks produced and the slots missed by miners.
+type MinerStatsRecorder interface {
+	// MinerStats return the dynasty containing timestamp and the stats of its miners.
+	MinerStats(timestamp int64) (int64, []*consensuspb.MinerStats, error)
+}
+
 // SyncService interface of sync service
 type SyncService interface {
 	Start()
@@ -255,9 +322,28 @@ type AccountManager interface {
 	Remove(*Address, []byte) error
 }
 
+// DipReward the reward of a contract deployer in a dip period
+type DipReward struct {
+	Contract *Address
+	Deployer *Address
+	Value    *util.Uint128
+}
+
+// Dip interface of developer incentive protocol, it computes the rewards of contract
+// deployers paid from the reward address.
+type Dip interface {
+	// RewardAddress return DipRewardAddress, nil if dip is not enabled in genesis.
+	RewardAddress() *Address
+	// RewardValue return the total reward of a period.
+	RewardValue() *util.Uint128
+	// Rewards return the rewards of period, computed from the ancestors of block only.
+	Rewards(period uint64, block *Block) ([]*DipReward, error)
+}
+
 // NVM interface
 type NVM interface {
 	CreateEngine(block *Block, tx *Transaction, contract state.Account, ws WorldState) (SmartContractEngine, error)
+	PublicFunctions(source, sourceType string) ([]string, error)
 	CheckV8Run() error
 }
 
@@ -300,8 +386,12 @@ type WorldState interface {
 
 	Dynasty() ([]byteutils.Hash, error)
 	DynastyRoot() byteutils.Hash
+	ElectionState() (state.ElectionState, error)
+	AuthorityState() (state.AuthorityState, error)
+	DepositState() (state.DepositState, error)
 
 	RecordGas(from string, gas *util.Uint128) error
 
+	Prepare(interface{}) (state.TxWorldState, error)
 	Reset(addr byteutils.Hash) error
 }
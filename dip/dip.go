@@ -0,0 +1,435 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package dip
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/dip/pb"
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/nr"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// const
+const (
+	// DefaultRewardValue 100 NAS in wei for each period
+	DefaultRewardValue = "100000000000000000000"
+
+	updateInterval = 15 * time.Second
+	cacheSize      = 16
+)
+
+var (
+	latestKey = []byte("latest")
+
+	// dipGasLimit covers the base gas of tx and the dip payload.
+	dipGasLimit, _ = util.NewUint128FromInt(200000)
+)
+
+// Errors in dip
+var (
+	ErrInvalidRewardValue    = errors.New("invalid dip reward value, should be an integer in wei")
+	ErrPeriodNotReady        = errors.New("the blocks of dip period are not irreversible yet")
+	ErrPeriodNotFinished     = errors.New("the dip period is not finished before the block")
+	ErrDipNotFound           = errors.New("dip of the period is not computed yet")
+	ErrMissingPeriodBlocks   = errors.New("the blocks of dip period are not found on chain")
+	ErrMissingContractOrigin = errors.New("the deploy tx of the contract is not found")
+)
+
+// Neblet interface breaks cycle import dependency and hides unused services.
+type Neblet interface {
+	Config() *nebletpb.Config
+	Genesis() *corepb.Genesis
+	Storage() storage.Storage
+	BlockChain() *core.BlockChain
+	AccountManager() core.AccountManager
+	Consensus() core.Consensus
+}
+
+// Dip the developer incentive protocol. The periods of dip are the same as nr, in each
+// period the contracts are weighted by the nr of their distinct callers, and the reward
+// value of period is shared by the deployers of the contracts in proportion to the weight.
+// The rewards are paid on chain from the keyless core.DipRewardAddress by a dip tx, which the
+// miner packs in its own block. They are computed from the ancestors of the block packing the
+// tx, so it's the same on all nodes.
+type Dip struct {
+	quitCh chan bool
+
+	neb     Neblet
+	chain   *core.BlockChain
+	nr      *nr.NR
+	storage storage.Storage
+	cache   *lru.Cache
+
+	rewardAddress *core.Address
+	rewardValue   *util.Uint128
+
+	submitted   uint64
+	submittedTx byteutils.Hash
+}
+
+// NewDip create a dip instance, it's disabled if dip is not set in genesis.
+func NewDip(neb Neblet, ranker *nr.NR) (*Dip, error) {
+	conf := neb.Genesis().GetDip()
+
+	var rewardAddress *core.Address
+	if conf != nil {
+		rewardAddress = core.DipRewardAddress
+	}
+	value := conf.GetRewardValue()
+	if len(value) == 0 {
+		value = DefaultRewardValue
+	}
+	rewardValue, err := util.NewUint128FromString(value)
+	if err != nil {
+		return nil, ErrInvalidRewardValue
+	}
+
+	stor, err := storage.OpenKeyspace(neb.Storage(), storage.DipKeyspace)
+	if err != nil {
+		return nil, err
+	}
+	cache, err := lru.New(cacheSize)
+	if err != nil {
+		return nil, err
+	}
+	return &Dip{
+		quitCh:        make(chan bool, 1),
+		neb:           neb,
+		chain:         neb.BlockChain(),
+		nr:            ranker,
+		storage:       stor,
+		cache:         cache,
+		rewardAddress: rewardAddress,
+		rewardValue:   rewardValue,
+	}, nil
+}
+
+// Start start dip service.
+func (d *Dip) Start() {
+	if d.rewardAddress == nil {
+		return
+	}
+	logging.CLog().Info("Starting Dip...")
+	go d.loop()
+}
+
+// Stop stop dip service.
+func (d *Dip) Stop() {
+	if d.rewardAddress == nil {
+		return
+	}
+	logging.CLog().Info("Stopping Dip...")
+	d.quitCh <- true
+}
+
+// RewardAddress return the address paying the rewards, nil if dip is not enabled in genesis.
+func (d *Dip) RewardAddress() *core.Address {
+	return d.rewardAddress
+}
+
+// RewardValue return the total reward of a period.
+func (d *Dip) RewardValue() *util.Uint128 {
+	return d.rewardValue
+}
+
+// PeriodRange return the first and the last block height of period, both inclusive.
+func (d *Dip) PeriodRange(period uint64) (uint64, uint64) {
+	return d.nr.PeriodRange(period)
+}
+
+// LatestPeriod return the latest computed period, 0 if none.
+func (d *Dip) LatestPeriod() (uint64, error) {
+	value, err := d.storage.Get(latestKey)
+	if err != nil {
+		if err == storage.ErrKeyNotFound {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return byteutils.Uint64(value), nil
+}
+
+// GetDipData return the rewards of period, the latest one if period is 0.
+func (d *Dip) GetDipData(period uint64) (*dippb.DipData, error) {
+	if d.rewardAddress == nil {
+		return nil, core.ErrDipNotEnabled
+	}
+	if period == 0 {
+		latest, err := d.LatestPeriod()
+		if err != nil {
+			return nil, err
+		}
+		if latest == 0 {
+			return nil, ErrDipNotFound
+		}
+		period = latest
+	}
+	value, err := d.storage.Get(byteutils.FromUint64(period))
+	if err != nil {
+		if err == storage.ErrKeyNotFound {
+			return nil, ErrDipNotFound
+		}
+		return nil, err
+	}
+	data := new(dippb.DipData)
+	if err := proto.Unmarshal(value, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// Rewards return the rewards of period to pay in block, computed from the ancestors of block.
+func (d *Dip) Rewards(period uint64, block *core.Block) ([]*core.DipReward, error) {
+	if d.rewardAddress == nil {
+		return nil, core.ErrDipNotEnabled
+	}
+	if period == 0 {
+		return nil, nr.ErrInvalidPeriod
+	}
+	_, end := d.PeriodRange(period)
+	if block.Height() <= end {
+		return nil, ErrPeriodNotFinished
+	}
+	endBlock := block
+	for endBlock.Height() > end {
+		if endBlock = d.chain.GetBlock(endBlock.ParentHash()); endBlock == nil {
+			return nil, ErrMissingPeriodBlocks
+		}
+	}
+	data, err := d.ComputeByEndBlock(period, endBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	rewards := []*core.DipReward{}
+	for _, item := range data.Items {
+		value, err := util.NewUint128FromString(item.Reward)
+		if err != nil {
+			return nil, err
+		}
+		if value.Cmp(util.NewUint128()) == 0 {
+			continue
+		}
+		contract, err := core.AddressParse(item.Contract)
+		if err != nil {
+			return nil, err
+		}
+		deployer, err := core.AddressParse(item.Deployer)
+		if err != nil {
+			return nil, err
+		}
+		rewards = append(rewards, &core.DipReward{
+			Contract: contract,
+			Deployer: deployer,
+			Value:    value,
+		})
+	}
+	return rewards, nil
+}
+
+// Compute the rewards of period from the irreversible blocks on canonical chain.
+func (d *Dip) Compute(period uint64) (*dippb.DipData, error) {
+	if period == 0 {
+		return nil, nr.ErrInvalidPeriod
+	}
+	_, end := d.PeriodRange(period)
+	if end > d.chain.LIB().Height() {
+		return nil, ErrPeriodNotReady
+	}
+	endBlock := d.chain.GetBlockOnCanonicalChainByHeight(end)
+	if endBlock == nil {
+		return nil, ErrMissingPeriodBlocks
+	}
+	return d.ComputeByEndBlock(period, endBlock)
+}
+
+// ComputeByEndBlock compute the rewards of period from endBlock and its ancestors,
+// endBlock should be the last block of period.
+func (d *Dip) ComputeByEndBlock(period uint64, endBlock *core.Block) (*dippb.DipData, error) {
+	key := endBlock.Hash().Hex()
+	if v, ok := d.cache.Get(key); ok {
+		return v.(*dippb.DipData), nil
+	}
+
+	ranks, err := d.nr.ComputeByEndBlock(period, endBlock)
+	if err != nil {
+		return nil, err
+	}
+	start, end := d.PeriodRange(period)
+	u := newUsage()
+	block := endBlock
+	for {
+		if err := u.collect(block); err != nil {
+			return nil, err
+		}
+		if block.Height() == start {
+			break
+		}
+		if block = d.chain.GetBlock(block.ParentHash()); block == nil {
+			return nil, ErrMissingPeriodBlocks
+		}
+	}
+	items, err := u.distribute(endBlock, ranks, d.rewardValue)
+	if err != nil {
+		return nil, err
+	}
+	data := &dippb.DipData{
+		Period:      period,
+		StartHeight: start,
+		EndHeight:   end,
+		EndBlock:    endBlock.Hash(),
+		RewardValue: d.rewardValue.String(),
+		Items:       items,
+	}
+	d.cache.Add(key, data)
+	return data, nil
+}
+
+// update compute and store the periods whose blocks turned irreversible,
+// and submit the reward tx of the next period if mining.
+func (d *Dip) update() error {
+	latest, err := d.LatestPeriod()
+	if err != nil {
+		return err
+	}
+	for period := latest + 1; ; period++ {
+		if _, end := d.PeriodRange(period); end > d.chain.LIB().Height() {
+			break
+		}
+		data, err := d.Compute(period)
+		if err != nil {
+			return err
+		}
+		value, err := proto.Marshal(data)
+		if err != nil {
+			return err
+		}
+		if err := d.storage.Put(byteutils.FromUint64(period), value); err != nil {
+			return err
+		}
+		if err := d.storage.Put(latestKey, byteutils.FromUint64(period)); err != nil {
+			return err
+		}
+		logging.VLog().WithFields(logrus.Fields{
+			"period": period,
+			"start":  data.StartHeight,
+			"end":    data.EndHeight,
+			"count":  len(data.Items),
+		}).Info("Computed dip rewards.")
+	}
+	return d.submit()
+}
+
+// submit push the reward tx of the next unpaid period from the miner into the local pool only,
+// it's valid in the blocks proposed by the miner. It's submitted again if dropped from the pool.
+func (d *Dip) submit() error {
+	chainConfig := d.neb.Config().Chain
+	if !d.neb.Consensus().Enable() || chainConfig.EnableRemoteSignServer {
+		return nil
+	}
+	tail := d.chain.TailBlock()
+	// the dip tx is not packed before the payload is available.
+	if tail.Height()+1 < core.DipAvailableHeight {
+		return nil
+	}
+	rewarded, err := core.RewardedDipPeriod(tail, d.rewardAddress)
+	if err != nil {
+		return err
+	}
+	period := rewarded + 1
+	if _, end := d.PeriodRange(period); end > d.chain.LIB().Height() {
+		return nil
+	}
+	if period <= d.submitted && d.chain.TransactionPool().GetTransaction(d.submittedTx) != nil {
+		return nil
+	}
+	pool, err := tail.GetAccount(d.rewardAddress.Bytes())
+	if err != nil {
+		return err
+	}
+	if pool.Balance().Cmp(d.rewardValue) < 0 {
+		return core.ErrInsufficientDipPool
+	}
+
+	miner, err := core.AddressParse(chainConfig.Miner)
+	if err != nil {
+		return err
+	}
+	payload, err := core.NewDipPayload(period)
+	if err != nil {
+		return err
+	}
+	data, err := payload.ToBytes()
+	if err != nil {
+		return err
+	}
+	acc, err := tail.GetAccount(miner.Bytes())
+	if err != nil {
+		return err
+	}
+	nonce := d.chain.TransactionPool().NextNonce(miner, acc.Nonce())
+	tx, err := core.NewTransaction(d.chain.ChainID(), miner, miner, util.NewUint128(), nonce,
+		core.TxPayloadDipType, data, core.TransactionGasPrice, dipGasLimit)
+	if err != nil {
+		return err
+	}
+	if err := d.neb.AccountManager().SignTransaction(miner, tx); err != nil {
+		return err
+	}
+	if err := d.chain.TransactionPool().Push(tx); err != nil {
+		return err
+	}
+	d.submitted = period
+	d.submittedTx = tx.Hash()
+
+	logging.VLog().WithFields(logrus.Fields{
+		"period": period,
+		"tx":     tx,
+	}).Info("Submitted dip reward tx.")
+	return nil
+}
+
+func (d *Dip) loop() {
+	logging.CLog().Info("Started Dip.")
+	timeChan := time.NewTicker(updateInterval).C
+	for {
+		select {
+		case <-timeChan:
+			if err := d.update(); err != nil {
+				logging.VLog().WithFields(logrus.Fields{
+					"err": err,
+				}).Debug("Failed to update dip rewards.")
+			}
+		case <-d.quitCh:
+			logging.CLog().Info("Stopped Dip.")
+			return
+		}
+	}
+}
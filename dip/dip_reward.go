@@ -0,0 +1,145 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package dip
+
+import (
+	"encoding/json"
+	"math/big"
+	"sort"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/dip/pb"
+	"github.com/nebulasio/go-nebulas/nr/pb"
+	"github.com/nebulasio/go-nebulas/util"
+)
+
+// usage the distinct callers of the contracts in a period.
+type usage struct {
+	callers map[string]map[string]bool // contract -> caller -> true
+}
+
+func newUsage() *usage {
+	return &usage{
+		callers: make(map[string]map[string]bool),
+	}
+}
+
+// collect the callers of the succeeded contract call txs in block.
+func (u *usage) collect(block *core.Block) error {
+	for _, tx := range block.Transactions() {
+		if tx.Type() != core.TxPayloadCallType || tx.To().Type() != core.ContractAddress {
+			continue
+		}
+		result, err := block.FetchExecutionResultEvent(tx.Hash())
+		if err != nil {
+			return err
+		}
+		txEvent := new(core.TransactionEvent)
+		if err := json.Unmarshal([]byte(result.Data), txEvent); err != nil {
+			return err
+		}
+		if txEvent.Status != core.TxExecutionSuccess {
+			continue
+		}
+
+		contract := tx.To().String()
+		if _, ok := u.callers[contract]; !ok {
+			u.callers[contract] = make(map[string]bool)
+		}
+		u.callers[contract][tx.From().String()] = true
+	}
+	return nil
+}
+
+// distribute weight the contracts by the nr score of their callers, and share the reward
+// value among the deployers in proportion to the weight. The weights are summed in
+// address order and the shares are computed exactly, so the result is reproducible.
+func (u *usage) distribute(end *core.Block, ranks *nrpb.NRData, value *util.Uint128) ([]*dippb.DipItem, error) {
+	scores := make(map[string]float64, len(ranks.Nrs))
+	for _, item := range ranks.Nrs {
+		scores[item.Address] = item.Score
+	}
+
+	contracts := make([]string, 0, len(u.callers))
+	for contract := range u.callers {
+		contracts = append(contracts, contract)
+	}
+	sort.Strings(contracts)
+
+	items := make([]*dippb.DipItem, 0, len(contracts))
+	total := new(big.Rat)
+	for _, contract := range contracts {
+		callers := make([]string, 0, len(u.callers[contract]))
+		for caller := range u.callers[contract] {
+			callers = append(callers, caller)
+		}
+		sort.Strings(callers)
+		weight := 0.0
+		for _, caller := range callers {
+			weight += scores[caller]
+		}
+
+		deployer, err := deployerOf(end, contract)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, &dippb.DipItem{
+			Contract: contract,
+			Deployer: deployer.String(),
+			Callers:  uint64(len(callers)),
+			Weight:   weight,
+		})
+		total.Add(total, new(big.Rat).SetFloat64(weight))
+	}
+
+	amount := new(big.Rat).SetInt(new(big.Int).SetBytes(value.Bytes()))
+	for _, item := range items {
+		reward := new(big.Int)
+		if total.Sign() > 0 {
+			share := new(big.Rat).Mul(amount, new(big.Rat).SetFloat64(item.Weight))
+			share.Quo(share, total)
+			reward.Quo(share.Num(), share.Denom())
+		}
+		item.Reward = reward.String()
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Weight > items[j].Weight
+	})
+	return items, nil
+}
+
+// deployerOf return the sender of the tx deploying contract.
+func deployerOf(block *core.Block, contract string) (*core.Address, error) {
+	addr, err := core.AddressParse(contract)
+	if err != nil {
+		return nil, err
+	}
+	acc, err := block.GetAccount(addr.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	if len(acc.BirthPlace()) == 0 {
+		return nil, ErrMissingContractOrigin
+	}
+	tx, err := block.GetTransaction(acc.BirthPlace())
+	if err != nil {
+		return nil, err
+	}
+	return tx.From(), nil
+}
@@ -0,0 +1,399 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package dip
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/account"
+	"github.com/nebulasio/go-nebulas/consensus/dev"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/nebulasio/go-nebulas/nr"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testMiner    = "n1FF1nz6tarkDVwWQkMnnwFPuPKUaQTdptE"
+	testDeployer = "n1GmkKH6nBMw4rrjt16RrJ9WcgvKUtAZP1s"
+	testCaller   = "n1H4MYms9F55ehcvygwWE71J8tJC4CRr2so"
+
+	testRewardValue = "1000000000000000000"
+
+	testContract = `"use strict";
+var Counter = function() {};
+Counter.prototype = {
+	init: function() {},
+	inc: function() {
+		return 1;
+	}
+};
+module.exports = Counter;`
+)
+
+var (
+	testPool = core.DipRewardAddress.String()
+)
+
+type Neb struct {
+	config    *nebletpb.Config
+	chain     *core.BlockChain
+	ns        net.Service
+	am        *account.Manager
+	genesis   *corepb.Genesis
+	storage   storage.Storage
+	consensus core.Consensus
+	emitter   *core.EventEmitter
+	nvm       core.NVM
+	dip       *Dip
+}
+
+func mockNeb(t *testing.T) *Neb {
+	storage, _ := storage.NewMemoryStorage()
+	eventEmitter := core.NewEventEmitter(1024)
+	consensus := dev.NewDev()
+	neb := &Neb{
+		genesis: &corepb.Genesis{
+			Meta: &corepb.GenesisMeta{ChainId: 0},
+			Consensus: &corepb.GenesisConsensus{
+				Engine: &corepb.GenesisConsensus_Dpos{
+					Dpos: &corepb.GenesisConsensusDpos{
+						Dynasty: []string{testMiner},
+					},
+				},
+			},
+			TokenDistribution: []*corepb.GenesisTokenDistribution{
+				&corepb.GenesisTokenDistribution{
+					Address: testMiner,
+					Value:   "5000000000000000000000000",
+				},
+				&corepb.GenesisTokenDistribution{
+					Address: testPool,
+					Value:   testRewardValue,
+				},
+			},
+			Nr:  &corepb.GenesisNr{Window: 3},
+			Dip: &corepb.GenesisDip{RewardValue: testRewardValue},
+		},
+		storage:   storage,
+		emitter:   eventEmitter,
+		consensus: consensus,
+		nvm:       nvm.NewNebulasVM(),
+		config: &nebletpb.Config{
+			Chain: &nebletpb.ChainConfig{
+				ChainId:    0,
+				Keydir:     "keydir",
+				StartMine:  true,
+				Miner:      testMiner,
+				Passphrase: "passphrase",
+				Consensus:  "dev",
+			},
+		},
+		ns: mockNetService{},
+	}
+
+	am, _ := account.NewManager(neb)
+	neb.am = am
+
+	chain, err := core.NewBlockChain(neb)
+	assert.Nil(t, err)
+	neb.chain = chain
+	ranker, err := nr.NewNR(neb)
+	assert.Nil(t, err)
+	neb.dip, err = NewDip(neb, ranker)
+	assert.Nil(t, err)
+	chain.SetDip(neb.dip)
+	assert.Nil(t, consensus.Setup(neb))
+	assert.Nil(t, chain.Setup(neb))
+	assert.Nil(t, consensus.EnableMining("passphrase"))
+	consensus.ResumeMining()
+
+	eventEmitter.Start()
+	return neb
+}
+
+func (n *Neb) Config() *nebletpb.Config {
+	return n.config
+}
+
+func (n *Neb) BlockChain() *core.BlockChain {
+	return n.chain
+}
+
+func (n *Neb) NetService() net.Service {
+	return n.ns
+}
+
+func (n *Neb) IsActiveSyncing() bool {
+	return true
+}
+
+func (n *Neb) AccountManager() core.AccountManager {
+	return n.am
+}
+
+func (n *Neb) Genesis() *corepb.Genesis {
+	return n.genesis
+}
+
+func (n *Neb) SetGenesis(genesis *corepb.Genesis) {
+	n.genesis = genesis
+}
+
+func (n *Neb) Storage() storage.Storage {
+	return n.storage
+}
+
+func (n *Neb) EventEmitter() *core.EventEmitter {
+	return n.emitter
+}
+
+func (n *Neb) Consensus() core.Consensus {
+	return n.consensus
+}
+
+func (n *Neb) Nvm() core.NVM {
+	return n.nvm
+}
+
+func (n *Neb) StartActiveSync() {}
+
+func (n *Neb) StartPprof(string) error { return nil }
+
+type mockNetService struct{}
+
+func (n mockNetService) Start() error { return nil }
+func (n mockNetService) Stop()        {}
+
+func (n mockNetService) Node() *net.Node { return nil }
+
+func (n mockNetService) Sync(net.Serializable) error { return nil }
+
+func (n mockNetService) Register(...*net.Subscriber)   {}
+func (n mockNetService) Deregister(...*net.Subscriber) {}
+
+func (n mockNetService) Broadcast(name string, msg net.Serializable, priority int) {}
+func (n mockNetService) Relay(name string, msg net.Serializable, priority int)     {}
+func (n mockNetService) SendMsg(name string, msg []byte, target string, priority int) error {
+	return nil
+}
+
+func (n mockNetService) SendMessageToPeers(messageName string, data []byte, priority int, filter net.PeerFilterAlgorithm) []string {
+	return make([]string, 0)
+}
+func (n mockNetService) SendMessageToPeer(messageName string, data []byte, priority int, peerID string) error {
+	return nil
+}
+
+func (n mockNetService) ClosePeer(peerID string, reason error) {}
+
+func (n mockNetService) BroadcastNetworkID([]byte) {}
+
+func mockTx(t *testing.T, neb *Neb, from, to string, value uint64, nonce uint64, payloadType string, payload []byte) *core.Transaction {
+	fromAddr, err := core.AddressParse(from)
+	assert.Nil(t, err)
+	toAddr, err := core.AddressParse(to)
+	assert.Nil(t, err)
+	assert.Nil(t, neb.am.Unlock(fromAddr, []byte("passphrase"), time.Minute))
+	tx, err := core.NewTransaction(neb.chain.ChainID(), fromAddr, toAddr, util.NewUint128FromUint(value), nonce, payloadType, payload, core.TransactionGasPrice, core.TransactionMaxGas)
+	assert.Nil(t, err)
+	assert.Nil(t, neb.am.SignTransaction(fromAddr, tx))
+	assert.Nil(t, neb.chain.TransactionPool().Push(tx))
+	return tx
+}
+
+func mintBlock(t *testing.T, neb *Neb) *core.Block {
+	block, err := neb.consensus.(*dev.Dev).MintBlock()
+	assert.Nil(t, err)
+	return block
+}
+
+func txSucceeded(t *testing.T, block *core.Block, tx *core.Transaction) bool {
+	result, err := block.FetchExecutionResultEvent(tx.Hash())
+	assert.Nil(t, err)
+	txEvent := new(core.TransactionEvent)
+	assert.Nil(t, json.Unmarshal([]byte(result.Data), txEvent))
+	return txEvent.Status == core.TxExecutionSuccess
+}
+
+func balanceOf(t *testing.T, block *core.Block, address string) *util.Uint128 {
+	addr, err := core.AddressParse(address)
+	assert.Nil(t, err)
+	acc, err := block.GetAccount(addr.Bytes())
+	assert.Nil(t, err)
+	return acc.Balance()
+}
+
+// mockPeriod mint the blocks of period 1, the deployer deploys a contract called by
+// the caller, who is ranked by transfers with the miner.
+func mockPeriod(t *testing.T, neb *Neb) (*core.Address, *core.Block) {
+	mockTx(t, neb, testMiner, testDeployer, 1000000000000000000, 1, core.TxPayloadBinaryType, nil)
+	mockTx(t, neb, testMiner, testCaller, 1000000000000000000, 2, core.TxPayloadBinaryType, nil)
+	mintBlock(t, neb)
+
+	deploy, err := core.NewDeployPayload(testContract, core.SourceTypeJavaScript, "")
+	assert.Nil(t, err)
+	data, err := deploy.ToBytes()
+	assert.Nil(t, err)
+	deployTx := mockTx(t, neb, testDeployer, testDeployer, 0, 1, core.TxPayloadDeployType, data)
+	mintBlock(t, neb)
+	contract, err := deployTx.GenerateContractAddress()
+	assert.Nil(t, err)
+
+	call, err := core.NewCallPayload("inc", "")
+	assert.Nil(t, err)
+	data, err = call.ToBytes()
+	assert.Nil(t, err)
+	mockTx(t, neb, testCaller, contract.String(), 0, 1, core.TxPayloadCallType, data)
+	mockTx(t, neb, testCaller, testMiner, 1000, 2, core.TxPayloadBinaryType, nil)
+	end := mintBlock(t, neb)
+	assert.Equal(t, uint64(4), end.Height())
+	return contract, end
+}
+
+func TestComputeRewards(t *testing.T) {
+	neb := mockNeb(t)
+	d := neb.dip
+	assert.Equal(t, testPool, d.RewardAddress().String())
+
+	_, err := d.Compute(1)
+	assert.Equal(t, ErrPeriodNotReady, err)
+
+	contract, end := mockPeriod(t, neb)
+	_, err = d.Rewards(1, end)
+	assert.Equal(t, ErrPeriodNotFinished, err)
+
+	data, err := d.Compute(1)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2), data.StartHeight)
+	assert.Equal(t, uint64(4), data.EndHeight)
+	assert.Equal(t, testRewardValue, data.RewardValue)
+	assert.Equal(t, 1, len(data.Items))
+	item := data.Items[0]
+	assert.Equal(t, contract.String(), item.Contract)
+	assert.Equal(t, testDeployer, item.Deployer)
+	assert.Equal(t, uint64(1), item.Callers)
+	assert.True(t, item.Weight > 0)
+	// the only contract takes the whole reward.
+	assert.Equal(t, testRewardValue, item.Reward)
+
+	next := mintBlock(t, neb)
+	rewards, err := d.Rewards(1, next)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(rewards))
+	assert.Equal(t, testDeployer, rewards[0].Deployer.String())
+	assert.Equal(t, testRewardValue, rewards[0].Value.String())
+}
+
+func TestPayRewards(t *testing.T) {
+	height := core.DipAvailableHeight
+	core.DipAvailableHeight = core.LocalDipAvailableHeight
+	defer func() { core.DipAvailableHeight = height }()
+
+	neb := mockNeb(t)
+	pool, err := core.AddressParse(testPool)
+	assert.Nil(t, err)
+	dipData := func(period uint64) []byte {
+		payload, err := core.NewDipPayload(period)
+		assert.Nil(t, err)
+		data, err := payload.ToBytes()
+		assert.Nil(t, err)
+		return data
+	}
+
+	_, end := mockPeriod(t, neb)
+	deployerBalance := balanceOf(t, end, testDeployer)
+	poolBalance := balanceOf(t, end, testPool)
+
+	// the dip tx is only packed by the proposer of block.
+	other := mockTx(t, neb, testCaller, testCaller, 0, 3, core.TxPayloadDipType, dipData(1))
+	block := mintBlock(t, neb)
+	assert.Equal(t, 0, len(block.Transactions()))
+	neb.chain.TransactionPool().Del(other)
+
+	// the rewards are paid from the keyless pool, and a block packs at most one dip tx.
+	tx := mockTx(t, neb, testMiner, testMiner, 0, 3, core.TxPayloadDipType, dipData(1))
+	duplicated := mockTx(t, neb, testMiner, testMiner, 0, 4, core.TxPayloadDipType, dipData(2))
+	block = mintBlock(t, neb)
+	assert.Equal(t, 1, len(block.Transactions()))
+	assert.True(t, txSucceeded(t, block, tx))
+	assert.Nil(t, neb.chain.TransactionPool().GetTransaction(duplicated.Hash()))
+	reward, _ := util.NewUint128FromString(testRewardValue)
+	expected, _ := deployerBalance.Add(reward)
+	assert.Equal(t, expected, balanceOf(t, block, testDeployer))
+	expected, _ = poolBalance.Sub(reward)
+	assert.Equal(t, expected, balanceOf(t, block, testPool))
+	period, err := core.RewardedDipPeriod(block, pool)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), period)
+
+	// each period is paid only once.
+	mockTx(t, neb, testMiner, testMiner, 0, 4, core.TxPayloadDipType, dipData(1))
+	block = mintBlock(t, neb)
+	assert.Equal(t, 0, len(block.Transactions()))
+	assert.Equal(t, uint64(7), block.Height())
+
+	// the period is not paid until the pool is funded.
+	mockTx(t, neb, testMiner, testMiner, 0, 4, core.TxPayloadDipType, dipData(2))
+	block = mintBlock(t, neb)
+	assert.Equal(t, 0, len(block.Transactions()))
+	mockTx(t, neb, testMiner, testPool, 1000000000000000000, 4, core.TxPayloadBinaryType, nil)
+	mintBlock(t, neb)
+	tx = mockTx(t, neb, testMiner, testMiner, 0, 5, core.TxPayloadDipType, dipData(2))
+	block = mintBlock(t, neb)
+	assert.Equal(t, 1, len(block.Transactions()))
+	assert.True(t, txSucceeded(t, block, tx))
+	period, err = core.RewardedDipPeriod(block, pool)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2), period)
+}
+
+func TestUpdate(t *testing.T) {
+	height := core.DipAvailableHeight
+	core.DipAvailableHeight = core.LocalDipAvailableHeight
+	defer func() { core.DipAvailableHeight = height }()
+
+	neb := mockNeb(t)
+	d := neb.dip
+
+	_, err := d.GetDipData(0)
+	assert.Equal(t, ErrDipNotFound, err)
+
+	mockPeriod(t, neb)
+	assert.Nil(t, d.update())
+	data, err := d.GetDipData(0)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), data.Period)
+	assert.Equal(t, 1, len(data.Items))
+	assert.Equal(t, testDeployer, data.Items[0].Deployer)
+
+	// the miner submits the reward tx of the period.
+	assert.Equal(t, uint64(1), d.submitted)
+	block := mintBlock(t, neb)
+	assert.Equal(t, 1, len(block.Transactions()))
+	assert.Equal(t, core.TxPayloadDipType, block.Transactions()[0].Type())
+	assert.True(t, txSucceeded(t, block, block.Transactions()[0]))
+}
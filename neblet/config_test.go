@@ -74,8 +74,10 @@ func MockGenesisConf() *corepb.Genesis {
 	return &corepb.Genesis{
 		Meta: &corepb.GenesisMeta{ChainId: 100},
 		Consensus: &corepb.GenesisConsensus{
-			Dpos: &corepb.GenesisConsensusDpos{
-				Dynasty: MockDynasty,
+			Engine: &corepb.GenesisConsensus_Dpos{
+				Dpos: &corepb.GenesisConsensusDpos{
+					Dynasty: MockDynasty,
+				},
 			},
 		},
 		TokenDistribution: []*corepb.GenesisTokenDistribution{
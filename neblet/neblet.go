@@ -20,13 +20,19 @@ import (
 	"net"
 
 	"github.com/nebulasio/go-nebulas/account"
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/consensus/dev"
 	"github.com/nebulasio/go-nebulas/consensus/dpos"
+	"github.com/nebulasio/go-nebulas/consensus/poa"
+	"github.com/nebulasio/go-nebulas/consensus/pod"
 	"github.com/nebulasio/go-nebulas/core"
 	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/dip"
 	"github.com/nebulasio/go-nebulas/metrics"
 	"github.com/nebulasio/go-nebulas/neblet/pb"
 	nebnet "github.com/nebulasio/go-nebulas/net"
 	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/nebulasio/go-nebulas/nr"
 	"github.com/nebulasio/go-nebulas/rpc"
 	"github.com/nebulasio/go-nebulas/storage"
 	nsync "github.com/nebulasio/go-nebulas/sync"
@@ -40,6 +46,17 @@ var (
 
 	// ErrIncompatibleStorageSchemeVersion throws when the storage schema has been changed
 	ErrIncompatibleStorageSchemeVersion = errors.New("incompatible storage schema version, pls migrate your storage")
+
+	// ErrUnknownConsensus throws when the consensus engine in config is not supported
+	ErrUnknownConsensus = errors.New("unknown consensus engine")
+)
+
+// Consensus engines
+const (
+	DposConsensus = "dpos"
+	PoaConsensus  = "poa"
+	PodConsensus  = "pod"
+	DevConsensus  = "dev"
 )
 
 var (
@@ -64,6 +81,10 @@ type Neblet struct {
 
 	syncService *nsync.Service
 
+	nr *nr.NR
+
+	dip *dip.Dip
+
 	rpcServer rpc.GRPCServer
 
 	lock sync.RWMutex
@@ -113,14 +134,30 @@ func (n *Neblet) Setup() {
 	logging.CLog().Info("Setuping Neblet...")
 
 	// storage
-	// n.storage, err = storage.NewDiskStorage(n.config.Chain.Datadir)
-	// n.storage, err = storage.NewMemoryStorage()
-	n.storage, err = storage.NewRocksStorage(n.config.Chain.Datadir)
+	n.storage, err = storage.NewStorage(n.config.Chain.Storage, n.config.Chain.Datadir)
 	if err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"dir":     n.config.Chain.Datadir,
+			"backend": n.config.Chain.Storage,
+			"err":     err,
+		}).Fatal("Failed to open disk storage.")
+	}
+	if err = core.CheckStorageScheme(n.storage); err != nil {
+		if err == core.ErrIncompatibleStorageScheme {
+			err = ErrIncompatibleStorageSchemeVersion
+		}
 		logging.CLog().WithFields(logrus.Fields{
 			"dir": n.config.Chain.Datadir,
 			"err": err,
-		}).Fatal("Failed to open disk storage.")
+		}).Fatal("Failed to check storage scheme.")
+	}
+
+	// trie cache
+	if err = trie.SetNodeCacheSize(int(n.config.Chain.TrieCacheSize)); err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"size": n.config.Chain.TrieCacheSize,
+			"err":  err,
+		}).Fatal("Failed to setup trie cache.")
 	}
 
 	// net
@@ -138,9 +175,30 @@ func (n *Neblet) Setup() {
 			"err": err,
 		}).Fatal("Failed to setup V8.")
 	}
+	if size := n.config.Chain.NvmPoolSize; size > 0 {
+		if err = nvm.SetEnginePoolSize(int(size)); err != nil {
+			logging.CLog().WithFields(logrus.Fields{
+				"size": size,
+				"err":  err,
+			}).Fatal("Failed to setup V8 engine pool.")
+		}
+	}
+	if n.config.Chain.NvmCodeCache {
+		if err = nvm.EnableCodeCache(n.storage); err != nil {
+			logging.CLog().WithFields(logrus.Fields{
+				"err": err,
+			}).Fatal("Failed to setup V8 code cache.")
+		}
+	}
 	// core
 	n.eventEmitter = core.NewEventEmitter(40960)
-	n.consensus = dpos.NewDpos()
+	n.consensus, err = newConsensus(n.config.Chain.Consensus, n.genesis)
+	if err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"consensus": n.config.Chain.Consensus,
+			"err":       err,
+		}).Fatal("Failed to create consensus.")
+	}
 	n.blockChain, err = core.NewBlockChain(n)
 	if err != nil {
 		logging.CLog().WithFields(logrus.Fields{
@@ -148,6 +206,32 @@ func (n *Neblet) Setup() {
 		}).Fatal("Failed to setup blockchain.")
 	}
 
+	// nr & dip, dip should be set before loading blocks.
+	n.nr, err = nr.NewNR(n)
+	if err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Fatal("Failed to setup nr.")
+	}
+	n.dip, err = dip.NewDip(n, n.nr)
+	if err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Fatal("Failed to setup dip.")
+	}
+	n.blockChain.SetDip(n.dip)
+
+	// pod elects the dynasty by nr, the ranker should be set before loading blocks.
+	if engine, ok := n.consensus.(*pod.Pod); ok {
+		ranker, err := nr.NewRanker(n.nr)
+		if err != nil {
+			logging.CLog().WithFields(logrus.Fields{
+				"err": err,
+			}).Fatal("Failed to setup nr ranker.")
+		}
+		engine.SetRanker(ranker)
+	}
+
 	// consensus
 	if err := n.consensus.Setup(n); err != nil {
 		logging.CLog().WithFields(logrus.Fields{
@@ -170,6 +254,31 @@ func (n *Neblet) Setup() {
 	logging.CLog().Info("Setuped Neblet.")
 }
 
+// newConsensus create the consensus engine by name, if name is empty the engine
+// configured in genesis is used.
+func newConsensus(name string, genesis *corepb.Genesis) (core.Consensus, error) {
+	if name == "" {
+		name = DposConsensus
+		if genesis.GetConsensus().GetPoa() != nil {
+			name = PoaConsensus
+		}
+		if genesis.GetConsensus().GetPod() != nil {
+			name = PodConsensus
+		}
+	}
+	switch name {
+	case DposConsensus:
+		return dpos.NewDpos(), nil
+	case PoaConsensus:
+		return poa.NewPoa(), nil
+	case PodConsensus:
+		return pod.NewPod(), nil
+	case DevConsensus:
+		return dev.NewDev(), nil
+	}
+	return nil, ErrUnknownConsensus
+}
+
 // StartPprof start pprof http listen
 func (n *Neblet) StartPprof(listen string) error {
 	if len(listen) > 0 {
@@ -234,6 +343,8 @@ func (n *Neblet) Start() {
 	n.blockChain.TransactionPool().Start()
 	n.eventEmitter.Start()
 	n.syncService.Start()
+	n.nr.Start()
+	n.dip.Start()
 
 	// start consensus
 	chainConf := n.config.Chain
@@ -286,6 +397,16 @@ func (n *Neblet) Stop() {
 		n.consensus = nil
 	}
 
+	if n.dip != nil {
+		n.dip.Stop()
+		n.dip = nil
+	}
+
+	if n.nr != nil {
+		n.nr.Stop()
+		n.nr = nil
+	}
+
 	if n.syncService != nil {
 		n.syncService.Stop()
 		n.syncService = nil
@@ -374,6 +495,16 @@ func (n *Neblet) SyncService() *nsync.Service {
 	return n.syncService
 }
 
+// NR return the nebulas rank service
+func (n *Neblet) NR() *nr.NR {
+	return n.nr
+}
+
+// Dip return the developer incentive protocol service
+func (n *Neblet) Dip() *dip.Dip {
+	return n.dip
+}
+
 // IsActiveSyncing return if the neb is syncing blocks
 func (n *Neblet) IsActiveSyncing() bool {
 	if n.syncService == nil {
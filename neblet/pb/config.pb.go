@@ -208,6 +208,20 @@ type ChainConfig struct {
 	SignatureCiphers   []string `protobuf:"bytes,28,rep,name=signature_ciphers,json=signatureCiphers" json:"signature_ciphers"`
 	SuperNode          bool     `protobuf:"varint,30,opt,name=super_node,json=superNode,proto3" json:"super_node"`
 	UnsupportedKeyword string   `protobuf:"bytes,31,opt,name=unsupported_keyword,json=unsupportedKeyword,proto3" json:"unsupported_keyword"`
+	// Count of trie nodes cached in memory, 0 disables the cache.
+	TrieCacheSize uint32 `protobuf:"varint,32,opt,name=trie_cache_size,json=trieCacheSize,proto3" json:"trie_cache_size"`
+	// Storage backend of data dir, "rocksdb", "leveldb", "boltdb" or "memory", default "rocksdb".
+	Storage string `protobuf:"bytes,33,opt,name=storage,proto3" json:"storage"`
+	// Consensus engine, "dpos", "poa", "pod" or "dev", default the engine of genesis.
+	Consensus string `protobuf:"bytes,34,opt,name=consensus,proto3" json:"consensus"`
+	// Consecutive missed slots of the primary miner before a standby node takes over mining.
+	// The node is the primary if 0.
+	StandbySlots uint32 `protobuf:"varint,38,opt,name=standby_slots,json=standbySlots,proto3" json:"standby_slots"`
+	// Count of warmed V8 isolates pooled for Call and EstimateGas, 0 disables the pool.
+	// Block execution never uses the pool.
+	NvmPoolSize uint32 `protobuf:"varint,39,opt,name=nvm_pool_size,json=nvmPoolSize,proto3" json:"nvm_pool_size"`
+	// Cache the compiled code of contracts in the node-local storage, the cache is out of consensus state.
+	NvmCodeCache bool `protobuf:"varint,40,opt,name=nvm_code_cache,json=nvmCodeCache,proto3" json:"nvm_code_cache"`
 }
 
 func (m *ChainConfig) Reset()                    { *m = ChainConfig{} }
@@ -320,6 +334,48 @@ func (m *ChainConfig) GetUnsupportedKeyword() string {
 	return ""
 }
 
+func (m *ChainConfig) GetTrieCacheSize() uint32 {
+	if m != nil {
+		return m.TrieCacheSize
+	}
+	return 0
+}
+
+func (m *ChainConfig) GetStorage() string {
+	if m != nil {
+		return m.Storage
+	}
+	return ""
+}
+
+func (m *ChainConfig) GetConsensus() string {
+	if m != nil {
+		return m.Consensus
+	}
+	return ""
+}
+
+func (m *ChainConfig) GetStandbySlots() uint32 {
+	if m != nil {
+		return m.StandbySlots
+	}
+	return 0
+}
+
+func (m *ChainConfig) GetNvmPoolSize() uint32 {
+	if m != nil {
+		return m.NvmPoolSize
+	}
+	return 0
+}
+
+func (m *ChainConfig) GetNvmCodeCache() bool {
+	if m != nil {
+		return m.NvmCodeCache
+	}
+	return false
+}
+
 type RPCConfig struct {
 	// RPC listen addresses.
 	RpcListen []string `protobuf:"bytes,1,rep,name=rpc_listen,json=rpcListen" json:"rpc_listen"`
@@ -609,68 +665,75 @@ func init() {
 func init() { proto.RegisterFile("config.proto", fileDescriptorConfig) }
 
 var fileDescriptorConfig = []byte{
-	// 1002 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x56, 0x4f, 0x6f, 0x1b, 0xb7,
-	0x13, 0xfd, 0x49, 0xfe, 0x13, 0xed, 0x48, 0x76, 0x14, 0xda, 0xb1, 0xe9, 0xf8, 0x57, 0xdb, 0x55,
-	0x61, 0x40, 0x40, 0x0a, 0x17, 0x75, 0x73, 0xe9, 0xa1, 0x87, 0x40, 0x40, 0x01, 0xc3, 0x76, 0x60,
-	0xac, 0xdb, 0xf3, 0x62, 0xb5, 0x3b, 0x5a, 0x11, 0x5e, 0x2d, 0x09, 0x92, 0x72, 0xe2, 0x5b, 0x2f,
-	0x3d, 0xf6, 0xeb, 0xb5, 0x9f, 0xa6, 0x40, 0x31, 0xb3, 0x5c, 0xfd, 0x43, 0x6e, 0x9c, 0xf7, 0x1e,
-	0x39, 0xcb, 0x99, 0xc7, 0x91, 0xa0, 0x97, 0xe9, 0x6a, 0xa2, 0x8a, 0x2b, 0x63, 0xb5, 0xd7, 0xa2,
-	0x53, 0xe1, 0xb8, 0x44, 0x6f, 0xc6, 0x83, 0xbf, 0xda, 0xb0, 0x3b, 0x62, 0x4a, 0xfc, 0x08, 0xaf,
-	0x2a, 0xf4, 0x9f, 0xb5, 0x7d, 0x92, 0xad, 0x8b, 0xd6, 0xb0, 0x7b, 0x7d, 0x7c, 0xd5, 0xc8, 0xae,
-	0x3e, 0xd5, 0x44, 0xad, 0x8c, 0x1b, 0x9d, 0x78, 0x0f, 0x3b, 0xd9, 0x34, 0x55, 0x95, 0x6c, 0xf3,
-	0x86, 0xb7, 0xcb, 0x0d, 0x23, 0x82, 0x83, 0xbc, 0xd6, 0x88, 0x4b, 0xd8, 0xb2, 0x26, 0x93, 0x5b,
-	0x2c, 0x3d, 0x58, 0x4a, 0xe3, 0x87, 0x51, 0x10, 0x12, 0x4f, 0x67, 0x3a, 0x9f, 0x7a, 0x27, 0xf3,
-	0xcd, 0x33, 0x1f, 0x09, 0x6e, 0xce, 0x64, 0x8d, 0x18, 0xc2, 0xf6, 0x4c, 0xb9, 0x4c, 0x22, 0x6b,
-	0x0f, 0x97, 0xda, 0x7b, 0xe5, 0xb2, 0x20, 0x65, 0x05, 0x65, 0x4f, 0x8d, 0x91, 0x93, 0xcd, 0xec,
-	0x1f, 0x8d, 0x69, 0xb2, 0xa7, 0xc6, 0x0c, 0xfe, 0x6e, 0xc1, 0xde, 0xda, 0x65, 0x85, 0x80, 0x6d,
-	0x87, 0x98, 0xcb, 0xd6, 0xc5, 0xd6, 0x30, 0x8a, 0x79, 0x2d, 0x8e, 0x60, 0xb7, 0x54, 0xce, 0x23,
-	0x5d, 0x9c, 0xd0, 0x10, 0x89, 0x73, 0xe8, 0x1a, 0xab, 0x9e, 0x53, 0x8f, 0xc9, 0x13, 0xbe, 0xf0,
-	0x55, 0xa3, 0x18, 0x02, 0x74, 0x8b, 0x2f, 0xe2, 0x1b, 0x80, 0x50, 0xbb, 0x44, 0xe5, 0x72, 0xfb,
-	0xa2, 0x35, 0xdc, 0x8b, 0xa3, 0x80, 0xdc, 0xe4, 0xe2, 0x3b, 0xd8, 0x73, 0xde, 0x62, 0x3a, 0x4b,
-	0x4a, 0x35, 0x53, 0xde, 0xc9, 0x9d, 0x8b, 0xd6, 0x70, 0x27, 0xee, 0xd5, 0xe0, 0x1d, 0x63, 0xe2,
-	0x03, 0x1c, 0x59, 0x74, 0x68, 0x9f, 0x31, 0x4f, 0xd6, 0xd5, 0xbb, 0xac, 0x3e, 0x6c, 0xd8, 0xc7,
-	0x95, 0x5d, 0x83, 0x3f, 0xb7, 0xa1, 0xbb, 0xd2, 0x14, 0x71, 0x02, 0x1d, 0x6e, 0x0b, 0x7d, 0x47,
-	0x8b, 0xbf, 0xe3, 0x15, 0xc7, 0x37, 0xb9, 0x90, 0xf0, 0xaa, 0xc0, 0x0a, 0x9d, 0x72, 0xdc, 0xd7,
-	0x28, 0x6e, 0x42, 0x62, 0xf2, 0xd4, 0xa7, 0xb9, 0xb2, 0xb2, 0x5b, 0x33, 0x21, 0xa4, 0x8a, 0x3c,
-	0xe1, 0x0b, 0x11, 0x3d, 0x26, 0x42, 0x44, 0x17, 0x76, 0x3e, 0xb5, 0x3e, 0x99, 0xa9, 0x0a, 0xe5,
-	0xe1, 0x45, 0x6b, 0xd8, 0x89, 0x23, 0x46, 0xee, 0x55, 0x85, 0xe2, 0x1d, 0x74, 0x32, 0xad, 0xaa,
-	0x71, 0xea, 0x50, 0xbe, 0xe5, 0x8d, 0x8b, 0x58, 0x1c, 0xc2, 0x0e, 0x6d, 0xb2, 0xf2, 0x88, 0x89,
-	0x3a, 0x10, 0x67, 0x00, 0x26, 0x75, 0xce, 0x4c, 0x2d, 0xed, 0x39, 0x0e, 0x15, 0x5e, 0x20, 0xe2,
-	0x67, 0x38, 0xc1, 0x2a, 0x1d, 0x97, 0x98, 0x58, 0x9c, 0x69, 0x8f, 0x89, 0x53, 0x45, 0x95, 0x70,
-	0x41, 0xac, 0x94, 0x9c, 0xff, 0xa8, 0x16, 0xc4, 0xcc, 0x3f, 0xaa, 0xa2, 0x7a, 0x64, 0x56, 0x7c,
-	0x0f, 0xe2, 0x2b, 0x7b, 0x4e, 0x38, 0x45, 0xdf, 0x6e, 0xaa, 0x4f, 0x21, 0x2a, 0x52, 0x97, 0x18,
-	0xab, 0x32, 0x94, 0xef, 0xea, 0x6f, 0x2f, 0x52, 0xf7, 0x40, 0x71, 0x43, 0x72, 0x5f, 0xe4, 0xe9,
-	0x82, 0xe4, 0x5e, 0x88, 0xf7, 0xf0, 0x86, 0x12, 0xa4, 0x7e, 0x6e, 0x31, 0xc9, 0x94, 0x99, 0xa2,
-	0x75, 0xf2, 0xff, 0x6c, 0xa4, 0xfe, 0x82, 0x18, 0xd5, 0x38, 0x17, 0x70, 0x6e, 0xd0, 0x26, 0x95,
-	0xce, 0x51, 0x9e, 0x85, 0x02, 0x12, 0xf2, 0x49, 0xe7, 0x28, 0x7e, 0x80, 0x83, 0x79, 0xe5, 0xe6,
-	0xc6, 0x68, 0xeb, 0x31, 0x27, 0xd7, 0x7d, 0xd6, 0x36, 0x97, 0xe7, 0x9c, 0x52, 0xac, 0x50, 0xb7,
-	0x35, 0x33, 0xf8, 0xa7, 0x05, 0xd1, 0xe2, 0xc5, 0xd1, 0xe9, 0xd6, 0x64, 0x49, 0x30, 0x73, 0x6d,
-	0xf1, 0xc8, 0x9a, 0xec, 0x6e, 0xe1, 0xe7, 0xa9, 0xf7, 0x26, 0x59, 0x33, 0x3b, 0x10, 0xb4, 0x21,
-	0x98, 0xe9, 0x7c, 0x5e, 0xa2, 0xdc, 0x5a, 0x0a, 0xee, 0x19, 0xa1, 0xbb, 0x66, 0xba, 0xaa, 0x30,
-	0xf3, 0x4a, 0x57, 0x8d, 0x4f, 0xb7, 0xd9, 0xa7, 0xfd, 0x25, 0x11, 0x9c, 0xbd, 0x4c, 0xb7, 0x62,
-	0xfe, 0x90, 0x8e, 0x05, 0xa7, 0x10, 0xb1, 0x20, 0xd3, 0x96, 0xdc, 0x4e, 0xc9, 0x3a, 0x04, 0x8c,
-	0xb4, 0x75, 0x83, 0x7f, 0x5b, 0x10, 0x2d, 0x5e, 0x33, 0x49, 0x4b, 0x5d, 0x24, 0x25, 0x3e, 0x63,
-	0xc9, 0x06, 0x8f, 0xe2, 0x4e, 0xa9, 0x8b, 0x3b, 0x8a, 0xc9, 0xfc, 0x44, 0x4e, 0x54, 0x89, 0x8d,
-	0xc5, 0x4b, 0x5d, 0xfc, 0xaa, 0x4a, 0x14, 0xc7, 0x40, 0xcb, 0x24, 0x2d, 0x90, 0x9f, 0xef, 0x5e,
-	0xbc, 0x5b, 0xea, 0xe2, 0x63, 0x81, 0xe2, 0x0a, 0x0e, 0x82, 0xb1, 0x32, 0x9b, 0xba, 0x69, 0x62,
-	0x91, 0x0a, 0xcb, 0x77, 0xe9, 0xc4, 0x6f, 0x6a, 0x6a, 0x44, 0x4c, 0xcc, 0x84, 0x18, 0x42, 0x7f,
-	0x55, 0x98, 0xcc, 0x6d, 0xc9, 0x37, 0x8a, 0xe2, 0xfd, 0x6c, 0x29, 0xfb, 0xdd, 0x96, 0x34, 0xf1,
-	0x8c, 0xb1, 0x7a, 0xc2, 0xef, 0x77, 0x6d, 0xe2, 0x3d, 0x10, 0xdc, 0x4c, 0x3c, 0xd6, 0xd0, 0x13,
-	0x7c, 0x46, 0xeb, 0x94, 0xae, 0x78, 0x40, 0x46, 0x71, 0x13, 0x0e, 0x2a, 0xe8, 0xae, 0xe8, 0x37,
-	0x7b, 0x57, 0x97, 0x60, 0xb5, 0x77, 0x67, 0x00, 0x99, 0x99, 0xd3, 0x8e, 0x65, 0x19, 0x56, 0x10,
-	0xe2, 0x67, 0x38, 0x6b, 0xf8, 0x30, 0xcb, 0x96, 0xc8, 0xe0, 0x16, 0x60, 0x39, 0x65, 0xc5, 0x2f,
-	0x70, 0x9a, 0xe3, 0x24, 0x9d, 0x97, 0x9e, 0x4c, 0xe8, 0xbc, 0xb6, 0xc8, 0xf5, 0x25, 0x83, 0xa3,
-	0x0d, 0xe9, 0x65, 0x90, 0xdc, 0x06, 0x05, 0x55, 0x7c, 0x44, 0xfc, 0xe0, 0x8f, 0x36, 0x74, 0x57,
-	0xe6, 0xbb, 0xb8, 0x84, 0xfd, 0x50, 0xed, 0x19, 0x7a, 0xab, 0x32, 0xc7, 0x27, 0x74, 0xe2, 0xbd,
-	0x1a, 0xbd, 0xaf, 0x41, 0xf1, 0x00, 0xfd, 0xba, 0xbc, 0xaa, 0x2a, 0x1a, 0x13, 0x92, 0x4b, 0xf7,
-	0xaf, 0x2f, 0xbf, 0xfa, 0xbb, 0x71, 0x15, 0x37, 0xea, 0xda, 0x9f, 0xf1, 0x6b, 0xbb, 0x0e, 0x88,
-	0x0f, 0xd0, 0x51, 0xd5, 0xa4, 0x9c, 0x7f, 0xc9, 0xc7, 0x3c, 0xe3, 0xba, 0xd7, 0x72, 0x79, 0xd2,
-	0x4d, 0x60, 0x42, 0x4b, 0x16, 0x4a, 0xf1, 0x2d, 0xf4, 0xc2, 0x77, 0x26, 0x3e, 0x2d, 0x9c, 0xec,
-	0xb1, 0x37, 0xbb, 0x01, 0xfb, 0x2d, 0x2d, 0xdc, 0xe0, 0x1c, 0x5e, 0x6f, 0x24, 0x17, 0x3d, 0xe8,
-	0x34, 0x27, 0xf6, 0xff, 0x37, 0xf8, 0x02, 0xfb, 0xeb, 0xe7, 0xd3, 0x4f, 0xcf, 0x54, 0x3b, 0x1f,
-	0x8a, 0xc7, 0x6b, 0xc2, 0xd8, 0x77, 0x6d, 0x36, 0x27, 0xaf, 0xc5, 0x3e, 0xb4, 0xf3, 0x71, 0xe8,
-	0x50, 0x3b, 0x1f, 0x93, 0x66, 0xee, 0xd0, 0xb2, 0x37, 0xa3, 0x98, 0xd7, 0x34, 0x69, 0x69, 0x4a,
-	0xf2, 0x74, 0xa8, 0x6d, 0xb8, 0x88, 0xc7, 0xbb, 0xfc, 0xaf, 0xe0, 0xa7, 0xff, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0xb9, 0xef, 0xf9, 0x0f, 0x25, 0x08, 0x00, 0x00,
+	// 1110 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x56, 0x5d, 0x6f, 0xdb, 0x36,
+	0x17, 0x7e, 0x9d, 0xaf, 0x5a, 0xc7, 0x4e, 0x9a, 0xb2, 0x69, 0xca, 0x36, 0x7d, 0xdb, 0xd4, 0x5b,
+	0x3b, 0x03, 0x1d, 0x32, 0xac, 0xeb, 0xcd, 0x2e, 0x76, 0x51, 0x18, 0x18, 0x50, 0xa4, 0x29, 0x02,
+	0x65, 0xbb, 0x16, 0x64, 0xe9, 0x44, 0x26, 0x2a, 0x93, 0x04, 0x49, 0xbb, 0x4d, 0xaf, 0xf6, 0x07,
+	0xf6, 0xef, 0x86, 0xed, 0xd7, 0x0c, 0x18, 0xce, 0x11, 0x65, 0x39, 0x46, 0xef, 0x74, 0x9e, 0xe7,
+	0x21, 0x0f, 0x79, 0xbe, 0x28, 0x18, 0x16, 0x46, 0x5f, 0xab, 0xea, 0xcc, 0x3a, 0x13, 0x8c, 0xe8,
+	0x6b, 0x9c, 0xd6, 0x18, 0xec, 0x74, 0xf4, 0xe7, 0x16, 0xec, 0x4d, 0x98, 0x12, 0x3f, 0xc2, 0x1d,
+	0x8d, 0xe1, 0x93, 0x71, 0x1f, 0x65, 0xef, 0xb4, 0x37, 0x1e, 0xbc, 0x7e, 0x78, 0xd6, 0xca, 0xce,
+	0x3e, 0x34, 0x44, 0xa3, 0x4c, 0x5b, 0x9d, 0x78, 0x05, 0xbb, 0xc5, 0x2c, 0x57, 0x5a, 0x6e, 0xf1,
+	0x82, 0x07, 0xdd, 0x82, 0x09, 0xc1, 0x51, 0xde, 0x68, 0xc4, 0x0b, 0xd8, 0x76, 0xb6, 0x90, 0xdb,
+	0x2c, 0xbd, 0xdf, 0x49, 0xd3, 0xcb, 0x49, 0x14, 0x12, 0x4f, 0x7b, 0xfa, 0x90, 0x07, 0x2f, 0xcb,
+	0xcd, 0x3d, 0xaf, 0x08, 0x6e, 0xf7, 0x64, 0x8d, 0x18, 0xc3, 0xce, 0x5c, 0xf9, 0x42, 0x22, 0x6b,
+	0x8f, 0x3a, 0xed, 0x85, 0xf2, 0x45, 0x94, 0xb2, 0x82, 0xbc, 0xe7, 0xd6, 0xca, 0xeb, 0x4d, 0xef,
+	0x6f, 0xad, 0x6d, 0xbd, 0xe7, 0xd6, 0x8e, 0xfe, 0xee, 0xc1, 0xfe, 0xad, 0xcb, 0x0a, 0x01, 0x3b,
+	0x1e, 0xb1, 0x94, 0xbd, 0xd3, 0xed, 0x71, 0x92, 0xf2, 0xb7, 0x38, 0x86, 0xbd, 0x5a, 0xf9, 0x80,
+	0x74, 0x71, 0x42, 0xa3, 0x25, 0x9e, 0xc1, 0xc0, 0x3a, 0xb5, 0xcc, 0x03, 0x66, 0x1f, 0xf1, 0x86,
+	0xaf, 0x9a, 0xa4, 0x10, 0xa1, 0x73, 0xbc, 0x11, 0xff, 0x07, 0x88, 0xb1, 0xcb, 0x54, 0x29, 0x77,
+	0x4e, 0x7b, 0xe3, 0xfd, 0x34, 0x89, 0xc8, 0xbb, 0x52, 0x7c, 0x03, 0xfb, 0x3e, 0x38, 0xcc, 0xe7,
+	0x59, 0xad, 0xe6, 0x2a, 0x78, 0xb9, 0x7b, 0xda, 0x1b, 0xef, 0xa6, 0xc3, 0x06, 0x7c, 0xcf, 0x98,
+	0x78, 0x03, 0xc7, 0x0e, 0x3d, 0xba, 0x25, 0x96, 0xd9, 0x6d, 0xf5, 0x1e, 0xab, 0x8f, 0x5a, 0xf6,
+	0x6a, 0x6d, 0xd5, 0xe8, 0xaf, 0x5d, 0x18, 0xac, 0x25, 0x45, 0x3c, 0x82, 0x3e, 0xa7, 0x85, 0xce,
+	0xd1, 0xe3, 0x73, 0xdc, 0x61, 0xfb, 0x5d, 0x29, 0x24, 0xdc, 0xa9, 0x50, 0xa3, 0x57, 0x9e, 0xf3,
+	0x9a, 0xa4, 0xad, 0x49, 0x4c, 0x99, 0x87, 0xbc, 0x54, 0x4e, 0x0e, 0x1a, 0x26, 0x9a, 0x14, 0x91,
+	0x8f, 0x78, 0x43, 0xc4, 0x90, 0x89, 0x68, 0xd1, 0x85, 0x7d, 0xc8, 0x5d, 0xc8, 0xe6, 0x4a, 0xa3,
+	0x3c, 0x3a, 0xed, 0x8d, 0xfb, 0x69, 0xc2, 0xc8, 0x85, 0xd2, 0x28, 0x1e, 0x43, 0xbf, 0x30, 0x4a,
+	0x4f, 0x73, 0x8f, 0xf2, 0x01, 0x2f, 0x5c, 0xd9, 0xe2, 0x08, 0x76, 0x69, 0x91, 0x93, 0xc7, 0x4c,
+	0x34, 0x86, 0x78, 0x0a, 0x60, 0x73, 0xef, 0xed, 0xcc, 0xd1, 0x9a, 0x87, 0x31, 0xc2, 0x2b, 0x44,
+	0xfc, 0x0c, 0x8f, 0x50, 0xe7, 0xd3, 0x1a, 0x33, 0x87, 0x73, 0x13, 0x30, 0xf3, 0xaa, 0xd2, 0x19,
+	0x07, 0xc4, 0x49, 0xc9, 0xfe, 0x8f, 0x1b, 0x41, 0xca, 0xfc, 0x95, 0xaa, 0xf4, 0x15, 0xb3, 0xe2,
+	0x7b, 0x10, 0x5f, 0x59, 0xf3, 0x88, 0x5d, 0x1c, 0xba, 0x4d, 0xf5, 0x09, 0x24, 0x55, 0xee, 0x33,
+	0xeb, 0x54, 0x81, 0xf2, 0x71, 0x73, 0xf6, 0x2a, 0xf7, 0x97, 0x64, 0xb7, 0x24, 0xe7, 0x45, 0x9e,
+	0xac, 0x48, 0xce, 0x85, 0x78, 0x05, 0xf7, 0xc8, 0x41, 0x1e, 0x16, 0x0e, 0xb3, 0x42, 0xd9, 0x19,
+	0x3a, 0x2f, 0x9f, 0x70, 0x21, 0x1d, 0xae, 0x88, 0x49, 0x83, 0x73, 0x00, 0x17, 0x16, 0x5d, 0xa6,
+	0x4d, 0x89, 0xf2, 0x69, 0x0c, 0x20, 0x21, 0x1f, 0x4c, 0x89, 0xe2, 0x07, 0xb8, 0xbf, 0xd0, 0x7e,
+	0x61, 0xad, 0x71, 0x01, 0x4b, 0xaa, 0xba, 0x4f, 0xc6, 0x95, 0xf2, 0x19, 0xbb, 0x14, 0x6b, 0xd4,
+	0x79, 0xc3, 0x88, 0x97, 0x70, 0x37, 0x38, 0x85, 0x59, 0x91, 0x17, 0x33, 0xba, 0xe8, 0x17, 0x94,
+	0xa7, 0x9c, 0xfe, 0x7d, 0x82, 0x27, 0x84, 0x5e, 0xa9, 0x2f, 0x48, 0xa9, 0xf6, 0xc1, 0xb8, 0xbc,
+	0x42, 0xf9, 0xbc, 0x49, 0x75, 0x34, 0xc5, 0x13, 0x48, 0x0a, 0xa3, 0x3d, 0x6a, 0xbf, 0xf0, 0x72,
+	0xc4, 0x5c, 0x07, 0x34, 0x25, 0x9c, 0xeb, 0x72, 0x7a, 0x93, 0xf9, 0xda, 0x04, 0x2f, 0x5f, 0xf2,
+	0xee, 0xc3, 0x08, 0x5e, 0x11, 0x26, 0x46, 0xb0, 0xaf, 0x97, 0xf3, 0xcc, 0x1a, 0x53, 0x37, 0x47,
+	0xf8, 0x8e, 0x45, 0x03, 0xbd, 0x9c, 0x5f, 0x1a, 0x53, 0xf3, 0x01, 0xbe, 0x85, 0x03, 0xd2, 0x14,
+	0xa6, 0x8c, 0x87, 0x95, 0x63, 0xbe, 0xfc, 0x50, 0x2f, 0xe7, 0x13, 0x53, 0x36, 0x47, 0x1d, 0xfd,
+	0xd3, 0x83, 0x64, 0x35, 0x40, 0x28, 0x58, 0xce, 0x16, 0x59, 0xec, 0xcd, 0xa6, 0x63, 0x13, 0x67,
+	0x8b, 0xf7, 0xab, 0xf6, 0x9c, 0x85, 0x60, 0xb3, 0x5b, 0xbd, 0x0b, 0x04, 0x6d, 0x08, 0xe6, 0xa6,
+	0x5c, 0xd4, 0x28, 0xb7, 0x3b, 0xc1, 0x05, 0x23, 0x94, 0xba, 0xc2, 0x68, 0x8d, 0x45, 0x50, 0x46,
+	0xb7, 0x6d, 0xb7, 0xc3, 0x6d, 0x77, 0xd8, 0x11, 0xb1, 0x51, 0x3b, 0x77, 0x6b, 0xbd, 0x1c, 0xdd,
+	0xb1, 0xe0, 0x04, 0x12, 0x16, 0x14, 0xc6, 0x51, 0xf3, 0x92, 0xb3, 0x3e, 0x01, 0x13, 0xe3, 0xfc,
+	0xe8, 0xdf, 0x1e, 0x24, 0xab, 0xe1, 0x44, 0xd2, 0xda, 0x54, 0x59, 0x8d, 0x4b, 0xac, 0xb9, 0x5f,
+	0x93, 0xb4, 0x5f, 0x9b, 0xea, 0x3d, 0xd9, 0xd4, 0xcb, 0x44, 0x5e, 0xab, 0x1a, 0xdb, 0x8e, 0xad,
+	0x4d, 0xf5, 0xab, 0xaa, 0x51, 0x3c, 0x04, 0xfa, 0xcc, 0x28, 0x8d, 0xdb, 0x1c, 0xe3, 0xbd, 0xda,
+	0x54, 0x6f, 0x2b, 0x14, 0x67, 0x70, 0x3f, 0xf6, 0x49, 0xe1, 0x72, 0x3f, 0xcb, 0x1c, 0x52, 0x9d,
+	0xf0, 0x5d, 0xfa, 0xe9, 0xbd, 0x86, 0x9a, 0x10, 0x93, 0x32, 0x21, 0xc6, 0x70, 0xb8, 0x2e, 0xcc,
+	0x16, 0xae, 0xe6, 0x1b, 0x25, 0xe9, 0x41, 0xd1, 0xc9, 0x7e, 0x77, 0x35, 0x0d, 0x70, 0x6b, 0x9d,
+	0xb9, 0x96, 0x7b, 0x9b, 0x03, 0xfc, 0x92, 0xe0, 0x76, 0x80, 0xb3, 0x86, 0xca, 0x6c, 0x89, 0xce,
+	0x2b, 0xa3, 0x79, 0xde, 0x27, 0x69, 0x6b, 0x8e, 0x34, 0x0c, 0xd6, 0xf4, 0x9b, 0xb9, 0x6b, 0x42,
+	0xb0, 0x9e, 0xbb, 0xa7, 0x00, 0x85, 0x5d, 0xd0, 0x8a, 0x2e, 0x0c, 0x6b, 0x08, 0xf1, 0x73, 0x9c,
+	0xb7, 0x7c, 0x1c, 0xcd, 0x1d, 0x32, 0x3a, 0x07, 0xe8, 0x1e, 0x0d, 0xf1, 0x0b, 0x9c, 0x94, 0x78,
+	0x9d, 0x2f, 0xea, 0x40, 0x3d, 0x45, 0xa5, 0x8f, 0x1c, 0x5f, 0xea, 0x57, 0x74, 0xd1, 0xbd, 0x8c,
+	0x92, 0xf3, 0xa8, 0xa0, 0x88, 0x4f, 0x88, 0x1f, 0xfd, 0xb1, 0x05, 0x83, 0xb5, 0xe7, 0x4a, 0xbc,
+	0x80, 0x83, 0x18, 0xed, 0x39, 0x06, 0xa7, 0x0a, 0xcf, 0x3b, 0xf4, 0xd3, 0xfd, 0x06, 0xbd, 0x68,
+	0x40, 0x71, 0x09, 0x87, 0x4d, 0x78, 0x95, 0xae, 0xda, 0x22, 0xa4, 0x2a, 0x3d, 0x78, 0xfd, 0xe2,
+	0xab, 0xcf, 0xe0, 0x59, 0xda, 0xaa, 0x9b, 0xfa, 0x4c, 0xef, 0xba, 0xdb, 0x80, 0x78, 0x03, 0x7d,
+	0xa5, 0xaf, 0xeb, 0xc5, 0xe7, 0x72, 0xca, 0x23, 0x7b, 0xf0, 0x5a, 0x76, 0x3b, 0xbd, 0x8b, 0x4c,
+	0x4c, 0xc9, 0x4a, 0x29, 0x9e, 0xc3, 0x30, 0x9e, 0x33, 0x0b, 0x79, 0xe5, 0xe5, 0x90, 0x6b, 0x73,
+	0x10, 0xb1, 0xdf, 0xf2, 0xca, 0x8f, 0x9e, 0xc1, 0xdd, 0x0d, 0xe7, 0x62, 0x08, 0xfd, 0x76, 0xc7,
+	0xc3, 0xff, 0x8d, 0x3e, 0xc3, 0xc1, 0xed, 0xfd, 0xe9, 0x25, 0x9d, 0x19, 0x1f, 0x62, 0xf0, 0xf8,
+	0x9b, 0x30, 0xae, 0xbb, 0x2d, 0x2e, 0x4e, 0xfe, 0x16, 0x07, 0xb0, 0x55, 0x4e, 0x63, 0x86, 0xb6,
+	0xca, 0x29, 0x69, 0x16, 0x1e, 0x1d, 0xd7, 0x66, 0x92, 0xf2, 0x37, 0x3d, 0x1c, 0x34, 0xf4, 0x79,
+	0xd8, 0x35, 0x65, 0xb8, 0xb2, 0xa7, 0x7b, 0xfc, 0x93, 0xf3, 0xd3, 0x7f, 0x03, 0x00, 0x70, 0xdf,
+	0xcb, 0xce, 0xf4, 0x08, 0x00, 0x00,
 }
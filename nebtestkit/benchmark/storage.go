@@ -37,12 +37,17 @@ func main() {
 		return
 	}
 	rootHash := os.Args[3]
-	stor, err := storage.NewDiskStorage(file)
+	backend := storage.LevelBackend
+	if len(os.Args) > 4 {
+		backend = os.Args[4]
+	}
+	stor, err := storage.NewStorage(backend, file)
 	if err != nil {
 		fmt.Println("OpenDB Error ", err)
 		return
 	}
-	fmt.Println("cnt:", cnt, " file:", file, " root:", rootHash)
+	defer stor.Close()
+	fmt.Println("cnt:", cnt, " file:", file, " root:", rootHash, " backend:", backend)
 
 	startAt := time.Now().Unix()
 	root, err := byteutils.FromHex(rootHash)
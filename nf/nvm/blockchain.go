@@ -21,6 +21,7 @@ package nvm
 import "C"
 
 import (
+	"fmt"
 	"unsafe"
 
 	"encoding/json"
@@ -33,6 +34,11 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+const (
+	// MaxInnerContractCallDepth max depth of the contracts called by contracts in a tx.
+	MaxInnerContractCallDepth = 3
+)
+
 // GetTxByHashFunc returns tx info by hash
 //export GetTxByHashFunc
 func GetTxByHashFunc(handler unsafe.Pointer, hash *C.char, gasCnt *C.size_t) *C.char {
@@ -42,7 +48,7 @@ func GetTxByHashFunc(handler unsafe.Pointer, hash *C.char, gasCnt *C.size_t) *C.
 	}
 
 	// calculate Gas.
-	*gasCnt = C.size_t(1000)
+	*gasCnt = C.size_t(engine.gasTable().GetTxByHash)
 
 	txHash, err := byteutils.FromHex(C.GoString(hash))
 	if err != nil {
@@ -88,7 +94,7 @@ func GetAccountStateFunc(handler unsafe.Pointer, address *C.char, gasCnt *C.size
 	}
 
 	// calculate Gas.
-	*gasCnt = C.size_t(1000)
+	*gasCnt = C.size_t(engine.gasTable().GetAccountState)
 
 	addr, err := core.AddressParse(C.GoString(address))
 	if err != nil {
@@ -126,7 +132,7 @@ func TransferFunc(handler unsafe.Pointer, to *C.char, v *C.char, gasCnt *C.size_
 	}
 
 	// calculate Gas.
-	*gasCnt = C.size_t(2000)
+	*gasCnt = C.size_t(engine.gasTable().Transfer)
 
 	addr, err := core.AddressParse(C.GoString(to))
 	if err != nil {
@@ -218,8 +224,10 @@ func TransferFunc(handler unsafe.Pointer, to *C.char, v *C.char, gasCnt *C.size_
 // VerifyAddressFunc verify address is valid
 //export VerifyAddressFunc
 func VerifyAddressFunc(handler unsafe.Pointer, address *C.char, gasCnt *C.size_t) int {
+	engine, _ := getEngineByStorageHandler(uint64(uintptr(handler)))
+
 	// calculate Gas.
-	*gasCnt = C.size_t(100)
+	*gasCnt = C.size_t(engine.gasTable().VerifyAddress)
 
 	addr, err := core.AddressParse(C.GoString(address))
 	if err != nil {
@@ -227,3 +235,185 @@ func VerifyAddressFunc(handler unsafe.Pointer, address *C.char, gasCnt *C.size_t
 	}
 	return int(addr.Type())
 }
+
+// CallContractFunc call function of the contract at address with value, returns the result in JSON
+//export CallContractFunc
+func CallContractFunc(handler unsafe.Pointer, address *C.char, funcName *C.char, args *C.char, v *C.char, gasCnt *C.size_t) *C.char {
+	engine, _ := getEngineByStorageHandler(uint64(uintptr(handler)))
+	if engine == nil || engine.ctx.block == nil {
+		logging.VLog().Error("Failed to get engine.")
+		return nil
+	}
+
+	// the native doesn't exist before the available height, the calls fail without gas.
+	if engine.ctx.block.Height() < core.InnerContractCallAvailableHeight {
+		return nil
+	}
+
+	result, instructions, err := engine.callContract(C.GoString(address), C.GoString(funcName), C.GoString(args), C.GoString(v))
+
+	// calculate Gas, the instructions of callee are counted in caller's.
+	*gasCnt = C.size_t(engine.gasTable().InnerContractCallBase + instructions)
+
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"handler":  uint64(uintptr(handler)),
+			"address":  C.GoString(address),
+			"function": C.GoString(funcName),
+			"err":      err,
+		}).Debug("CallContractFunc call contract failed.")
+		return nil
+	}
+	return C.CString(result)
+}
+
+// callContract run the function of the contract at address in a nested engine and world state.
+// The callee shares the remaining execution instructions of the caller, and its changes on
+// world state are dropped if it fails.
+func (e *V8Engine) callContract(address, function, args, value string) (string, uint64, error) {
+	ctx := e.ctx
+	if ctx.depth >= MaxInnerContractCallDepth {
+		return "", 0, ErrExceedMaxCallDepth
+	}
+
+	addr, err := core.AddressParse(address)
+	if err != nil {
+		return "", 0, err
+	}
+	amount, err := util.NewUint128FromString(value)
+	if err != nil {
+		return "", 0, err
+	}
+
+	used := uint64(e.v8engine.stats.count_of_executed_instructions) + e.gasTable().InnerContractCallBase
+	if e.limitsOfExecutionInstructions <= used {
+		return "", 0, ErrInsufficientGas
+	}
+
+	ws, err := ctx.state.Prepare(ctx.tx.Hash().String())
+	if err != nil {
+		return "", 0, err
+	}
+	result, instructions, exeErr := e.runInnerContract(ws, addr, amount, function, args, e.limitsOfExecutionInstructions-used)
+	if exeErr != nil {
+		err = ws.Close()
+	} else {
+		_, err = ws.CheckAndUpdate()
+	}
+	if err != nil {
+		return "", instructions, err
+	}
+
+	// the accounts got before preparing the nested world state are stale.
+	if ctx.contract, err = ctx.state.GetContractAccount(ctx.contract.Address()); err != nil {
+		return "", instructions, err
+	}
+
+	if err := e.recordInnerContractCall(addr, amount, function, args, exeErr); err != nil {
+		return "", instructions, err
+	}
+	return result, instructions, exeErr
+}
+
+func (e *V8Engine) runInnerContract(ws state.TxWorldState, addr *core.Address, amount *util.Uint128, function, args string, limitsOfExecutionInstructions uint64) (string, uint64, error) {
+	contract, err := core.CheckContract(addr, ws)
+	if err != nil {
+		return "", 0, err
+	}
+	source, sourceType, err := core.LoadContractSource(contract, ws)
+	if err != nil {
+		return "", 0, err
+	}
+
+	caller, err := ws.GetContractAccount(e.ctx.contract.Address())
+	if err != nil {
+		return "", 0, err
+	}
+	if amount.Cmp(util.NewUint128()) > 0 {
+		if err := caller.SubBalance(amount); err != nil {
+			return "", 0, err
+		}
+		if err := contract.AddBalance(amount); err != nil {
+			return "", 0, err
+		}
+	}
+	from, err := core.AddressParseFromBytes(caller.Address())
+	if err != nil {
+		return "", 0, err
+	}
+
+	tx := &innerTransaction{
+		Transaction: e.ctx.tx,
+		from:        from,
+		to:          addr,
+		value:       amount,
+	}
+	engine := NewV8Engine(newInnerContext(e.ctx, tx, contract, ws))
+	defer engine.Dispose()
+
+	if err := engine.SetExecutionLimits(limitsOfExecutionInstructions, core.DefaultLimitsOfTotalMemorySize); err != nil {
+		return "", 0, err
+	}
+	result, err := engine.Call(source, sourceType, function, args)
+	instructions := engine.ExecutionInstructions()
+	if err == core.ErrExecutionFailed && len(result) > 0 {
+		err = fmt.Errorf("Call: %s", result)
+	}
+	return result, instructions, err
+}
+
+func (e *V8Engine) recordInnerContractCall(addr *core.Address, amount *util.Uint128, function, args string, exeErr error) error {
+	from, err := core.AddressParseFromBytes(e.ctx.contract.Address())
+	if err != nil {
+		return err
+	}
+	event := &InnerContractCallEvent{
+		From:     from.String(),
+		To:       addr.String(),
+		Value:    amount.String(),
+		Function: function,
+		Args:     args,
+		Depth:    e.ctx.depth + 1,
+	}
+	if exeErr != nil {
+		event.Error = exeErr.Error()
+	}
+	eData, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	e.ctx.state.RecordEvent(e.ctx.tx.Hash(), &state.Event{Topic: core.TopicInnerContractCall, Data: string(eData)})
+	return nil
+}
+
+// GetBlockFunc returns the header of a recent block by height in JSON
+//export GetBlockFunc
+func GetBlockFunc(handler unsafe.Pointer, height C.uint64_t, gasCnt *C.size_t) *C.char {
+	engine, _ := getEngineByStorageHandler(uint64(uintptr(handler)))
+	if engine == nil || engine.ctx.block == nil {
+		return nil
+	}
+
+	// the native doesn't exist before the available height, the reads fail without gas.
+	if engine.ctx.block.Height() < core.RecentBlockAvailableHeight {
+		return nil
+	}
+
+	// calculate Gas.
+	*gasCnt = C.size_t(engine.gasTable().GetBlock)
+
+	block, err := engine.ctx.block.RecentBlock(uint64(height))
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"handler": uint64(uintptr(handler)),
+			"height":  uint64(height),
+			"err":     err,
+		}).Debug("GetBlockFunc get block failed.")
+		return nil
+	}
+	blockJSON, err := json.Marshal(toSerializableBlockHeader(block))
+	if err != nil {
+		return nil
+	}
+	return C.CString(string(blockJSON))
+}
@@ -27,20 +27,33 @@ void V8Log(int level, const char *msg);
 // require.
 char *RequireDelegateFunc(void *handler, const char *filename, size_t *lineOffset);
 
+// code cache.
+int GetCodeCacheFunc(void *handler, const char *filename, char **data, size_t *size);
+void SetCodeCacheFunc(void *handler, const char *filename, const char *data, size_t size);
+
 // storage.
 char *StorageGetFunc(void *handler, const char *key, size_t *gasCnt);
 int StoragePutFunc(void *handler, const char *key, const char *value, size_t *gasCnt);
 int StorageDelFunc(void *handler, const char *key, size_t *gasCnt);
+char *StorageIterateFunc(void *handler, const char *field, int offset, int limit, size_t *gasCnt);
 
 // blockchain.
 char *GetTxByHashFunc(void *handler, const char *hash, size_t *gasCnt);
 char *GetAccountStateFunc(void *handler, const char *address, size_t *gasCnt);
 int TransferFunc(void *handler, const char *to, const char *value, size_t *gasCnt);
 int VerifyAddressFunc(void *handler, const char *address, size_t *gasCnt);
+char *CallContractFunc(void *handler, const char *address, const char *funcName, const char *args, const char *value, size_t *gasCnt);
+char *GetBlockFunc(void *handler, uint64_t height, size_t *gasCnt);
 
 // event.
 void EventTriggerFunc(void *handler, const char *topic, const char *data, size_t *gasCnt);
 
+// crypto.
+char *Sha256Func(void *handler, const char *data, size_t *gasCnt);
+char *Sha3256Func(void *handler, const char *data, size_t *gasCnt);
+char *Ripemd160Func(void *handler, const char *data, size_t *gasCnt);
+char *RecoverAddressFunc(void *handler, int alg, const char *hash, const char *sign, size_t *gasCnt);
+
 // The gateway functions.
 void V8Log_cgo(int level, const char *msg) {
 	V8Log(level, msg);
@@ -50,6 +63,13 @@ char *RequireDelegateFunc_cgo(void *handler, const char *filename, size_t *lineO
 	return RequireDelegateFunc(handler, filename, lineOffset);
 }
 
+int GetCodeCacheFunc_cgo(void *handler, const char *filename, char **data, size_t *size) {
+	return GetCodeCacheFunc(handler, filename, data, size);
+}
+void SetCodeCacheFunc_cgo(void *handler, const char *filename, const char *data, size_t size) {
+	SetCodeCacheFunc(handler, filename, data, size);
+}
+
 char *StorageGetFunc_cgo(void *handler, const char *key, size_t *gasCnt) {
 	return StorageGetFunc(handler, key, gasCnt);
 };
@@ -59,6 +79,9 @@ int StoragePutFunc_cgo(void *handler, const char *key, const char *value, size_t
 int StorageDelFunc_cgo(void *handler, const char *key, size_t *gasCnt) {
 	return StorageDelFunc(handler, key, gasCnt);
 };
+char *StorageIterateFunc_cgo(void *handler, const char *field, int offset, int limit, size_t *gasCnt) {
+	return StorageIterateFunc(handler, field, offset, limit, gasCnt);
+};
 
 char *GetTxByHashFunc_cgo(void *handler, const char *hash, size_t *gasCnt) {
 	return GetTxByHashFunc(handler, hash, gasCnt);
@@ -72,10 +95,29 @@ int TransferFunc_cgo(void *handler, const char *to, const char *value, size_t *g
 int VerifyAddressFunc_cgo(void *handler, const char *address, size_t *gasCnt) {
 	return VerifyAddressFunc(handler, address, gasCnt);
 };
+char *CallContractFunc_cgo(void *handler, const char *address, const char *funcName, const char *args, const char *value, size_t *gasCnt) {
+	return CallContractFunc(handler, address, funcName, args, value, gasCnt);
+};
+char *GetBlockFunc_cgo(void *handler, uint64_t height, size_t *gasCnt) {
+	return GetBlockFunc(handler, height, gasCnt);
+};
 
 void EventTriggerFunc_cgo(void *handler, const char *topic, const char *data, size_t *gasCnt) {
 	EventTriggerFunc(handler, topic, data, gasCnt);
 };
 
+char *Sha256Func_cgo(void *handler, const char *data, size_t *gasCnt) {
+	return Sha256Func(handler, data, gasCnt);
+};
+char *Sha3256Func_cgo(void *handler, const char *data, size_t *gasCnt) {
+	return Sha3256Func(handler, data, gasCnt);
+};
+char *Ripemd160Func_cgo(void *handler, const char *data, size_t *gasCnt) {
+	return Ripemd160Func(handler, data, gasCnt);
+};
+char *RecoverAddressFunc_cgo(void *handler, int alg, const char *hash, const char *sign, size_t *gasCnt) {
+	return RecoverAddressFunc(handler, alg, hash, sign, gasCnt);
+};
+
 */
 import "C"
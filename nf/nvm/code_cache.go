@@ -0,0 +1,133 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import "C"
+
+import (
+	"bytes"
+	"sync"
+	"unsafe"
+
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// The compiled code of contract modules is cached in the node-local storage, outside of
+// the consensus state. The entry of a contract is keyed by its address and the value leads
+// with the hash of the compiled source, so an upgraded source replaces the stale entry.
+var (
+	codeCacheStorage storage.Storage
+	codeCacheLock    = sync.RWMutex{}
+)
+
+// EnableCodeCache cache the compiled code of contracts in the storage, nil disables the cache.
+func EnableCodeCache(stor storage.Storage) error {
+	if stor != nil {
+		ks, err := storage.OpenKeyspace(stor, storage.CodeCacheKeyspace)
+		if err != nil {
+			return err
+		}
+		stor = ks
+	}
+
+	codeCacheLock.Lock()
+	defer codeCacheLock.Unlock()
+	codeCacheStorage = stor
+	return nil
+}
+
+// codeCacheKey return the key of the module compiled by the engine, and its source hash.
+func codeCacheKey(e *V8Engine, filename string) ([]byte, []byte) {
+	if e == nil || e.ctx == nil || e.ctx.contract == nil {
+		return nil, nil
+	}
+	module := e.modules.Get(filename)
+	if module == nil {
+		return nil, nil
+	}
+	return e.ctx.contract.Address(), hash.Sha3256([]byte(module.source))
+}
+
+func getCodeCacheStorage() storage.Storage {
+	codeCacheLock.RLock()
+	defer codeCacheLock.RUnlock()
+	return codeCacheStorage
+}
+
+// GetCodeCacheFunc export GetCodeCacheFunc
+//export GetCodeCacheFunc
+func GetCodeCacheFunc(handler unsafe.Pointer, filename *C.char, data **C.char, size *C.size_t) C.int {
+	stor := getCodeCacheStorage()
+	if stor == nil {
+		return 0
+	}
+	key, sourceHash := codeCacheKey(getEngineByEngineHandler(handler), C.GoString(filename))
+	if key == nil {
+		return 0
+	}
+
+	value, err := stor.Get(key)
+	if err != nil && err != storage.ErrKeyNotFound {
+		logging.VLog().WithFields(logrus.Fields{
+			"key": key,
+			"err": err,
+		}).Debug("Failed to get code cache.")
+	}
+	if err != nil || len(value) <= len(sourceHash) || !bytes.Equal(value[:len(sourceHash)], sourceHash) {
+		metricsCodeCacheMiss.Mark(1)
+		return 1
+	}
+
+	metricsCodeCacheHit.Mark(1)
+	code := value[len(sourceHash):]
+	*data = (*C.char)(C.CBytes(code))
+	*size = C.size_t(len(code))
+	return 1
+}
+
+// SetCodeCacheFunc export SetCodeCacheFunc
+//export SetCodeCacheFunc
+func SetCodeCacheFunc(handler unsafe.Pointer, filename *C.char, data *C.char, size C.size_t) {
+	stor := getCodeCacheStorage()
+	if stor == nil {
+		return
+	}
+	key, sourceHash := codeCacheKey(getEngineByEngineHandler(handler), C.GoString(filename))
+	if key == nil {
+		return
+	}
+
+	var err error
+	if data == nil {
+		// V8 rejected the cached code.
+		metricsCodeCacheRejected.Mark(1)
+		err = stor.Del(key)
+	} else {
+		err = stor.Put(key, append(sourceHash, C.GoBytes(unsafe.Pointer(data), C.int(size))...))
+	}
+	if err != nil && err != storage.ErrKeyNotFound {
+		logging.VLog().WithFields(logrus.Fields{
+			"key": key,
+			"err": err,
+		}).Debug("Failed to update code cache.")
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/nebulasio/go-nebulas/core"
 	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/util"
 )
 
 // SerializableAccount serializable account state
@@ -38,6 +39,16 @@ type SerializableBlock struct {
 	Seed      string `json:"seed,omitempty"`
 }
 
+// SerializableBlockHeader serializable header of a recent block
+type SerializableBlockHeader struct {
+	Hash       string `json:"hash"`
+	ParentHash string `json:"parentHash"`
+	Height     uint64 `json:"height"`
+	Timestamp  int64  `json:"timestamp"`
+	Coinbase   string `json:"coinbase"`
+	Seed       string `json:"seed,omitempty"`
+}
+
 // SerializableTransaction serializable transaction
 type SerializableTransaction struct {
 	Hash      string `json:"hash"`
@@ -56,6 +67,8 @@ type Context struct {
 	tx       Transaction
 	contract Account
 	state    WorldState
+	depth    int // the depth of inner contract calls, 0 for the contract called by tx.
+	gasTable *core.GasTable
 }
 
 // NewContext create a engine context
@@ -68,10 +81,47 @@ func NewContext(block Block, tx Transaction, contract Account, state WorldState)
 		tx:       tx,
 		contract: contract,
 		state:    state,
+		gasTable: core.DefaultGasSchedule().Table,
 	}
 	return ctx, nil
 }
 
+// newInnerContext create the context of the contract called by the contract in parent context.
+func newInnerContext(parent *Context, tx Transaction, contract Account, state WorldState) *Context {
+	return &Context{
+		block:    parent.block,
+		tx:       tx,
+		contract: contract,
+		state:    state,
+		depth:    parent.depth + 1,
+		gasTable: parent.gasTable,
+	}
+}
+
+// innerTransaction the transaction seen by the contract called by another contract,
+// sent from the caller contract with the value of the call.
+type innerTransaction struct {
+	Transaction
+	from  *core.Address
+	to    *core.Address
+	value *util.Uint128
+}
+
+// From returns the caller contract
+func (tx *innerTransaction) From() *core.Address {
+	return tx.from
+}
+
+// To returns the callee contract
+func (tx *innerTransaction) To() *core.Address {
+	return tx.to
+}
+
+// Value returns the value of the call
+func (tx *innerTransaction) Value() *util.Uint128 {
+	return tx.value
+}
+
 func toSerializableAccount(acc Account) *SerializableAccount {
 	sAcc := &SerializableAccount{
 		Nonce:   acc.Nonce(),
@@ -92,6 +142,17 @@ func toSerializableBlock(block Block) *SerializableBlock {
 	return sBlock
 }
 
+func toSerializableBlockHeader(block *core.Block) *SerializableBlockHeader {
+	return &SerializableBlockHeader{
+		Hash:       block.Hash().String(),
+		ParentHash: block.ParentHash().String(),
+		Height:     block.Height(),
+		Timestamp:  block.Timestamp(),
+		Coinbase:   block.Coinbase().String(),
+		Seed:       block.RandomSeed(),
+	}
+}
+
 func toSerializableTransaction(tx Transaction) *SerializableTransaction {
 	return &SerializableTransaction{
 		From:      tx.From().String(),
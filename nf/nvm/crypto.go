@@ -0,0 +1,129 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import "C"
+
+import (
+	"unsafe"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Sha256Func returns the sha256 hash of data in hex
+//export Sha256Func
+func Sha256Func(handler unsafe.Pointer, data *C.char, gasCnt *C.size_t) *C.char {
+	engine := getEngineByEngineHandler(handler)
+
+	if !cryptoAvailable(engine) {
+		return nil
+	}
+
+	// calculate Gas.
+	*gasCnt = C.size_t(engine.gasTable().CryptoHash)
+	return C.CString(byteutils.Hex(hash.Sha256([]byte(C.GoString(data)))))
+}
+
+// Sha3256Func returns the sha3-256 hash of data in hex
+//export Sha3256Func
+func Sha3256Func(handler unsafe.Pointer, data *C.char, gasCnt *C.size_t) *C.char {
+	engine := getEngineByEngineHandler(handler)
+
+	if !cryptoAvailable(engine) {
+		return nil
+	}
+
+	// calculate Gas.
+	*gasCnt = C.size_t(engine.gasTable().CryptoHash)
+	return C.CString(byteutils.Hex(hash.Sha3256([]byte(C.GoString(data)))))
+}
+
+// Ripemd160Func returns the ripemd160 hash of data in hex
+//export Ripemd160Func
+func Ripemd160Func(handler unsafe.Pointer, data *C.char, gasCnt *C.size_t) *C.char {
+	engine := getEngineByEngineHandler(handler)
+
+	if !cryptoAvailable(engine) {
+		return nil
+	}
+
+	// calculate Gas.
+	*gasCnt = C.size_t(engine.gasTable().CryptoHash)
+	return C.CString(byteutils.Hex(hash.Ripemd160([]byte(C.GoString(data)))))
+}
+
+// RecoverAddressFunc returns the address signing the hash with sign, both in hex
+//export RecoverAddressFunc
+func RecoverAddressFunc(handler unsafe.Pointer, alg C.int, data *C.char, sign *C.char, gasCnt *C.size_t) *C.char {
+	engine := getEngineByEngineHandler(handler)
+
+	if !cryptoAvailable(engine) {
+		return nil
+	}
+
+	// calculate Gas.
+	*gasCnt = C.size_t(engine.gasTable().CryptoRecoverAddress)
+	addr, err := recoverAddress(keystore.Algorithm(alg), C.GoString(data), C.GoString(sign))
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"alg":  int(alg),
+			"hash": C.GoString(data),
+			"sign": C.GoString(sign),
+			"err":  err,
+		}).Debug("RecoverAddressFunc recover address failed.")
+		return nil
+	}
+	return C.CString(addr.String())
+}
+
+// cryptoAvailable return whether the Crypto functions are available in the block of engine,
+// the natives don't exist before and fail without gas.
+func cryptoAvailable(engine *V8Engine) bool {
+	return engine.ctx.block != nil && engine.ctx.block.Height() >= core.CryptoAvailableHeight
+}
+
+func recoverAddress(alg keystore.Algorithm, data, sign string) (*core.Address, error) {
+	hashBytes, err := byteutils.FromHex(data)
+	if err != nil {
+		return nil, err
+	}
+	signBytes, err := byteutils.FromHex(sign)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := crypto.NewSignature(alg)
+	if err != nil {
+		return nil, err
+	}
+	pub, err := signature.RecoverPublic(hashBytes, signBytes)
+	if err != nil {
+		return nil, err
+	}
+	pubData, err := pub.Encoded()
+	if err != nil {
+		return nil, err
+	}
+	return core.NewAddressFromPublicKey(pubData)
+}
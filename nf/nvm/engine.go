@@ -37,9 +37,26 @@ func (nvm *NebulasVM) CreateEngine(block *core.Block, tx *core.Transaction, cont
 	if err != nil {
 		return nil, err
 	}
+	schedule, err := core.GasScheduleAt(state, block.Height())
+	if err != nil {
+		return nil, err
+	}
+	ctx.gasTable = schedule.Table
+
+	// only the simulations borrow pooled isolates, block execution keeps using new ones.
+	if block.Simulated() {
+		return borrowV8Engine(ctx), nil
+	}
 	return NewV8Engine(ctx), nil
 }
 
+// PublicFunctions return the functions of the contract source callable by call payloads
+func (nvm *NebulasVM) PublicFunctions(source, sourceType string) ([]string, error) {
+	engine := NewV8Engine(nil)
+	defer engine.Dispose()
+	return engine.PublicFunctions(source, sourceType)
+}
+
 // CheckV8Run to check V8 env is OK
 func (nvm *NebulasVM) CheckV8Run() error {
 	engine := NewV8Engine(nil)
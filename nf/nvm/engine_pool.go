@@ -0,0 +1,141 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+/*
+#include "v8/engine.h"
+*/
+import "C"
+import (
+	"sync"
+)
+
+const (
+	// MaxEnginePoolSize max count of V8 isolates in the engine pool.
+	MaxEnginePoolSize = 1024
+)
+
+var (
+	enginePool     *v8EnginePool
+	enginePoolLock = sync.RWMutex{}
+)
+
+// v8EnginePool keeps warmed V8 isolates to be borrowed by simulation paths, such as Call and EstimateGas.
+// A new context is created for each execution, the isolate is reset before it goes back to the pool.
+type v8EnginePool struct {
+	mu     sync.Mutex
+	size   int
+	idle   []*C.V8Engine
+	closed bool
+}
+
+// SetEnginePoolSize set up the pool with `size` warmed V8 isolates, 0 disables the pool.
+func SetEnginePoolSize(size int) error {
+	if size < 0 || size > MaxEnginePoolSize {
+		return ErrInvalidEnginePoolSize
+	}
+
+	v8engineOnce.Do(func() {
+		InitV8Engine()
+	})
+
+	var pool *v8EnginePool
+	if size > 0 {
+		pool = &v8EnginePool{
+			size: size,
+			idle: make([]*C.V8Engine, 0, size),
+		}
+		for i := 0; i < size; i++ {
+			pool.idle = append(pool.idle, C.CreateEngine())
+		}
+	}
+
+	enginePoolLock.Lock()
+	old := enginePool
+	enginePool = pool
+	enginePoolLock.Unlock()
+
+	if old != nil {
+		old.close()
+	}
+	metricsEnginePoolIdle.Update(int64(size))
+	return nil
+}
+
+// borrowV8Engine return a V8Engine running on an isolate of the pool,
+// a new isolate is created if the pool is disabled or drained.
+func borrowV8Engine(ctx *Context) *V8Engine {
+	enginePoolLock.RLock()
+	pool := enginePool
+	enginePoolLock.RUnlock()
+
+	if pool == nil {
+		return NewV8Engine(ctx)
+	}
+
+	v8engine := pool.get()
+	if v8engine == nil {
+		metricsEnginePoolMiss.Mark(1)
+		v8engine = C.CreateEngine()
+	} else {
+		metricsEnginePoolHit.Mark(1)
+	}
+
+	engine := newV8Engine(ctx, v8engine)
+	engine.pool = pool
+	return engine
+}
+
+func (pool *v8EnginePool) get() *C.V8Engine {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	if len(pool.idle) == 0 {
+		return nil
+	}
+	v8engine := pool.idle[len(pool.idle)-1]
+	pool.idle = pool.idle[:len(pool.idle)-1]
+	metricsEnginePoolIdle.Update(int64(len(pool.idle)))
+	return v8engine
+}
+
+// put return the isolate to the pool, or delete it if the pool is full or closed.
+func (pool *v8EnginePool) put(v8engine *C.V8Engine) {
+	pool.mu.Lock()
+	if pool.closed || len(pool.idle) >= pool.size {
+		pool.mu.Unlock()
+		metricsEnginePoolDiscard.Mark(1)
+		C.DeleteEngine(v8engine)
+		return
+	}
+	pool.idle = append(pool.idle, v8engine)
+	metricsEnginePoolIdle.Update(int64(len(pool.idle)))
+	pool.mu.Unlock()
+}
+
+func (pool *v8EnginePool) close() {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	pool.closed = true
+	for _, v8engine := range pool.idle {
+		C.DeleteEngine(v8engine)
+	}
+	pool.idle = nil
+}
@@ -30,17 +30,28 @@ void V8Log_cgo(int level, const char *msg);
 
 char *RequireDelegateFunc_cgo(void *handler, const char *filename, size_t *lineOffset);
 
+int GetCodeCacheFunc_cgo(void *handler, const char *filename, char **data, size_t *size);
+void SetCodeCacheFunc_cgo(void *handler, const char *filename, const char *data, size_t size);
+
 char *StorageGetFunc_cgo(void *handler, const char *key, size_t *gasCnt);
 int StoragePutFunc_cgo(void *handler, const char *key, const char *value, size_t *gasCnt);
 int StorageDelFunc_cgo(void *handler, const char *key, size_t *gasCnt);
+char *StorageIterateFunc_cgo(void *handler, const char *field, int offset, int limit, size_t *gasCnt);
 
 char *GetTxByHashFunc_cgo(void *handler, const char *hash);
 char *GetAccountStateFunc_cgo(void *handler, const char *address);
 int TransferFunc_cgo(void *handler, const char *to, const char *value);
 int VerifyAddressFunc_cgo(void *handler, const char *address);
+char *CallContractFunc_cgo(void *handler, const char *address, const char *funcName, const char *args, const char *value, size_t *gasCnt);
+char *GetBlockFunc_cgo(void *handler, uint64_t height, size_t *gasCnt);
 
 void EventTriggerFunc_cgo(void *handler, const char *topic, const char *data, size_t *gasCnt);
 
+char *Sha256Func_cgo(void *handler, const char *data, size_t *gasCnt);
+char *Sha3256Func_cgo(void *handler, const char *data, size_t *gasCnt);
+char *Ripemd160Func_cgo(void *handler, const char *data, size_t *gasCnt);
+char *RecoverAddressFunc_cgo(void *handler, int alg, const char *hash, const char *sign, size_t *gasCnt);
+
 */
 import "C"
 import (
@@ -91,6 +102,7 @@ type V8Engine struct {
 	actualTotalMemorySize                   uint64
 	lcsHandler                              uint64
 	gcsHandler                              uint64
+	pool                                    *v8EnginePool
 }
 
 type sourceModuleItem struct {
@@ -110,14 +122,20 @@ func InitV8Engine() {
 	// Require.
 	C.InitializeRequireDelegate((C.RequireDelegate)(unsafe.Pointer(C.RequireDelegateFunc_cgo)))
 
+	// Code cache.
+	C.InitializeCodeCacheDelegate((C.GetCodeCacheFunc)(unsafe.Pointer(C.GetCodeCacheFunc_cgo)), (C.SetCodeCacheFunc)(unsafe.Pointer(C.SetCodeCacheFunc_cgo)))
+
 	// Storage.
-	C.InitializeStorage((C.StorageGetFunc)(unsafe.Pointer(C.StorageGetFunc_cgo)), (C.StoragePutFunc)(unsafe.Pointer(C.StoragePutFunc_cgo)), (C.StorageDelFunc)(unsafe.Pointer(C.StorageDelFunc_cgo)))
+	C.InitializeStorage((C.StorageGetFunc)(unsafe.Pointer(C.StorageGetFunc_cgo)), (C.StoragePutFunc)(unsafe.Pointer(C.StoragePutFunc_cgo)), (C.StorageDelFunc)(unsafe.Pointer(C.StorageDelFunc_cgo)), (C.StorageIterateFunc)(unsafe.Pointer(C.StorageIterateFunc_cgo)))
 
 	// Blockchain.
-	C.InitializeBlockchain((C.GetTxByHashFunc)(unsafe.Pointer(C.GetTxByHashFunc_cgo)), (C.GetAccountStateFunc)(unsafe.Pointer(C.GetAccountStateFunc_cgo)), (C.TransferFunc)(unsafe.Pointer(C.TransferFunc_cgo)), (C.VerifyAddressFunc)(unsafe.Pointer(C.VerifyAddressFunc_cgo)))
+	C.InitializeBlockchain((C.GetTxByHashFunc)(unsafe.Pointer(C.GetTxByHashFunc_cgo)), (C.GetAccountStateFunc)(unsafe.Pointer(C.GetAccountStateFunc_cgo)), (C.TransferFunc)(unsafe.Pointer(C.TransferFunc_cgo)), (C.VerifyAddressFunc)(unsafe.Pointer(C.VerifyAddressFunc_cgo)), (C.CallContractFunc)(unsafe.Pointer(C.CallContractFunc_cgo)), (C.GetBlockFunc)(unsafe.Pointer(C.GetBlockFunc_cgo)))
 
 	// Event.
 	C.InitializeEvent((C.EventTriggerFunc)(unsafe.Pointer(C.EventTriggerFunc_cgo)))
+
+	// Crypto.
+	C.InitializeCrypto((C.Sha256Func)(unsafe.Pointer(C.Sha256Func_cgo)), (C.Sha3256Func)(unsafe.Pointer(C.Sha3256Func_cgo)), (C.Ripemd160Func)(unsafe.Pointer(C.Ripemd160Func_cgo)), (C.RecoverAddressFunc)(unsafe.Pointer(C.RecoverAddressFunc_cgo)))
 }
 
 // DisposeV8Engine dispose the v8 engine.
@@ -131,10 +149,14 @@ func NewV8Engine(ctx *Context) *V8Engine {
 		InitV8Engine()
 	})
 
+	return newV8Engine(ctx, C.CreateEngine())
+}
+
+func newV8Engine(ctx *Context, v8engine *C.V8Engine) *V8Engine {
 	engine := &V8Engine{
 		ctx:      ctx,
 		modules:  NewModules(),
-		v8engine: C.CreateEngine(),
+		v8engine: v8engine,
 		strictDisallowUsageOfInstructionCounter: 1, // enable by default.
 		enableLimits:                            true,
 		limitsOfExecutionInstructions:           0,
@@ -164,6 +186,14 @@ func NewV8Engine(ctx *Context) *V8Engine {
 	return engine
 }
 
+// gasTable return the gas table of the engine, the default one if the engine has no context.
+func (e *V8Engine) gasTable() *core.GasTable {
+	if e == nil || e.ctx == nil || e.ctx.gasTable == nil {
+		return core.DefaultGasSchedule().Table
+	}
+	return e.ctx.gasTable
+}
+
 // SetEnableLimit eval switch
 func (e *V8Engine) SetEnableLimit(isLimit bool) {
 	e.enableLimits = isLimit
@@ -180,6 +210,15 @@ func (e *V8Engine) Dispose() {
 	delete(engines, e.v8engine)
 	enginesLock.Unlock()
 
+	if e.pool != nil {
+		// the terminated isolates are not reused.
+		if e.v8engine.is_requested_terminate_execution == 0 {
+			C.ResetEngine(e.v8engine)
+			e.pool.put(e.v8engine)
+			return
+		}
+		metricsEnginePoolDiscard.Mark(1)
+	}
 	C.DeleteEngine(e.v8engine)
 }
 
@@ -244,13 +283,57 @@ func (e *V8Engine) TranspileTypeScript(source string) (string, int, error) {
 
 }
 
+// PublicFunctions parse the source and return the functions callable by call payloads.
+func (e *V8Engine) PublicFunctions(source, sourceType string) ([]string, error) {
+	switch sourceType {
+	case core.SourceTypeJavaScript:
+	case core.SourceTypeTypeScript:
+		jsSource, _, err := e.TranspileTypeScript(source)
+		if err != nil {
+			return nil, err
+		}
+		source = jsSource
+	default:
+		return nil, ErrUnsupportedSourceType
+	}
+
+	cSource := C.CString(source)
+	defer C.free(unsafe.Pointer(cSource))
+
+	cFunctions := C.ParsePublicFunctions(e.v8engine, cSource)
+	if cFunctions == nil {
+		return nil, ErrParsePublicFunctionsFailed
+	}
+	defer C.free(unsafe.Pointer(cFunctions))
+
+	var names []string
+	if err := json.Unmarshal([]byte(C.GoString(cFunctions)), &names); err != nil {
+		return nil, err
+	}
+	// the same rules as Call.
+	functions := []string{}
+	for _, name := range names {
+		if core.PublicFuncNameChecker.MatchString(name) && !strings.EqualFold("init", name) {
+			functions = append(functions, name)
+		}
+	}
+	return functions, nil
+}
+
 // InjectTracingInstructions process the source to inject tracing instructions.
 func (e *V8Engine) InjectTracingInstructions(source string) (string, int, error) {
 	cSource := C.CString(source)
 	defer C.free(unsafe.Pointer(cSource))
 
+	weights, err := e.gasTable().ExpressionsJSON()
+	if err != nil {
+		return "", 0, err
+	}
+	cWeights := C.CString(weights)
+	defer C.free(unsafe.Pointer(cWeights))
+
 	lineOffset := C.int(0)
-	traceableCSource := C.InjectTracingInstructions(e.v8engine, cSource, &lineOffset, C.int(e.strictDisallowUsageOfInstructionCounter))
+	traceableCSource := C.InjectTracingInstructions(e.v8engine, cSource, &lineOffset, C.int(e.strictDisallowUsageOfInstructionCounter), cWeights)
 
 	if traceableCSource == nil {
 		return "", 0, ErrInjectTracingInstructionFailed
@@ -384,7 +467,12 @@ func (e *V8Engine) AddModule(id, source string, sourceLineOffset int) error {
 	// inject tracing instruction when enable limits.
 	if e.enableLimits {
 		var item *sourceModuleItem
-		sourceHash := byteutils.Hex(hash.Sha3256([]byte(source)))
+		weights, err := e.gasTable().ExpressionsJSON()
+		if err != nil {
+			return err
+		}
+		// the traceable source differs in the gas tables.
+		sourceHash := byteutils.Hex(hash.Sha3256([]byte(source), []byte(weights)))
 
 		// try read from cache.
 		if sourceModuleCache.Contains(sourceHash) { //ToDo cache whether need into db
@@ -455,16 +543,33 @@ func (e *V8Engine) prepareRunnableContractScript(source, function, args string)
 	} else {
 		argsInput = []byte("[]")
 	}
-	runnableSource = fmt.Sprintf(`Blockchain.blockParse("%s");
+	runnableSource = fmt.Sprintf(`%sBlockchain.blockParse("%s");
 									Blockchain.transactionParse("%s");
 									var __contract = require("%s");
 									var __instance = new __contract();
 									__instance["%s"].apply(__instance, JSON.parse("%s"));`,
-		formatArgs(string(blockJSON)), formatArgs(string(txJSON)),
+		availabilityScript(e.ctx.block), formatArgs(string(blockJSON)), formatArgs(string(txJSON)),
 		ModuleID, function, formatArgs(string(argsInput)))
 	return runnableSource, 0, nil
 }
 
+// availabilityScript return the script setting up the features by the height of block, the features
+// not available yet are undefined in contract as they were. It's kept in one line, so the lines of
+// runnable source don't change.
+func availabilityScript(block Block) string {
+	script := ""
+	if block.Height() < core.InnerContractCallAvailableHeight {
+		script += "delete Object.getPrototypeOf(Blockchain).call;"
+	}
+	if block.Height() < core.RecentBlockAvailableHeight {
+		script += "delete Object.getPrototypeOf(Blockchain).getBlock;"
+	}
+	if block.Height() >= core.CryptoAvailableHeight {
+		script += "const Crypto = require('crypto.js');"
+	}
+	return script
+}
+
 func getEngineByStorageHandler(handler uint64) (*V8Engine, Account) {
 	storagesLock.RLock()
 	engine := storages[handler]
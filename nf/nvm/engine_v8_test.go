@@ -43,6 +43,7 @@ import (
 	"github.com/nebulasio/go-nebulas/core"
 	"github.com/nebulasio/go-nebulas/core/state"
 	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
 	"github.com/nebulasio/go-nebulas/crypto/keystore"
 	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
 	"github.com/nebulasio/go-nebulas/storage"
@@ -91,6 +92,11 @@ func (block *testBlock) DateAvailable() bool {
 	return true
 }
 
+// RecentBlock mock
+func (block *testBlock) RecentBlock(height uint64) (*core.Block, error) {
+	return nil, core.ErrInvalidRecentBlockHeight
+}
+
 // GetTransaction mock
 func (block *testBlock) GetTransaction(hash byteutils.Hash) (*core.Transaction, error) {
 	return nil, nil
@@ -216,6 +222,38 @@ func TestRunScriptSourceInModule(t *testing.T) {
 	}
 }
 
+func TestPublicFunctions(t *testing.T) {
+	tests := []struct {
+		filepath   string
+		sourceType string
+		expected   []string
+	}{
+		{"./test/bank_vault_contract.js", "js", []string{"save", "takeout", "balanceOf", "verifyAddress"}},
+		{"./test/bank_vault_contract.ts", "ts", []string{"save", "takeout"}},
+		{"./test/inner_call_caller.js", "js", []string{"incr", "incrWithValue", "recover", "recurse"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filepath, func(t *testing.T) {
+			data, err := ioutil.ReadFile(tt.filepath)
+			assert.Nil(t, err, "filepath read error")
+
+			engine := NewV8Engine(nil)
+			functions, err := engine.PublicFunctions(string(data), tt.sourceType)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, functions)
+			engine.Dispose()
+		})
+	}
+
+	engine := NewV8Engine(nil)
+	defer engine.Dispose()
+	_, err := engine.PublicFunctions("var a = ;", "js")
+	assert.Equal(t, ErrParsePublicFunctionsFailed, err)
+	_, err = engine.PublicFunctions("", "go")
+	assert.Equal(t, ErrUnsupportedSourceType, err)
+}
+
 func TestRunScriptSourceWithLimits(t *testing.T) {
 	tests := []struct {
 		name                          string
@@ -823,6 +861,372 @@ func TestBlockChain(t *testing.T) {
 	}
 }
 
+func TestCrypto(t *testing.T) {
+	h := core.CryptoAvailableHeight
+	core.CryptoAvailableHeight = core.LocalCryptoAvailableHeight
+	defer func() { core.CryptoAvailableHeight = h }()
+
+	priv := secp256k1.GeneratePrivateKey()
+	pubData, err := priv.PublicKey().Encoded()
+	assert.Nil(t, err)
+	signer, err := core.NewAddressFromPublicKey(pubData)
+	assert.Nil(t, err)
+	data := hash.Sha3256([]byte("voucher"))
+	sign, err := priv.Sign(data)
+	assert.Nil(t, err)
+
+	source := fmt.Sprintf(`%s
+	function check(name, got, want) {
+		if (got !== want) {
+			throw new Error(name + ": " + got + " != " + want);
+		}
+	}
+	check("sha256", Crypto.sha256("nebulas"), "%s");
+	check("sha3256", Crypto.sha3256("nebulas"), "%s");
+	check("ripemd160", Crypto.ripemd160("nebulas"), "%s");
+	check("recoverAddress", Crypto.recoverAddress(1, "%s", "%s"), "%s");
+	check("invalid sign", Crypto.recoverAddress(1, "%s", "00"), null);
+	check("invalid alg", Crypto.recoverAddress(2, "%s", "%s"), null);
+	`, availabilityScript(mockBlock()),
+		byteutils.Hex(hash.Sha256([]byte("nebulas"))),
+		byteutils.Hex(hash.Sha3256([]byte("nebulas"))),
+		byteutils.Hex(hash.Ripemd160([]byte("nebulas"))),
+		byteutils.Hex(data), byteutils.Hex(sign), signer.String(),
+		byteutils.Hex(data),
+		byteutils.Hex(data), byteutils.Hex(sign))
+
+	mem, _ := storage.NewMemoryStorage()
+	context, _ := state.NewWorldState(dpos.NewDpos(), mem)
+	contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+	ctx, err := NewContext(mockBlock(), mockTransaction(), contract, context)
+	assert.Nil(t, err)
+
+	engine := NewV8Engine(ctx)
+	defer engine.Dispose()
+	engine.SetExecutionLimits(900000, 10000000)
+	_, err = engine.RunScriptSource(source, 0)
+	assert.Nil(t, err)
+	// the natives cost fixed gas.
+	table := core.DefaultGasSchedule().Table
+	assert.True(t, engine.ExecutionInstructions() >= 3*table.CryptoHash+3*table.CryptoRecoverAddress)
+
+	// Crypto is undefined before the height, and the natives cost no gas.
+	core.CryptoAvailableHeight = mockBlock().Height() + 1
+	source = fmt.Sprintf(`%s
+	if (typeof Crypto !== "undefined") {
+		throw new Error("Crypto is defined.");
+	}
+	if (_native_crypto.sha256("nebulas") !== null) {
+		throw new Error("sha256: not null");
+	}
+	if (_native_crypto.recoverAddress(1, "%s", "%s") !== null) {
+		throw new Error("recoverAddress: not null");
+	}
+	`, availabilityScript(mockBlock()), byteutils.Hex(data), byteutils.Hex(sign))
+	unavailable := NewV8Engine(ctx)
+	defer unavailable.Dispose()
+	unavailable.SetExecutionLimits(900000, 10000000)
+	_, err = unavailable.RunScriptSource(source, 0)
+	assert.Nil(t, err)
+	assert.True(t, unavailable.ExecutionInstructions() < table.CryptoHash)
+}
+
+func TestBlockchainGetBlock(t *testing.T) {
+	height := core.RecentBlockAvailableHeight
+	core.RecentBlockAvailableHeight = core.LocalRecentBlockAvailableHeight
+	defer func() { core.RecentBlockAvailableHeight = height }()
+
+	source := `
+	function expectThrow(name, f, want) {
+		try {
+			f();
+		} catch (e) {
+			var got = e instanceof Error ? e.message : e;
+			if (got !== want) {
+				throw new Error(name + ": " + got + " != " + want);
+			}
+			return;
+		}
+		throw new Error(name + ": no error");
+	}
+	expectThrow("no argument", function () { Blockchain.getBlock(); }, "Blockchain.getBlock() requires 1 argument");
+	expectThrow("invalid height", function () { Blockchain.getBlock("1"); }, "height must be non-negative number");
+	expectThrow("out of window", function () { Blockchain.getBlock(1); }, "Blockchain.getBlock() failed.");
+	`
+
+	mem, _ := storage.NewMemoryStorage()
+	context, _ := state.NewWorldState(dpos.NewDpos(), mem)
+	contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+	ctx, err := NewContext(mockBlock(), mockTransaction(), contract, context)
+	assert.Nil(t, err)
+
+	engine := NewV8Engine(ctx)
+	defer engine.Dispose()
+	engine.SetExecutionLimits(100000, 10000000)
+	_, err = engine.RunScriptSource(source, 0)
+	assert.Nil(t, err)
+	// the failed reads cost gas too.
+	assert.True(t, engine.ExecutionInstructions() >= core.DefaultGasSchedule().Table.GetBlock)
+}
+
+func TestBlockchainGetBlockUnavailable(t *testing.T) {
+	height := core.RecentBlockAvailableHeight
+	core.RecentBlockAvailableHeight = mockBlock().Height() + 1
+	defer func() { core.RecentBlockAvailableHeight = height }()
+
+	// Blockchain.getBlock behaves as the methods never defined before the available height.
+	source := `var Contract = function () {};
+	Contract.prototype = {
+		init: function () {},
+		get: function () {
+			return Blockchain.%s(1);
+		}
+	};
+	module.exports = Contract;`
+
+	call := func(method string) (uint64, error) {
+		mem, _ := storage.NewMemoryStorage()
+		context, _ := state.NewWorldState(dpos.NewDpos(), mem)
+		contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+		ctx, err := NewContext(mockBlock(), mockTransaction(), contract, context)
+		assert.Nil(t, err)
+
+		engine := NewV8Engine(ctx)
+		defer engine.Dispose()
+		engine.SetExecutionLimits(100000, 10000000)
+		_, err = engine.Call(fmt.Sprintf(source, method), "js", "get", "")
+		return engine.ExecutionInstructions(), err
+	}
+	instructions, err := call("getBlock")
+	undefinedInstructions, undefinedErr := call("getBlocc")
+	assert.NotNil(t, err)
+	assert.NotNil(t, undefinedErr)
+	assert.Equal(t, undefinedInstructions, instructions)
+}
+
+func TestStorageIteration(t *testing.T) {
+	h := core.StorageIterationAvailableHeight
+	core.StorageIterationAvailableHeight = core.LocalStorageIterationAvailableHeight
+	defer func() { core.StorageIterationAvailableHeight = h }()
+
+	source := `
+	function check(name, got, want) {
+		if (JSON.stringify(got) !== JSON.stringify(want)) {
+			throw new Error(name + ": " + JSON.stringify(got) + " != " + JSON.stringify(want));
+		}
+	}
+	var obj = {};
+	LocalContractStorage.defineMapProperty(obj, "balances");
+	for (var i = 0; i < 5; i++) {
+		obj.balances.set("addr" + i, i);
+	}
+	obj.balances.del("addr2");
+
+	var keys = obj.balances.keys();
+	check("keys", keys.slice().sort(), ["addr0", "addr1", "addr3", "addr4"]);
+	check("page", obj.balances.keys(1, 2), keys.slice(1, 3));
+	check("end", obj.balances.keys(4, 2), []);
+
+	var sum = 0;
+	obj.balances.forEach(function (value, key) {
+		check("value of " + key, value, obj.balances.get(key));
+		sum += value;
+	});
+	check("sum", sum, 8);
+
+	LocalContractStorage.defineMapProperty(obj, "empty");
+	check("empty", obj.empty.keys(), []);
+
+	try {
+		obj.balances.keys(0, 101);
+		throw new Error("limit should be checked.");
+	} catch (e) {
+		check("limit", e.message, "limit must be integer in (0, 100].");
+	}
+	`
+
+	mem, _ := storage.NewMemoryStorage()
+	context, _ := state.NewWorldState(dpos.NewDpos(), mem)
+	contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+	ctx, err := NewContext(mockBlock(), mockTransaction(), contract, context)
+	assert.Nil(t, err)
+
+	engine := NewV8Engine(ctx)
+	defer engine.Dispose()
+	engine.SetExecutionLimits(900000, 10000000)
+	_, err = engine.RunScriptSource(source, 0)
+	assert.Nil(t, err)
+
+	keys, visited, err := iterateStorageKeys(contract, "balances", 1, MaxStorageIteratePageSize)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(keys))
+	assert.Equal(t, 4, visited)
+
+	// the keys set before the height are not indexed.
+	core.StorageIterationAvailableHeight = h
+	lateEngine := NewV8Engine(ctx)
+	defer lateEngine.Dispose()
+	lateEngine.SetExecutionLimits(900000, 10000000)
+	_, err = lateEngine.RunScriptSource(`var obj = {}; LocalContractStorage.defineMapProperty(obj, "late"); obj.late.set("k", 1);`, 0)
+	assert.Nil(t, err)
+	keys, _, err = iterateStorageKeys(contract, "late", 0, MaxStorageIteratePageSize)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(keys))
+
+	// the map having keys set before the height is not iterable.
+	core.StorageIterationAvailableHeight = core.LocalStorageIterationAvailableHeight
+	partialEngine := NewV8Engine(ctx)
+	defer partialEngine.Dispose()
+	partialEngine.SetExecutionLimits(900000, 10000000)
+	_, err = partialEngine.RunScriptSource(`
+	var obj = {};
+	LocalContractStorage.defineMapProperty(obj, "late");
+	obj.late.set("j", 2);
+	try {
+		obj.late.keys();
+		throw new Error("partially indexed map should not be iterable.");
+	} catch (e) {
+		if (e.message !== "iterate keys of late failed, the iteration is not available yet or the map has keys set before it.") {
+			throw e;
+		}
+	}
+	`, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, ErrPartiallyIndexedStorage, checkStorageIndexState(contract, "late"))
+	assert.Nil(t, checkStorageIndexState(contract, "balances"))
+}
+
+func TestEnginePool(t *testing.T) {
+	assert.Equal(t, ErrInvalidEnginePoolSize, SetEnginePoolSize(-1))
+	assert.Equal(t, ErrInvalidEnginePoolSize, SetEnginePoolSize(MaxEnginePoolSize+1))
+
+	assert.Nil(t, SetEnginePoolSize(2))
+	defer SetEnginePoolSize(0)
+	assert.Equal(t, 2, len(enginePool.idle))
+
+	mem, _ := storage.NewMemoryStorage()
+	context, _ := state.NewWorldState(dpos.NewDpos(), mem)
+	contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+	ctx, err := NewContext(mockBlock(), mockTransaction(), contract, context)
+	assert.Nil(t, err)
+
+	run := func() uint64 {
+		engine := borrowV8Engine(ctx)
+		defer engine.Dispose()
+		engine.SetExecutionLimits(100000, 10000000)
+		source, _, err := engine.InjectTracingInstructions("var a = 0; for (var i = 0; i < 100; i++) { a += i; }")
+		assert.Nil(t, err)
+		_, err = engine.RunScriptSource(source, 0)
+		assert.Nil(t, err)
+		return engine.ExecutionInstructions()
+	}
+
+	// the reused isolates are reset.
+	want := run()
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, want, run())
+	}
+	assert.Equal(t, 2, len(enginePool.idle))
+
+	// the engines over the size are deleted.
+	engines := []*V8Engine{borrowV8Engine(ctx), borrowV8Engine(ctx), borrowV8Engine(ctx)}
+	assert.Equal(t, 0, len(enginePool.idle))
+	for _, engine := range engines {
+		engine.Dispose()
+	}
+	assert.Equal(t, 2, len(enginePool.idle))
+
+	// the terminated isolates are not reused.
+	engine := borrowV8Engine(ctx)
+	engine.SetExecutionLimits(100, 10000000)
+	source, _, err := engine.InjectTracingInstructions("while (true) {}")
+	assert.Nil(t, err)
+	_, err = engine.RunScriptSource(source, 0)
+	assert.Equal(t, ErrInsufficientGas, err)
+	engine.Dispose()
+	assert.Equal(t, 1, len(enginePool.idle))
+}
+
+func TestCodeCache(t *testing.T) {
+	data, err := ioutil.ReadFile("./test/bank_vault_contract.js")
+	assert.Nil(t, err)
+
+	cache, _ := storage.NewMemoryStorage()
+	assert.Nil(t, EnableCodeCache(cache))
+	defer EnableCodeCache(nil)
+	ks, err := storage.OpenKeyspace(cache, storage.CodeCacheKeyspace)
+	assert.Nil(t, err)
+
+	mem, _ := storage.NewMemoryStorage()
+	context, _ := state.NewWorldState(dpos.NewDpos(), mem)
+	contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+	ctx, err := NewContext(mockBlock(), mockTransaction(), contract, context)
+	assert.Nil(t, err)
+
+	call := func() (string, uint64) {
+		engine := NewV8Engine(ctx)
+		defer engine.Dispose()
+		engine.SetExecutionLimits(100000, 10000000)
+		result, err := engine.Call(string(data), "js", "verifyAddress", "[\"n1FkntVUMPAsESuCAAPK711omQk19JotBjM\"]")
+		assert.Nil(t, err)
+		return result, engine.ExecutionInstructions()
+	}
+
+	// the first call produces the cache.
+	result, gas := call()
+	value, err := ks.Get(contract.Address())
+	assert.Nil(t, err)
+	assert.True(t, len(value) > 32)
+
+	// the cached code gets the same result and gas.
+	cachedResult, cachedGas := call()
+	assert.Equal(t, result, cachedResult)
+	assert.Equal(t, gas, cachedGas)
+
+	// the rejected cache is removed.
+	assert.Nil(t, ks.Put(contract.Address(), append(value[:32], []byte("broken code")...)))
+	rejectedResult, rejectedGas := call()
+	assert.Equal(t, result, rejectedResult)
+	assert.Equal(t, gas, rejectedGas)
+	_, err = ks.Get(contract.Address())
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+}
+
+func TestGasTable(t *testing.T) {
+	data, err := ioutil.ReadFile("./test/bank_vault_contract.js")
+	assert.Nil(t, err)
+
+	mem, _ := storage.NewMemoryStorage()
+	context, _ := state.NewWorldState(dpos.NewDpos(), mem)
+	contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+	ctx, err := NewContext(mockBlock(), mockTransaction(), contract, context)
+	assert.Nil(t, err)
+
+	call := func() uint64 {
+		engine := NewV8Engine(ctx)
+		defer engine.Dispose()
+		engine.SetExecutionLimits(100000, 10000000)
+		_, err := engine.Call(string(data), "js", "verifyAddress", "[\"n1FkntVUMPAsESuCAAPK711omQk19JotBjM\"]")
+		assert.Nil(t, err)
+		return engine.ExecutionInstructions()
+	}
+	gas := call()
+
+	// the doubled counts of expressions and natives cost more gas.
+	table := *core.DefaultGasSchedule().Table
+	table.Expressions = make(map[string]uint64)
+	for name, count := range core.DefaultGasSchedule().Table.Expressions {
+		table.Expressions[name] = count * 2
+	}
+	table.VerifyAddress *= 2
+	ctx.gasTable = &table
+	assert.True(t, call() > gas+core.DefaultGasSchedule().Table.VerifyAddress)
+
+	// the default table is restored.
+	ctx.gasTable = core.DefaultGasSchedule().Table
+	assert.Equal(t, gas, call())
+}
+
 func TestBankVaultContract(t *testing.T) {
 	type TakeoutTest struct {
 		args          string
@@ -1545,8 +1949,10 @@ func MockGenesisConf() *corepb.Genesis {
 	return &corepb.Genesis{
 		Meta: &corepb.GenesisMeta{ChainId: 0},
 		Consensus: &corepb.GenesisConsensus{
-			Dpos: &corepb.GenesisConsensusDpos{
-				Dynasty: dynasty,
+			Engine: &corepb.GenesisConsensus_Dpos{
+				Dpos: &corepb.GenesisConsensusDpos{
+					Dynasty: dynasty,
+				},
 			},
 		},
 		TokenDistribution: []*corepb.GenesisTokenDistribution{
@@ -1743,3 +2149,236 @@ func TestInnerTransactions(t *testing.T) {
 		// check
 	}
 }
+
+// mockContractBlock mint a block by coinbase on tail with the txs signed by their senders.
+func mockContractBlock(t *testing.T, neb *Neb, coinbase *core.Address, txs []*core.Transaction) *core.Block {
+	tail := neb.chain.TailBlock()
+	consensusState, err := tail.WorldState().NextConsensusState(dpos.BlockIntervalInMs / dpos.SecondInMs)
+	assert.Nil(t, err)
+	block, err := core.NewBlock(neb.chain.ChainID(), coinbase, tail)
+	assert.Nil(t, err)
+	block.WorldState().SetConsensusState(consensusState)
+	block.SetTimestamp(consensusState.TimeStamp())
+
+	for _, tx := range txs {
+		assert.Nil(t, neb.am.SignTransaction(tx.From(), tx))
+		assert.Nil(t, neb.chain.TransactionPool().Push(tx))
+	}
+	block.CollectTransactions((time.Now().Unix() + 1) * dpos.SecondInMs)
+	assert.Equal(t, len(txs), len(block.Transactions()))
+	assert.Nil(t, block.Seal())
+	assert.Nil(t, neb.am.SignBlock(coinbase, block))
+	assert.Nil(t, neb.chain.BlockPool().Push(block))
+	assert.Equal(t, block.Hash(), neb.chain.TailBlock().Hash())
+	return block
+}
+
+func TestInnerContractCall(t *testing.T) {
+	h := core.InnerContractCallAvailableHeight
+	core.InnerContractCallAvailableHeight = core.LocalInnerContractCallAvailableHeight
+	defer func() { core.InnerContractCallAvailableHeight = h }()
+
+	neb := mockNeb(t)
+	a, _ := core.AddressParse("n1FF1nz6tarkDVwWQkMnnwFPuPKUaQTdptE")
+	assert.Nil(t, neb.am.Unlock(a, []byte("passphrase"), keystore.YearUnlockDuration))
+	b, _ := core.AddressParse("n1GmkKH6nBMw4rrjt16RrJ9WcgvKUtAZP1s")
+	assert.Nil(t, neb.am.Unlock(b, []byte("passphrase"), keystore.YearUnlockDuration))
+	c, _ := core.AddressParse("n1H4MYms9F55ehcvygwWE71J8tJC4CRr2so")
+	assert.Nil(t, neb.am.Unlock(c, []byte("passphrase"), keystore.YearUnlockDuration))
+
+	gasLimit, _ := util.NewUint128FromInt(1000000)
+	nonce := uint64(0)
+	newTx := func(to *core.Address, value int64, payloadType string, payload []byte) *core.Transaction {
+		nonce++
+		v, _ := util.NewUint128FromInt(value)
+		tx, err := core.NewTransaction(neb.chain.ChainID(), a, to, v, nonce, payloadType, payload, core.TransactionGasPrice, gasLimit)
+		assert.Nil(t, err)
+		return tx
+	}
+
+	// deploy the callee and the caller.
+	addrs := []*core.Address{}
+	txs := []*core.Transaction{}
+	for _, path := range []string{"./test/inner_call_callee.js", "./test/inner_call_caller.js"} {
+		data, err := ioutil.ReadFile(path)
+		assert.Nil(t, err, "contract path read error")
+		deploy, _ := core.NewDeployPayload(string(data), "js", "")
+		payload, _ := deploy.ToBytes()
+		tx := newTx(a, 0, core.TxPayloadDeployType, payload)
+		addr, err := tx.GenerateContractAddress()
+		assert.Nil(t, err)
+		addrs = append(addrs, addr)
+		txs = append(txs, tx)
+	}
+	mockContractBlock(t, neb, b, txs)
+	callee, caller := addrs[0], addrs[1]
+
+	tests := []struct {
+		function string
+		args     string
+		value    int64
+		status   int8
+	}{
+		{"incr", fmt.Sprintf("[\"%s\", 2]", callee), 0, core.TxExecutionSuccess},
+		{"incrWithValue", fmt.Sprintf("[\"%s\", 3, \"5\"]", callee), 10, core.TxExecutionSuccess},
+		{"recover", fmt.Sprintf("[\"%s\"]", callee), 0, core.TxExecutionSuccess},
+		{"recurse", fmt.Sprintf("[\"%s\"]", caller), 0, core.TxExecutionFailed},
+	}
+	txs = []*core.Transaction{}
+	for _, tt := range tests {
+		call, _ := core.NewCallPayload(tt.function, tt.args)
+		payload, _ := call.ToBytes()
+		txs = append(txs, newTx(caller, tt.value, core.TxPayloadCallType, payload))
+	}
+	block := mockContractBlock(t, neb, c, txs)
+
+	for i, tt := range tests {
+		t.Run(tt.function, func(t *testing.T) {
+			event, err := block.FetchExecutionResultEvent(txs[i].Hash())
+			assert.Nil(t, err)
+			txEvent := new(core.TransactionEventV2)
+			assert.Nil(t, json.Unmarshal([]byte(event.Data), txEvent))
+			assert.Equal(t, tt.status, txEvent.Status, txEvent.Error)
+		})
+	}
+
+	// the inner calls are recorded in the events of the tx.
+	events, err := block.FetchEvents(txs[0].Hash())
+	assert.Nil(t, err)
+	assert.Equal(t, core.TopicInnerContractCall, events[0].Topic)
+	innerCall := new(InnerContractCallEvent)
+	assert.Nil(t, json.Unmarshal([]byte(events[0].Data), innerCall))
+	assert.Equal(t, caller.String(), innerCall.From)
+	assert.Equal(t, callee.String(), innerCall.To)
+	assert.Equal(t, "incr", innerCall.Function)
+	assert.Equal(t, 1, innerCall.Depth)
+	assert.Equal(t, "", innerCall.Error)
+
+	// the failed call is rolled back.
+	events, err = block.FetchEvents(txs[2].Hash())
+	assert.Nil(t, err)
+	assert.Nil(t, json.Unmarshal([]byte(events[0].Data), innerCall))
+	assert.NotEqual(t, "", innerCall.Error)
+
+	get, _ := core.NewCallPayload("get", "")
+	payload, _ := get.ToBytes()
+	result, err := neb.chain.SimulateTransactionExecution(newTx(callee, 0, core.TxPayloadCallType, payload))
+	assert.Nil(t, err)
+	assert.Nil(t, result.Err)
+	assert.Equal(t, "5", result.Msg)
+
+	// the value of the call is transferred from caller to callee.
+	acc, err := block.GetAccount(callee.Bytes())
+	assert.Nil(t, err)
+	assert.Equal(t, "5", acc.Balance().String())
+	acc, err = block.GetAccount(caller.Bytes())
+	assert.Nil(t, err)
+	assert.Equal(t, "5", acc.Balance().String())
+
+	// Blockchain.call is undefined before the available height.
+	core.InnerContractCallAvailableHeight = block.Height() + 2
+	acc, err = block.GetAccount(a.Bytes())
+	assert.Nil(t, err)
+	nonce = acc.Nonce()
+	call, _ := core.NewCallPayload("incr", fmt.Sprintf("[\"%s\", 2]", callee))
+	payload, _ = call.ToBytes()
+	tx := newTx(caller, 0, core.TxPayloadCallType, payload)
+	block = mockContractBlock(t, neb, c, []*core.Transaction{tx})
+	event, err := block.FetchExecutionResultEvent(tx.Hash())
+	assert.Nil(t, err)
+	txEvent := new(core.TransactionEventV2)
+	assert.Nil(t, json.Unmarshal([]byte(event.Data), txEvent))
+	assert.Equal(t, core.TxExecutionFailed, txEvent.Status)
+	assert.Contains(t, txEvent.Error, "Blockchain.call is not a function")
+}
+
+func TestUpgradeContract(t *testing.T) {
+	h := core.ContractUpgradeAvailableHeight
+	core.ContractUpgradeAvailableHeight = core.LocalContractUpgradeAvailableHeight
+	defer func() { core.ContractUpgradeAvailableHeight = h }()
+
+	neb := mockNeb(t)
+	a, _ := core.AddressParse("n1FF1nz6tarkDVwWQkMnnwFPuPKUaQTdptE")
+	assert.Nil(t, neb.am.Unlock(a, []byte("passphrase"), keystore.YearUnlockDuration))
+	b, _ := core.AddressParse("n1GmkKH6nBMw4rrjt16RrJ9WcgvKUtAZP1s")
+	assert.Nil(t, neb.am.Unlock(b, []byte("passphrase"), keystore.YearUnlockDuration))
+
+	gasLimit, _ := util.NewUint128FromInt(1000000)
+	nonce := uint64(0)
+	newTx := func(to *core.Address, payloadType string, payload []byte) *core.Transaction {
+		nonce++
+		tx, err := core.NewTransaction(neb.chain.ChainID(), a, to, util.NewUint128(), nonce, payloadType, payload, core.TransactionGasPrice, gasLimit)
+		assert.Nil(t, err)
+		return tx
+	}
+	source := `var Counter = function() {
+		LocalContractStorage.defineProperty(this, "count");
+	};
+	Counter.prototype = {
+		init: function() {
+			this.count = 1;
+		},
+		incr: function() {
+			this.count += %d;
+		},
+		get: function() {
+			return this.count;
+		}
+	};
+	module.exports = Counter;`
+
+	deploy, _ := core.NewDeployPayload(fmt.Sprintf(source, 1), core.SourceTypeJavaScript, "")
+	payload, _ := deploy.ToBytes()
+	tx := newTx(a, core.TxPayloadDeployType, payload)
+	contract, err := tx.GenerateContractAddress()
+	assert.Nil(t, err)
+	mockContractBlock(t, neb, b, []*core.Transaction{tx})
+	incr, _ := core.NewCallPayload("incr", "")
+	payload, _ = incr.ToBytes()
+	mockContractBlock(t, neb, b, []*core.Transaction{newTx(contract, core.TxPayloadCallType, payload)})
+
+	// the broken source fails the upgrade, and the contract is not frozen with it.
+	upgrades := []*core.Transaction{}
+	for _, src := range []string{`var Counter = function() {`, fmt.Sprintf(source, 10)} {
+		upgrade, err := core.NewUpgradePayload(src, core.SourceTypeJavaScript, len(upgrades) == 0)
+		assert.Nil(t, err)
+		payload, _ = upgrade.ToBytes()
+		upgrades = append(upgrades, newTx(contract, core.TxPayloadUpgradeType, payload))
+	}
+	block := mockContractBlock(t, neb, b, upgrades)
+	for i, status := range []int8{core.TxExecutionFailed, core.TxExecutionSuccess} {
+		event, err := block.FetchExecutionResultEvent(upgrades[i].Hash())
+		assert.Nil(t, err)
+		txEvent := new(core.TransactionEventV2)
+		assert.Nil(t, json.Unmarshal([]byte(event.Data), txEvent))
+		assert.Equal(t, status, txEvent.Status, txEvent.Error)
+		if status == core.TxExecutionFailed {
+			assert.Equal(t, core.ErrInvalidUpgradeSource.Error(), txEvent.Error)
+		}
+	}
+	acc, err := block.WorldState().GetContractAccount(contract.Bytes())
+	assert.Nil(t, err)
+	frozen, err := core.ContractFrozen(acc)
+	assert.Nil(t, err)
+	assert.False(t, frozen)
+	version, err := core.ContractVersion(acc)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), version)
+
+	// the upgraded source runs on the storage kept from the deployed one.
+	payload, _ = incr.ToBytes()
+	tx = newTx(contract, core.TxPayloadCallType, payload)
+	block = mockContractBlock(t, neb, b, []*core.Transaction{tx})
+	event, err := block.FetchExecutionResultEvent(tx.Hash())
+	assert.Nil(t, err)
+	txEvent := new(core.TransactionEventV2)
+	assert.Nil(t, json.Unmarshal([]byte(event.Data), txEvent))
+	assert.Equal(t, core.TxExecutionSuccess, txEvent.Status, txEvent.Error)
+
+	get, _ := core.NewCallPayload("get", "")
+	payload, _ = get.ToBytes()
+	result, err := neb.chain.SimulateTransactionExecution(newTx(contract, core.TxPayloadCallType, payload))
+	assert.Nil(t, err)
+	assert.Nil(t, result.Err)
+	assert.Equal(t, "12", result.Msg)
+}
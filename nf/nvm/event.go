@@ -27,11 +27,6 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-const (
-	// EventBaseGasCount the gas count of a new event
-	EventBaseGasCount = 20
-)
-
 // TransferFromContractEvent event for transfer in contract
 type TransferFromContractEvent struct {
 	Amount string `json:"amount"`
@@ -39,6 +34,17 @@ type TransferFromContractEvent struct {
 	To     string `json:"to"`
 }
 
+// InnerContractCallEvent event for a contract called by another contract
+type InnerContractCallEvent struct {
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Value    string `json:"value"`
+	Function string `json:"function"`
+	Args     string `json:"args"`
+	Depth    int    `json:"depth"`
+	Error    string `json:"error"`
+}
+
 // EventTriggerFunc export EventTriggerFunc
 //export EventTriggerFunc
 func EventTriggerFunc(handler unsafe.Pointer, topic, data *C.char, gasCnt *C.size_t) {
@@ -56,7 +62,8 @@ func EventTriggerFunc(handler unsafe.Pointer, topic, data *C.char, gasCnt *C.siz
 	}
 
 	// calculate Gas.
-	*gasCnt = C.size_t(EventBaseGasCount + len(gTopic) + len(gData))
+	table := e.gasTable()
+	*gasCnt = C.size_t(table.EventBase + uint64(len(gTopic)+len(gData))*table.EventByte)
 
 	contractTopic := EventNameSpaceContract + "." + gTopic
 	event := &state.Event{Topic: contractTopic, Data: gData}
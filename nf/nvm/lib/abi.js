@@ -0,0 +1 @@
+../v8/lib/abi.js
\ No newline at end of file
@@ -21,11 +21,14 @@ package nvm
 import "C"
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"regexp"
 	"unsafe"
 
 	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core"
 	"github.com/nebulasio/go-nebulas/util/logging"
 	"github.com/sirupsen/logrus"
 )
@@ -39,10 +42,32 @@ var (
 		};
 	*/
 	StorageKeyPattern = regexp.MustCompile("^@([a-zA-Z_$][a-zA-Z0-9_]+?)\\[(.*?)\\]$")
+	// StorageFieldPattern the pattern of the field name of storage maps
+	StorageFieldPattern = regexp.MustCompile("^[a-zA-Z_$][a-zA-Z0-9_]+$")
 	// DefaultDomainKey the default domain key
 	DefaultDomainKey = "_"
 	// ErrInvalidStorageKey invalid storage key error
 	ErrInvalidStorageKey = errors.New("invalid storage key")
+	// ErrPartiallyIndexedStorage the map has keys set before the iteration is available
+	ErrPartiallyIndexedStorage = errors.New("the storage map has keys set before the iteration is available")
+
+	// the index states of storage maps
+	storageIndexComplete = []byte{1}
+	storageIndexPartial  = []byte{0}
+)
+
+const (
+	// storageIndexDomain the domain of the keys indexing the items of storage maps, it
+	// cannot be reached by contract code since it is not a valid field name.
+	storageIndexDomain = ".keys"
+
+	// storageIndexStateDomain the domain of the index state of storage maps, it's recorded on the
+	// first indexed write of a map. A map is partially indexed if it had items then, they were set
+	// before StorageIterationAvailableHeight, and its keys are not iterable.
+	storageIndexStateDomain = ".indexed"
+
+	// MaxStorageIteratePageSize the max count of keys iterated in a page.
+	MaxStorageIteratePageSize = 100
 )
 
 // hashStorageKey return the key hash.
@@ -60,6 +85,55 @@ func parseStorageKey(key string) (string, string, error) {
 	return matches[0][1], matches[0][2], nil
 }
 
+// indexStorageKey return whether the map item is indexed to be iterable.
+func indexStorageKey(engine *V8Engine, domainKey string) bool {
+	return domainKey != DefaultDomainKey && engine.ctx.block != nil && engine.ctx.block.Height() >= core.StorageIterationAvailableHeight
+}
+
+// recordStorageIndexState record the index state of the map field on its first indexed write,
+// it should be called before the item is written. Return whether the state is recorded.
+func recordStorageIndexState(storage Account, field string) (bool, error) {
+	key := trie.HashDomains(storageIndexStateDomain, field)
+	if _, err := storage.Get(key); err != ErrKeyNotFound {
+		return false, err
+	}
+
+	// all items of the field existing now were set before the keys are indexed.
+	state := storageIndexComplete
+	iter, err := storage.Iterator(trie.HashDomainsPrefix(field))
+	if err != nil && err != ErrKeyNotFound {
+		return false, err
+	}
+	if err == nil {
+		exist, err := iter.Next()
+		if err != nil {
+			return false, err
+		}
+		if exist {
+			state = storageIndexPartial
+		}
+	}
+	if err := storage.Put(key, state); err != nil && err != ErrKeyNotFound {
+		return false, err
+	}
+	return true, nil
+}
+
+// checkStorageIndexState return ErrPartiallyIndexedStorage if the map field has keys not indexed.
+func checkStorageIndexState(storage Account, field string) error {
+	state, err := storage.Get(trie.HashDomains(storageIndexStateDomain, field))
+	if err != nil {
+		if err == ErrKeyNotFound {
+			return nil
+		}
+		return err
+	}
+	if bytes.Equal(state, storageIndexPartial) {
+		return ErrPartiallyIndexedStorage
+	}
+	return nil
+}
+
 // StorageGetFunc export StorageGetFunc
 //export StorageGetFunc
 func StorageGetFunc(handler unsafe.Pointer, key *C.char, gasCnt *C.size_t) *C.char {
@@ -102,7 +176,7 @@ func StorageGetFunc(handler unsafe.Pointer, key *C.char, gasCnt *C.size_t) *C.ch
 // StoragePutFunc export StoragePutFunc
 //export StoragePutFunc
 func StoragePutFunc(handler unsafe.Pointer, key *C.char, value *C.char, gasCnt *C.size_t) int {
-	_, storage := getEngineByStorageHandler(uint64(uintptr(handler)))
+	engine, storage := getEngineByStorageHandler(uint64(uintptr(handler)))
 	if storage == nil {
 		logging.VLog().Error("Failed to get storage handler.")
 		return 1
@@ -112,7 +186,8 @@ func StoragePutFunc(handler unsafe.Pointer, key *C.char, value *C.char, gasCnt *
 	v := []byte(C.GoString(value))
 
 	// calculate Gas.
-	*gasCnt = C.size_t(len(k) + len(v))
+	table := engine.gasTable()
+	*gasCnt = C.size_t(uint64(len(k)+len(v)) * table.StorageByte)
 
 	domainKey, itemKey, err := parseStorageKey(k)
 	if err != nil {
@@ -124,6 +199,22 @@ func StoragePutFunc(handler unsafe.Pointer, key *C.char, value *C.char, gasCnt *
 		return 1
 	}
 
+	index := indexStorageKey(engine, domainKey)
+	if index {
+		recorded, err := recordStorageIndexState(storage, domainKey)
+		if err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"handler": uint64(uintptr(handler)),
+				"key":     k,
+				"err":     err,
+			}).Debug("StoragePutFunc record index state failed.")
+			return 1
+		}
+		if recorded {
+			*gasCnt += C.size_t(uint64(len(domainKey)+len(storageIndexComplete)) * table.StorageByte)
+		}
+	}
+
 	err = storage.Put(trie.HashDomains(domainKey, itemKey), v)
 	if err != nil && err != ErrKeyNotFound {
 		logging.VLog().WithFields(logrus.Fields{
@@ -134,13 +225,26 @@ func StoragePutFunc(handler unsafe.Pointer, key *C.char, value *C.char, gasCnt *
 		return 1
 	}
 
+	if index {
+		*gasCnt += C.size_t(uint64(len(itemKey)) * table.StorageByte)
+		err = storage.Put(trie.HashDomains(storageIndexDomain, domainKey, itemKey), []byte(itemKey))
+		if err != nil && err != ErrKeyNotFound {
+			logging.VLog().WithFields(logrus.Fields{
+				"handler": uint64(uintptr(handler)),
+				"key":     k,
+				"err":     err,
+			}).Debug("StoragePutFunc put key index failed.")
+			return 1
+		}
+	}
+
 	return 0
 }
 
 // StorageDelFunc export StorageDelFunc
 //export StorageDelFunc
 func StorageDelFunc(handler unsafe.Pointer, key *C.char, gasCnt *C.size_t) int {
-	_, storage := getEngineByStorageHandler(uint64(uintptr(handler)))
+	engine, storage := getEngineByStorageHandler(uint64(uintptr(handler)))
 	if storage == nil {
 		logging.VLog().Error("Failed to get storage handler.")
 		return 1
@@ -161,6 +265,18 @@ func StorageDelFunc(handler unsafe.Pointer, key *C.char, gasCnt *C.size_t) int {
 		return 1
 	}
 
+	index := indexStorageKey(engine, domainKey)
+	if index {
+		if _, err := recordStorageIndexState(storage, domainKey); err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"handler": uint64(uintptr(handler)),
+				"key":     k,
+				"err":     err,
+			}).Debug("StorageDelFunc record index state failed.")
+			return 1
+		}
+	}
+
 	err = storage.Del(trie.HashDomains(domainKey, itemKey))
 	if err != nil && err != ErrKeyNotFound {
 		logging.VLog().WithFields(logrus.Fields{
@@ -171,5 +287,103 @@ func StorageDelFunc(handler unsafe.Pointer, key *C.char, gasCnt *C.size_t) int {
 		return 1
 	}
 
+	if index {
+		err = storage.Del(trie.HashDomains(storageIndexDomain, domainKey, itemKey))
+		if err != nil && err != ErrKeyNotFound {
+			logging.VLog().WithFields(logrus.Fields{
+				"handler": uint64(uintptr(handler)),
+				"key":     k,
+				"err":     err,
+			}).Debug("StorageDelFunc del key index failed.")
+			return 1
+		}
+	}
+
 	return 0
 }
+
+// StorageIterateFunc export StorageIterateFunc, returns the keys of the map field in a page in JSON.
+// Only the keys set since StorageIterationAvailableHeight are indexed, so the map having keys set
+// before it fails with ErrPartiallyIndexedStorage.
+//export StorageIterateFunc
+func StorageIterateFunc(handler unsafe.Pointer, field *C.char, offset C.int, limit C.int, gasCnt *C.size_t) *C.char {
+	engine, storage := getEngineByStorageHandler(uint64(uintptr(handler)))
+	if storage == nil {
+		logging.VLog().Error("Failed to get storage handler.")
+		return nil
+	}
+
+	f := C.GoString(field)
+
+	// calculate Gas.
+	table := engine.gasTable()
+	*gasCnt = C.size_t(table.StorageIterateBase)
+
+	if engine.ctx.block == nil || engine.ctx.block.Height() < core.StorageIterationAvailableHeight {
+		return nil
+	}
+	if !StorageFieldPattern.MatchString(f) || offset < 0 || limit <= 0 || limit > MaxStorageIteratePageSize {
+		logging.VLog().WithFields(logrus.Fields{
+			"handler": uint64(uintptr(handler)),
+			"field":   f,
+			"offset":  int(offset),
+			"limit":   int(limit),
+		}).Debug("Invalid storage iteration.")
+		return nil
+	}
+
+	if err := checkStorageIndexState(storage, f); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"handler": uint64(uintptr(handler)),
+			"field":   f,
+			"err":     err,
+		}).Debug("StorageIterateFunc check index state failed.")
+		return nil
+	}
+
+	keys, visited, err := iterateStorageKeys(storage, f, int(offset), int(limit))
+	*gasCnt += C.size_t(uint64(visited) * table.StorageIterateEntry)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"handler": uint64(uintptr(handler)),
+			"field":   f,
+			"err":     err,
+		}).Debug("StorageIterateFunc iterate keys failed.")
+		return nil
+	}
+
+	data, err := json.Marshal(keys)
+	if err != nil {
+		return nil
+	}
+	*gasCnt += C.size_t(uint64(len(data)) * table.StorageByte)
+	return C.CString(string(data))
+}
+
+// iterateStorageKeys return the keys of the map field from offset, and the count of entries visited.
+func iterateStorageKeys(storage Account, field string, offset, limit int) ([]string, int, error) {
+	keys := []string{}
+	iter, err := storage.Iterator(trie.HashDomainsPrefix(storageIndexDomain, field))
+	if err != nil {
+		if err == ErrKeyNotFound {
+			return keys, 0, nil
+		}
+		return nil, 0, err
+	}
+
+	visited := 0
+	for len(keys) < limit {
+		exist, err := iter.Next()
+		if err != nil {
+			return nil, visited, err
+		}
+		if !exist {
+			break
+		}
+		visited++
+		if visited > offset {
+			keys = append(keys, string(iter.Value()))
+		}
+	}
+	return keys, visited, nil
+}
@@ -0,0 +1,27 @@
+'use strict'
+
+var CalleeContract = function () {
+    LocalContractStorage.defineProperty(this, "count");
+}
+
+CalleeContract.prototype = {
+    init: function () {
+        this.count = 0;
+    },
+
+    incr: function (n) {
+        this.count = this.count + n;
+        return this.count;
+    },
+    fail: function () {
+        this.count = 100;
+        throw new Error("callee failed.");
+    },
+    get: function () {
+        return this.count;
+    },
+    caller: function () {
+        return Blockchain.transaction.from;
+    }
+}
+module.exports = CalleeContract;
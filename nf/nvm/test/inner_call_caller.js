@@ -0,0 +1,28 @@
+'use strict'
+
+var CallerContract = function () {
+}
+
+CallerContract.prototype = {
+    init: function () {
+    },
+
+    incr: function (callee, n) {
+        return Blockchain.call(callee, "incr", [n]);
+    },
+    incrWithValue: function (callee, n, value) {
+        return Blockchain.call(callee, "incr", [n], value);
+    },
+    recover: function (callee) {
+        try {
+            Blockchain.call(callee, "fail");
+        } catch (e) {
+            return "recovered";
+        }
+        throw new Error("callee should fail.");
+    },
+    recurse: function (self) {
+        return Blockchain.call(self, "recurse", [self]);
+    }
+}
+module.exports = CallerContract;
@@ -22,11 +22,14 @@ var (
 	ErrExceedMemoryLimits              = errors.New("exceed memory limits")
 	ErrInjectTracingInstructionFailed  = errors.New("inject tracing instructions failed")
 	ErrTranspileTypeScriptFailed       = errors.New("transpile TypeScript failed")
+	ErrParsePublicFunctionsFailed      = errors.New("parse public functions failed")
 	ErrUnsupportedSourceType           = errors.New("unsupported source type")
 	ErrArgumentsFormat                 = errors.New("arguments format error")
 	ErrLimitHasEmpty                   = errors.New("limit args has empty")
 	ErrSetMemorySmall                  = errors.New("set memory small than v8 limit")
 	ErrDisallowCallNotStandardFunction = errors.New("disallow call not standard function")
+	ErrExceedMaxCallDepth              = errors.New("exceed max depth of inner contract calls")
+	ErrInvalidEnginePoolSize           = errors.New("invalid engine pool size")
 )
 
 //define
@@ -60,6 +63,7 @@ type Block interface {
 	RandomSeed() string
 	RandomAvailable() bool
 	DateAvailable() bool
+	RecentBlock(height uint64) (*core.Block, error)
 }
 
 // Transaction interface breaks cycle import dependency and hides unused services.
@@ -84,11 +88,14 @@ type Account interface {
 	Put(key []byte, value []byte) error
 	Get(key []byte) ([]byte, error)
 	Del(key []byte) error
+	Iterator(prefix []byte) (state.Iterator, error)
 }
 
 // WorldState interface breaks cycle import dependency and hides unused services.
 type WorldState interface {
 	GetOrCreateUserAccount(addr byteutils.Hash) (state.Account, error)
+	GetContractAccount(addr byteutils.Hash) (state.Account, error)
 	GetTx(txHash byteutils.Hash) ([]byte, error)
 	RecordEvent(txHash byteutils.Hash, event *state.Event)
+	Prepare(interface{}) (state.TxWorldState, error)
 }
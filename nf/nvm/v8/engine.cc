@@ -20,6 +20,7 @@
 #include "engine.h"
 #include "allocator.h"
 #include "engine_int.h"
+#include "lib/abi.h"
 #include "lib/execution_env.h"
 #include "lib/global.h"
 #include "lib/instruction_counter.h"
@@ -110,6 +111,26 @@ void DeleteEngine(V8Engine *e) {
   free(e);
 }
 
+void ResetEngine(V8Engine *e) {
+  Isolate *isolate = static_cast<Isolate *>(e->isolate);
+  {
+    Isolate::Scope isolate_scope(isolate);
+    isolate->CancelTerminateExecution();
+
+    // release the garbage of the last execution, the contexts are not reused.
+    isolate->LowMemoryNotification();
+  }
+
+  static_cast<ArrayBufferAllocator *>(e->allocator)
+      ->reset_peak_allocated_size();
+
+  e->limits_of_executed_instructions = 0;
+  e->limits_of_total_memory_size = 0;
+  e->is_requested_terminate_execution = 0;
+  e->testing = 0;
+  memset(&(e->stats), 0, sizeof(V8EngineStats));
+}
+
 int ExecuteSourceDataDelegate(char **result, Isolate *isolate,
                               const char *source, int source_line_offset,
                               Local<Context> context, TryCatch &trycatch,
@@ -155,11 +176,13 @@ int ExecuteSourceDataDelegate(char **result, Isolate *isolate,
 
 char *InjectTracingInstructions(V8Engine *e, const char *source,
                                 int *source_line_offset,
-                                int strictDisallowUsage) {
+                                int strictDisallowUsage,
+                                const char *weights) {
   TracingContext tContext;
   tContext.source_line_offset = 0;
   tContext.tracable_source = NULL;
   tContext.strictDisallowUsage = strictDisallowUsage;
+  tContext.weights = weights;
 
   Execute(NULL, e, source, 0, 0L, 0L, InjectTracingInstructionDelegate,
           (void *)&tContext);
@@ -181,6 +204,16 @@ char *TranspileTypeScriptModule(V8Engine *e, const char *source,
   return static_cast<char *>(tContext.js_source);
 }
 
+char *ParsePublicFunctions(V8Engine *e, const char *source) {
+  AbiContext aContext;
+  aContext.functions = NULL;
+
+  Execute(NULL, e, source, 0, 0L, 0L, PublicFunctionsDelegate,
+          (void *)&aContext);
+
+  return aContext.functions;
+}
+
 int RunScriptSource(char **result, V8Engine *e, const char *source,
                     int source_line_offset, uintptr_t lcsHandler,
                     uintptr_t gcsHandler) {
@@ -57,8 +57,10 @@ typedef int (*StoragePutFunc)(void *handler, const char *key, const char *value,
                               size_t *counterVal);
 typedef int (*StorageDelFunc)(void *handler, const char *key,
                               size_t *counterVal);
+typedef char *(*StorageIterateFunc)(void *handler, const char *field,
+                                    int offset, int limit, size_t *counterVal);
 EXPORT void InitializeStorage(StorageGetFunc get, StoragePutFunc put,
-                              StorageDelFunc del);
+                              StorageDelFunc del, StorageIterateFunc iterate);
 
 // blockchain
 typedef char *(*GetTxByHashFunc)(void *handler, const char *hash,
@@ -69,11 +71,30 @@ typedef int (*TransferFunc)(void *handler, const char *to, const char *value,
                             size_t *counterVal);
 typedef int (*VerifyAddressFunc)(void *handler, const char *address,
                                  size_t *counterVal);
+typedef char *(*CallContractFunc)(void *handler, const char *address,
+                                  const char *funcName, const char *args,
+                                  const char *value, size_t *counterVal);
+typedef char *(*GetBlockFunc)(void *handler, uint64_t height,
+                              size_t *counterVal);
 
 EXPORT void InitializeBlockchain(GetTxByHashFunc getTx,
                                  GetAccountStateFunc getAccount,
                                  TransferFunc transfer,
-                                 VerifyAddressFunc verifyAddress);
+                                 VerifyAddressFunc verifyAddress,
+                                 CallContractFunc callContract,
+                                 GetBlockFunc getBlock);
+
+// crypto
+typedef char *(*Sha256Func)(void *handler, const char *data, size_t *gasCnt);
+typedef char *(*Sha3256Func)(void *handler, const char *data, size_t *gasCnt);
+typedef char *(*Ripemd160Func)(void *handler, const char *data,
+                               size_t *gasCnt);
+typedef char *(*RecoverAddressFunc)(void *handler, int alg, const char *hash,
+                                    const char *sign, size_t *gasCnt);
+
+EXPORT void InitializeCrypto(Sha256Func sha256, Sha3256Func sha3256,
+                             Ripemd160Func ripemd160,
+                             RecoverAddressFunc recoverAddress);
 
 // version
 EXPORT char *GetV8Version();
@@ -83,6 +104,16 @@ typedef char *(*RequireDelegate)(void *handler, const char *filename,
                                  size_t *lineOffset);
 EXPORT void InitializeRequireDelegate(RequireDelegate delegate);
 
+// code cache callback, get returns 0 if the module is not cacheable, otherwise
+// returns 1 and sets data to the cached code if any, the data is freed by caller.
+typedef int (*GetCodeCacheFunc)(void *handler, const char *filename,
+                                char **data, size_t *size);
+// set stores the cached code of the module, it removes the cache if data is NULL.
+typedef void (*SetCodeCacheFunc)(void *handler, const char *filename,
+                                 const char *data, size_t size);
+EXPORT void InitializeCodeCacheDelegate(GetCodeCacheFunc get,
+                                        SetCodeCacheFunc set);
+
 typedef struct V8EngineStats {
   size_t count_of_executed_instructions;
   size_t total_memory_size;
@@ -113,17 +144,23 @@ EXPORT void Dispose();
 
 EXPORT V8Engine *CreateEngine();
 
+// reset the engine to be reused by another execution.
+EXPORT void ResetEngine(V8Engine *e);
+
 EXPORT int RunScriptSource(char **result, V8Engine *e, const char *source,
                            int source_line_offset, uintptr_t lcsHandler,
                            uintptr_t gcsHandler);
 
 EXPORT char *InjectTracingInstructions(V8Engine *e, const char *source,
                                        int *source_line_offset,
-                                       int strictDisallowUsage);
+                                       int strictDisallowUsage,
+                                       const char *weights);
 
 EXPORT char *TranspileTypeScriptModule(V8Engine *e, const char *source,
                                        int *source_line_offset);
 
+EXPORT char *ParsePublicFunctions(V8Engine *e, const char *source);
+
 EXPORT int IsEngineLimitsExceeded(V8Engine *e);
 
 EXPORT void ReadMemoryStatistics(V8Engine *e);
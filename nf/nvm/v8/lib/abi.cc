@@ -0,0 +1,85 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or
+// modify it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see
+// <http://www.gnu.org/licenses/>.
+//
+
+#include "abi.h"
+#include "logger.h"
+#include "util.h"
+
+#include <string.h>
+
+extern void PrintException(Local<Context> context, TryCatch &trycatch);
+
+static char abi_source_template[] =
+    "(function(){\n"
+    "const abi = require(\"abi.js\");\n"
+    "const source = \"%s\";\n"
+    "return JSON.stringify(abi.publicFunctions(source));\n"
+    "})();";
+
+int PublicFunctionsDelegate(char **result, Isolate *isolate, const char *source,
+                            int source_line_offset, Local<Context> context,
+                            TryCatch &trycatch, void *delegateContext) {
+  AbiContext *aContext = static_cast<AbiContext *>(delegateContext);
+  aContext->functions = NULL;
+
+  std::string s(source);
+  s = ReplaceAll(s, "\\", "\\\\");
+  s = ReplaceAll(s, "\n", "\\n");
+  s = ReplaceAll(s, "\r", "\\r");
+  s = ReplaceAll(s, "\"", "\\\"");
+
+  char *runnableSource = NULL;
+  asprintf(&runnableSource, abi_source_template, s.c_str());
+
+  // Create a string containing the JavaScript source code.
+  Local<String> src =
+      String::NewFromUtf8(isolate, runnableSource, NewStringType::kNormal)
+          .ToLocalChecked();
+  free(runnableSource);
+
+  // Compile the source code.
+  ScriptOrigin sourceSrcOrigin(
+      String::NewFromUtf8(isolate, "_abi_execution.js"),
+      Integer::New(isolate, source_line_offset));
+  MaybeLocal<Script> script = Script::Compile(context, src, &sourceSrcOrigin);
+
+  if (script.IsEmpty()) {
+    PrintException(context, trycatch);
+    return 1;
+  }
+
+  // Run the script to get the result.
+  MaybeLocal<Value> ret = script.ToLocalChecked()->Run(context);
+  if (ret.IsEmpty()) {
+    PrintException(context, trycatch);
+    return 1;
+  }
+
+  Local<Value> checked_ret = ret.ToLocalChecked();
+  if (!checked_ret->IsString()) {
+    LogErrorf("_abi_execution.js:publicFunctions() should return array.");
+    return 1;
+  }
+
+  String::Utf8Value str(checked_ret);
+  aContext->functions = (char *)malloc(str.length() + 1);
+  strcpy(aContext->functions, *str);
+
+  return 0;
+}
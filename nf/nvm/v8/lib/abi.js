@@ -0,0 +1,116 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+'use strict';
+
+const module_path_prefix = (typeof process !== 'undefined') && (process.release.name === 'node') ? './' : '';
+const esprima = require(module_path_prefix + 'esprima.js');
+
+function walk(node, visitor) {
+    if (node === null || typeof node !== 'object') {
+        return;
+    }
+    if (Array.isArray(node)) {
+        node.forEach(function (child) {
+            walk(child, visitor);
+        });
+        return;
+    }
+    if (typeof node.type === 'string') {
+        visitor(node);
+    }
+    for (var key in node) {
+        if (node.hasOwnProperty(key) && key !== 'loc' && key !== 'range') {
+            walk(node[key], visitor);
+        }
+    }
+};
+
+function isFunction(node) {
+    return node.type === 'FunctionExpression' || node.type === 'ArrowFunctionExpression';
+};
+
+function propertyName(node, computed) {
+    if (!computed && node.type === 'Identifier') {
+        return node.name;
+    }
+    if (node.type === 'Literal' && typeof node.value === 'string') {
+        return node.value;
+    }
+    return null;
+};
+
+// the name of X in `X.prototype`, null if node is not such an expression.
+function prototypeOwner(node) {
+    if (node.type !== 'MemberExpression' || propertyName(node.property, node.computed) !== 'prototype') {
+        return null;
+    }
+    return node.object.type === 'Identifier' ? node.object.name : null;
+};
+
+// publicFunctions return the names of the functions defined on the prototype of the
+// exported contract, the way the contract is called by the engine.
+function publicFunctions(source) {
+    var ast = esprima.parseScript(source);
+
+    var exported = null;
+    walk(ast, function (node) {
+        if (node.type === 'AssignmentExpression' && node.left.type === 'MemberExpression' &&
+            node.left.object.type === 'Identifier' && node.left.object.name === 'module' &&
+            propertyName(node.left.property, node.left.computed) === 'exports' &&
+            node.right.type === 'Identifier') {
+            exported = node.right.name;
+        }
+    });
+    if (exported === null) {
+        return [];
+    }
+
+    var names = [];
+    var add = function (name) {
+        if (name !== null && names.indexOf(name) < 0) {
+            names.push(name);
+        }
+    };
+    walk(ast, function (node) {
+        if (node.type === 'AssignmentExpression') {
+            if (prototypeOwner(node.left) === exported && node.right.type === 'ObjectExpression') {
+                // X.prototype = { f: function () {}, g() {} }
+                node.right.properties.forEach(function (prop) {
+                    if (prop.type === 'Property' && prop.kind === 'init' && isFunction(prop.value)) {
+                        add(propertyName(prop.key, prop.computed));
+                    }
+                });
+            } else if (node.left.type === 'MemberExpression' && prototypeOwner(node.left.object) === exported &&
+                isFunction(node.right)) {
+                // X.prototype.f = function () {}
+                add(propertyName(node.left.property, node.left.computed));
+            }
+        } else if ((node.type === 'ClassDeclaration' || node.type === 'ClassExpression') &&
+            node.id !== null && node.id.name === exported) {
+            // class X { f() {} }
+            node.body.body.forEach(function (method) {
+                if (method.type === 'MethodDefinition' && method.kind === 'method' && !method.static) {
+                    add(propertyName(method.key, method.computed));
+                }
+            });
+        }
+    });
+    return names;
+};
+
+exports["publicFunctions"] = publicFunctions;
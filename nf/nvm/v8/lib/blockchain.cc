@@ -25,14 +25,20 @@ static GetTxByHashFunc sGetTxByHash = NULL;
 static GetAccountStateFunc sGetAccountState = NULL;
 static TransferFunc sTransfer = NULL;
 static VerifyAddressFunc sVerifyAddress = NULL;
+static CallContractFunc sCallContract = NULL;
+static GetBlockFunc sGetBlock = NULL;
 
 void InitializeBlockchain(GetTxByHashFunc getTx, GetAccountStateFunc getAccount,
                           TransferFunc transfer,
-                          VerifyAddressFunc verifyAddress) {
+                          VerifyAddressFunc verifyAddress,
+                          CallContractFunc callContract,
+                          GetBlockFunc getBlock) {
   sGetTxByHash = getTx;
   sGetAccountState = getAccount;
   sTransfer = transfer;
   sVerifyAddress = verifyAddress;
+  sCallContract = callContract;
+  sGetBlock = getBlock;
 }
 
 void NewBlockchainInstance(Isolate *isolate, Local<Context> context,
@@ -65,6 +71,16 @@ void NewBlockchainInstance(Isolate *isolate, Local<Context> context,
                 static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
                                                PropertyAttribute::ReadOnly));
 
+  blockTpl->Set(String::NewFromUtf8(isolate, "call"),
+                FunctionTemplate::New(isolate, CallContractCallback),
+                static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
+                                               PropertyAttribute::ReadOnly));
+
+  blockTpl->Set(String::NewFromUtf8(isolate, "getBlock"),
+                FunctionTemplate::New(isolate, GetBlockCallback),
+                static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
+                                               PropertyAttribute::ReadOnly));
+
   Local<Object> instance = blockTpl->NewInstance(context).ToLocalChecked();
   instance->SetInternalField(0, External::New(isolate, handler));
 
@@ -204,3 +220,79 @@ void VerifyAddressCallback(const FunctionCallbackInfo<Value> &info) {
   // record storage usage.
   IncrCounter(isolate, isolate->GetCurrentContext(), cnt);
 }
+
+// CallContractCallback
+void CallContractCallback(const FunctionCallbackInfo<Value> &info) {
+  Isolate *isolate = info.GetIsolate();
+  Local<Object> thisArg = info.Holder();
+  Local<External> handler = Local<External>::Cast(thisArg->GetInternalField(0));
+
+  if (info.Length() != 4) {
+    isolate->ThrowException(String::NewFromUtf8(
+        isolate, "Blockchain.call() requires 4 arguments"));
+    return;
+  }
+
+  for (int i = 0; i < 4; i++) {
+    if (!info[i]->IsString()) {
+      isolate->ThrowException(String::NewFromUtf8(
+          isolate, "address, function, args and value must be string"));
+      return;
+    }
+  }
+
+  size_t cnt = 0;
+
+  char *value = sCallContract(handler->Value(),
+                              *String::Utf8Value(info[0]->ToString()),
+                              *String::Utf8Value(info[1]->ToString()),
+                              *String::Utf8Value(info[2]->ToString()),
+                              *String::Utf8Value(info[3]->ToString()), &cnt);
+
+  // record storage usage.
+  IncrCounter(isolate, isolate->GetCurrentContext(), cnt);
+
+  if (value == NULL) {
+    isolate->ThrowException(Exception::Error(
+        String::NewFromUtf8(isolate, "Blockchain.call() failed.")));
+    return;
+  }
+  info.GetReturnValue().Set(String::NewFromUtf8(isolate, value));
+  free(value);
+}
+
+// GetBlockCallback
+void GetBlockCallback(const FunctionCallbackInfo<Value> &info) {
+  Isolate *isolate = info.GetIsolate();
+  Local<Object> thisArg = info.Holder();
+  Local<External> handler = Local<External>::Cast(thisArg->GetInternalField(0));
+
+  if (info.Length() != 1) {
+    isolate->ThrowException(String::NewFromUtf8(
+        isolate, "Blockchain.getBlock() requires 1 argument"));
+    return;
+  }
+
+  Local<Value> height = info[0];
+  if (!height->IsNumber() || height->NumberValue() < 0) {
+    isolate->ThrowException(
+        String::NewFromUtf8(isolate, "height must be non-negative number"));
+    return;
+  }
+
+  size_t cnt = 0;
+
+  char *value =
+      sGetBlock(handler->Value(), (uint64_t)height->IntegerValue(), &cnt);
+
+  // record blockchain usage.
+  IncrCounter(isolate, isolate->GetCurrentContext(), cnt);
+
+  if (value == NULL) {
+    isolate->ThrowException(Exception::Error(
+        String::NewFromUtf8(isolate, "Blockchain.getBlock() failed.")));
+    return;
+  }
+  info.GetReturnValue().Set(String::NewFromUtf8(isolate, value));
+  free(value);
+}
@@ -74,6 +74,25 @@ Blockchain.prototype = {
     },
     verifyAddress: function (address) {
         return this.nativeBlockchain.verifyAddress(address);
+    },
+    // call is deleted before InnerContractCallAvailableHeight, it's undefined in the contracts before.
+    call: function (address, func, args, value) {
+        if (args === undefined) {
+            args = [];
+        }
+        if (value === undefined) {
+            value = 0;
+        }
+        if (!(value instanceof BigNumber)) {
+            value = new BigNumber(value);
+        }
+        var ret = this.nativeBlockchain.call(address, func, JSON.stringify(args), value.toString(10));
+        return JSON.parse(ret);
+    },
+    // getBlock is deleted before RecentBlockAvailableHeight, it's undefined in the contracts before.
+    getBlock: function (height) {
+        var block = JSON.parse(this.nativeBlockchain.getBlock(height));
+        return Object.freeze(block);
     }
 };
 module.exports = new Blockchain();
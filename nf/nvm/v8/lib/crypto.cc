@@ -0,0 +1,168 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or
+// modify it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see
+// <http://www.gnu.org/licenses/>.
+//
+#include "crypto.h"
+#include "../engine.h"
+#include "global.h"
+#include "instruction_counter.h"
+
+static Sha256Func sSha256 = NULL;
+static Sha3256Func sSha3256 = NULL;
+static Ripemd160Func sRipemd160 = NULL;
+static RecoverAddressFunc sRecoverAddress = NULL;
+
+void InitializeCrypto(Sha256Func sha256, Sha3256Func sha3256,
+                      Ripemd160Func ripemd160,
+                      RecoverAddressFunc recoverAddress) {
+  sSha256 = sha256;
+  sSha3256 = sha3256;
+  sRipemd160 = ripemd160;
+  sRecoverAddress = recoverAddress;
+}
+
+void NewNativeCryptoObject(Isolate *isolate, Local<ObjectTemplate> globalTpl) {
+  Local<ObjectTemplate> cryptoTpl = ObjectTemplate::New(isolate);
+
+  cryptoTpl->Set(String::NewFromUtf8(isolate, "sha256"),
+                 FunctionTemplate::New(isolate, Sha256Callback),
+                 static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
+                                                PropertyAttribute::ReadOnly));
+
+  cryptoTpl->Set(String::NewFromUtf8(isolate, "sha3256"),
+                 FunctionTemplate::New(isolate, Sha3256Callback),
+                 static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
+                                                PropertyAttribute::ReadOnly));
+
+  cryptoTpl->Set(String::NewFromUtf8(isolate, "ripemd160"),
+                 FunctionTemplate::New(isolate, Ripemd160Callback),
+                 static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
+                                                PropertyAttribute::ReadOnly));
+
+  cryptoTpl->Set(String::NewFromUtf8(isolate, "recoverAddress"),
+                 FunctionTemplate::New(isolate, RecoverAddressCallback),
+                 static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
+                                                PropertyAttribute::ReadOnly));
+
+  globalTpl->Set(String::NewFromUtf8(isolate, "_native_crypto"), cryptoTpl,
+                 static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
+                                                PropertyAttribute::ReadOnly));
+}
+
+typedef char *(*HashFunc)(void *handler, const char *data, size_t *gasCnt);
+
+static void HashCallback(const FunctionCallbackInfo<Value> &info,
+                         const char *name, HashFunc hash) {
+  Isolate *isolate = info.GetIsolate();
+  Local<Context> context = isolate->GetCurrentContext();
+
+  if (info.Length() != 1) {
+    isolate->ThrowException(Exception::Error(String::NewFromUtf8(
+        isolate, (std::string("crypto.") + name + "() requires 1 argument")
+                     .c_str())));
+    return;
+  }
+
+  Local<Value> data = info[0];
+  if (!data->IsString()) {
+    isolate->ThrowException(Exception::Error(
+        String::NewFromUtf8(isolate, "data must be string")));
+    return;
+  }
+
+  if (hash == NULL) {
+    info.GetReturnValue().SetNull();
+    return;
+  }
+
+  V8Engine *e = GetV8EngineInstance(context);
+  size_t cnt = 0;
+
+  char *value = hash(e, *String::Utf8Value(data->ToString()), &cnt);
+  if (value == NULL) {
+    info.GetReturnValue().SetNull();
+  } else {
+    info.GetReturnValue().Set(String::NewFromUtf8(isolate, value));
+    free(value);
+  }
+
+  // record crypto usage.
+  IncrCounter(isolate, context, cnt);
+}
+
+// Sha256Callback
+void Sha256Callback(const FunctionCallbackInfo<Value> &info) {
+  HashCallback(info, "sha256", sSha256);
+}
+
+// Sha3256Callback
+void Sha3256Callback(const FunctionCallbackInfo<Value> &info) {
+  HashCallback(info, "sha3256", sSha3256);
+}
+
+// Ripemd160Callback
+void Ripemd160Callback(const FunctionCallbackInfo<Value> &info) {
+  HashCallback(info, "ripemd160", sRipemd160);
+}
+
+// RecoverAddressCallback
+void RecoverAddressCallback(const FunctionCallbackInfo<Value> &info) {
+  Isolate *isolate = info.GetIsolate();
+  Local<Context> context = isolate->GetCurrentContext();
+
+  if (info.Length() != 3) {
+    isolate->ThrowException(Exception::Error(String::NewFromUtf8(
+        isolate, "crypto.recoverAddress() requires 3 arguments")));
+    return;
+  }
+
+  Local<Value> alg = info[0];
+  if (!alg->IsInt32()) {
+    isolate->ThrowException(Exception::Error(
+        String::NewFromUtf8(isolate, "alg must be integer")));
+    return;
+  }
+
+  Local<Value> hash = info[1];
+  Local<Value> sign = info[2];
+  if (!hash->IsString() || !sign->IsString()) {
+    isolate->ThrowException(Exception::Error(
+        String::NewFromUtf8(isolate, "hash and sign must be string")));
+    return;
+  }
+
+  if (sRecoverAddress == NULL) {
+    info.GetReturnValue().SetNull();
+    return;
+  }
+
+  V8Engine *e = GetV8EngineInstance(context);
+  size_t cnt = 0;
+
+  char *value = sRecoverAddress(e, alg->Int32Value(),
+                                *String::Utf8Value(hash->ToString()),
+                                *String::Utf8Value(sign->ToString()), &cnt);
+  if (value == NULL) {
+    info.GetReturnValue().SetNull();
+  } else {
+    info.GetReturnValue().Set(String::NewFromUtf8(isolate, value));
+    free(value);
+  }
+
+  // record crypto usage.
+  IncrCounter(isolate, context, cnt);
+}
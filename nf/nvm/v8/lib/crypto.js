@@ -0,0 +1,53 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+'use strict';
+
+var Crypto = function () {
+    Object.defineProperty(this, "nativeCrypto", {
+        configurable: false,
+        enumerable: false,
+        get: function () {
+            return _native_crypto;
+        }
+    });
+};
+
+Crypto.prototype = {
+    // the hex string of the sha256 hash of data.
+    sha256: function (data) {
+        return this.nativeCrypto.sha256(String(data));
+    },
+
+    // the hex string of the sha3-256 hash of data.
+    sha3256: function (data) {
+        return this.nativeCrypto.sha3256(String(data));
+    },
+
+    // the hex string of the ripemd160 hash of data.
+    ripemd160: function (data) {
+        return this.nativeCrypto.ripemd160(String(data));
+    },
+
+    // the address signing the hash with sign, both in hex string, null if failed.
+    recoverAddress: function (alg, hash, sign) {
+        return this.nativeCrypto.recoverAddress(alg, hash, sign);
+    }
+};
+
+// Crypto is defined since CryptoAvailableHeight, it's undefined in the contracts before.
+module.exports = new Crypto();
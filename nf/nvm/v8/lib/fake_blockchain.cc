@@ -59,3 +59,27 @@ int VerifyAddress(void *handler, const char *address, size_t *gasCnt) {
   *gasCnt = 100;
   return 0;
 }
+
+char *CallContract(void *handler, const char *address, const char *funcName,
+                   const char *args, const char *value, size_t *gasCnt) {
+  *gasCnt = 1000;
+
+  char *ret = NULL;
+  string result = "\"\"";
+  ret = (char *)calloc(result.length() + 1, sizeof(char));
+  strncpy(ret, result.c_str(), result.length());
+  return ret;
+}
+
+char *GetBlock(void *handler, uint64_t height, size_t *gasCnt) {
+  *gasCnt = 1000;
+
+  char *ret = NULL;
+  string value = "{\"timestamp\":0,\"hash\":"
+                 "\"59fc526072b09af8a8ca9732dae17132c4e9127e43cf2232\","
+                 "\"height\":" +
+                 to_string(height) + "}";
+  ret = (char *)calloc(value.length() + 1, sizeof(char));
+  strncpy(ret, value.c_str(), value.length());
+  return ret;
+}
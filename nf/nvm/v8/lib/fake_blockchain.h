@@ -21,10 +21,14 @@
 #define _NEBULAS_NF_NVM_V8_LIB_FAKE_BLOCKCHAIN_H_
 
 #include <stddef.h>
+#include <stdint.h>
 
 char *GetTxByHash(void *handler, const char *hash, size_t *gasCnt);
 char *GetAccountState(void *handler, const char *addres, size_t *gasCnts);
 int Transfer(void *handler, const char *to, const char *value, size_t *gasCnt);
 int VerifyAddress(void *handler, const char *address, size_t *gasCnt);
+char *CallContract(void *handler, const char *address, const char *funcName,
+                   const char *args, const char *value, size_t *gasCnt);
+char *GetBlock(void *handler, uint64_t height, size_t *gasCnt);
 
 #endif //_NEBULAS_NF_NVM_V8_LIB_FAKE_BLOCKCHAIN_H_
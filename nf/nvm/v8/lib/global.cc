@@ -19,6 +19,7 @@
 
 #include "global.h"
 #include "blockchain.h"
+#include "crypto.h"
 #include "event.h"
 #include "instruction_counter.h"
 #include "log_callback.h"
@@ -32,6 +33,7 @@ Local<ObjectTemplate> CreateGlobalObjectTemplate(Isolate *isolate) {
   NewNativeRequireFunction(isolate, globalTpl);
   NewNativeLogFunction(isolate, globalTpl);
   NewNativeEventFunction(isolate, globalTpl);
+  NewNativeCryptoObject(isolate, globalTpl);
 
   NewStorageType(isolate, globalTpl);
 
@@ -139,7 +139,8 @@ function record_injection_info(injection_records, pos, value, injection_func) {
     item.value += value;
 };
 
-function processScript(source, strictDisallowUsage) {
+// weights overrides the count of instruction of the tracking Expressions, it is the gas table of the chain.
+function processScript(source, strictDisallowUsage, weights) {
     var injection_records = new Map();
     var record_injection = function (pos, value, injection_func) {
         return record_injection_info(injection_records, pos, value, injection_func);
@@ -258,6 +259,9 @@ function processScript(source, strictDisallowUsage) {
 
             // Other Expressions.
             var tracing_val = TrackingExpressions[node.type];
+            if (tracing_val && weights && weights[node.type] > 0) {
+                tracing_val = weights[node.type];
+            }
             if (!tracing_val) {
                 // not the tracking expression, ignore.
                 return;
@@ -39,6 +39,8 @@ static char source_require_format[] =
     "})();\n";
 
 static RequireDelegate sRequireDelegate = NULL;
+static GetCodeCacheFunc sGetCodeCache = NULL;
+static SetCodeCacheFunc sSetCodeCache = NULL;
 
 static int readSource(Local<Context> context, const char *filename, char **data,
                       size_t *lineOffset) {
@@ -71,6 +73,49 @@ static int readSource(Local<Context> context, const char *filename, char **data,
   return 0;
 }
 
+static MaybeLocal<Script> compileSource(Local<Context> context,
+                                       const char *filename, const char *data,
+                                       ScriptOrigin &origin) {
+  Isolate *isolate = context->GetIsolate();
+  Local<String> src = String::NewFromUtf8(isolate, data);
+
+  char *cache = NULL;
+  size_t cacheSize = 0;
+  V8Engine *e = GetV8EngineInstance(context);
+  if (sGetCodeCache == NULL || sSetCodeCache == NULL ||
+      sGetCodeCache(e, filename, &cache, &cacheSize) == 0) {
+    return Script::Compile(context, src, &origin);
+  }
+
+  if (cache != NULL) {
+    // consume the cached code, the source owns the CachedData but not the
+    // buffer.
+    ScriptCompiler::Source source(
+        src, origin,
+        new ScriptCompiler::CachedData(
+            reinterpret_cast<const uint8_t *>(cache), cacheSize,
+            ScriptCompiler::CachedData::BufferNotOwned));
+    MaybeLocal<Script> script = ScriptCompiler::Compile(
+        context, &source, ScriptCompiler::kConsumeCodeCache);
+    if (source.GetCachedData()->rejected) {
+      // V8 compiled the source, the cache is produced again next time.
+      sSetCodeCache(e, filename, NULL, 0);
+    }
+    free(cache);
+    return script;
+  }
+
+  ScriptCompiler::Source source(src, origin);
+  MaybeLocal<Script> script = ScriptCompiler::Compile(
+      context, &source, ScriptCompiler::kProduceCodeCache);
+  const ScriptCompiler::CachedData *cachedData = source.GetCachedData();
+  if (!script.IsEmpty() && cachedData != NULL && cachedData->data != NULL) {
+    sSetCodeCache(e, filename, reinterpret_cast<const char *>(cachedData->data),
+                  cachedData->length);
+  }
+  return script;
+}
+
 void NewNativeRequireFunction(Isolate *isolate,
                               Local<ObjectTemplate> globalTpl) {
   globalTpl->Set(String::NewFromUtf8(isolate, "_native_require"),
@@ -150,8 +195,8 @@ void RequireCallback(const v8::FunctionCallbackInfo<v8::Value> &info) {
   free(abPath);
 
   ScriptOrigin sourceSrcOrigin(path, Integer::New(isolate, lineOffset));
-  MaybeLocal<Script> script = Script::Compile(
-      context, String::NewFromUtf8(isolate, data), &sourceSrcOrigin);
+  MaybeLocal<Script> script =
+      compileSource(context, *filename, data, sourceSrcOrigin);
   if (!script.IsEmpty()) {
     MaybeLocal<Value> ret = script.ToLocalChecked()->Run(context);
     if (!ret.IsEmpty()) {
@@ -166,3 +211,8 @@ void RequireCallback(const v8::FunctionCallbackInfo<v8::Value> &info) {
 void InitializeRequireDelegate(RequireDelegate delegate) {
   sRequireDelegate = delegate;
 }
+
+void InitializeCodeCacheDelegate(GetCodeCacheFunc get, SetCodeCacheFunc set) {
+  sGetCodeCache = get;
+  sSetCodeCache = set;
+}
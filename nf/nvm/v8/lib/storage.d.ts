@@ -43,6 +43,9 @@ interface ContractStorage {
     // set key and value pair to Native Storage,
     // return 0 for success, otherwise failure.
     rawSet(key: string, value: string): number;
+    // return a page of the keys of StorageMap `fieldName`,
+    // only the keys set since the iteration is available are returned.
+    rawKeys(fieldName: string, offset?: number, limit?: number): string[];
 
     // define a object property named `fieldname` to `obj` with descriptor.
     // default descriptor is JSON.parse/JSON.stringify descriptor.
@@ -96,6 +99,12 @@ interface StorageMap {
     // the value will be serialized to string by calling `descriptor.stringify`.
     // return 0 for success, otherwise failure.
     set(key: string, value: any): number;
+
+    // return a page of the keys, default limit is 20 and max is 100.
+    keys(offset?: number, limit?: number): string[];
+
+    // call `callback` with the value and key of each item in a page of keys.
+    forEach(callback: (value: any, key: string) => void, offset?: number, limit?: number): void;
 }
 
 declare const lcs: ContractStorage;
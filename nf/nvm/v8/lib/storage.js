@@ -20,6 +20,10 @@
 
 var fieldNameRe = /^[a-zA-Z_$][a-zA-Z0-9_]+$/;
 
+// the default and max page size of iterating the keys of maps, the max is MaxStorageIteratePageSize in nvm.
+var defaultPageSize = 20;
+var maxPageSize = 100;
+
 var combineStorageMapKey = function (fieldName, key) {
     return "@" + fieldName + "[" + key + "]";
 };
@@ -131,6 +135,22 @@ StorageMap.prototype = {
     set: function (key, value) {
         var val = this.stringify(value);
         return this.contractStorage.rawSet(combineStorageMapKey(this.fieldName, key), val);
+    },
+    // keys return the keys of the map in a page, in an order decided by the storage.
+    // NOTE: only the keys set since the iteration is available are indexed, the map having
+    // keys set before it is not iterable and keys throws, while get, set and del still work.
+    keys: function (offset, limit) {
+        return this.contractStorage.rawKeys(this.fieldName, offset, limit);
+    },
+    // forEach call callback with the value and key of each item in a page.
+    forEach: function (callback, offset, limit) {
+        if (typeof callback !== 'function') {
+            throw new Error("callback must be function.");
+        }
+        var keys = this.keys(offset, limit);
+        for (var i = 0; i < keys.length; i++) {
+            callback(this.get(keys[i]), keys[i]);
+        }
     }
 };
 StorageMap.prototype.put = StorageMap.prototype.set;
@@ -148,6 +168,21 @@ ContractStorage.prototype = {
         }
         return ret;
     },
+    rawKeys: function (fieldName, offset, limit) {
+        offset = offset || 0;
+        limit = limit || defaultPageSize;
+        if (!Number.isInteger(offset) || offset < 0) {
+            throw new Error("offset must be non-negative integer.");
+        }
+        if (!Number.isInteger(limit) || limit <= 0 || limit > maxPageSize) {
+            throw new Error("limit must be integer in (0, " + maxPageSize + "].");
+        }
+        var keys = this.nativeStorage.iterate(fieldName, offset, limit);
+        if (keys == null) {
+            throw new Error("iterate keys of " + fieldName + " failed, the iteration is not available yet or the map has keys set before it.");
+        }
+        return JSON.parse(keys);
+    },
     del: function (key) {
         var ret = this.nativeStorage.del(key);
         if (ret != 0) {
@@ -26,6 +26,7 @@
 static StorageGetFunc GET = NULL;
 static StoragePutFunc PUT = NULL;
 static StorageDelFunc DEL = NULL;
+static StorageIterateFunc ITERATE = NULL;
 
 void NewStorageType(Isolate *isolate, Local<ObjectTemplate> globalTpl) {
   Local<FunctionTemplate> type =
@@ -55,6 +56,11 @@ void NewStorageType(Isolate *isolate, Local<ObjectTemplate> globalTpl) {
       FunctionTemplate::New(isolate, StorageDelCallback),
       static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
                                      PropertyAttribute::ReadOnly));
+  instanceTpl->Set(
+      String::NewFromUtf8(isolate, "iterate"),
+      FunctionTemplate::New(isolate, StorageIterateCallback),
+      static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
+                                     PropertyAttribute::ReadOnly));
 
   globalTpl->Set(className, type,
                  static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
@@ -86,10 +92,11 @@ void NewStorageTypeInstance(Isolate *isolate, Local<Context> context,
 }
 
 void InitializeStorage(StorageGetFunc get, StoragePutFunc put,
-                       StorageDelFunc del) {
+                       StorageDelFunc del, StorageIterateFunc iterate) {
   GET = get;
   PUT = put;
   DEL = del;
+  ITERATE = iterate;
 }
 
 void StorageConstructor(const FunctionCallbackInfo<Value> &info) {
@@ -204,3 +211,41 @@ void StorageDelCallback(const FunctionCallbackInfo<Value> &info) {
   // record storage usage.
   IncrCounter(isolate, isolate->GetCurrentContext(), cnt);
 }
+
+void StorageIterateCallback(const FunctionCallbackInfo<Value> &info) {
+  Isolate *isolate = info.GetIsolate();
+  Local<Object> thisArg = info.Holder();
+  Local<External> handler = Local<External>::Cast(thisArg->GetInternalField(0));
+
+  if (info.Length() != 3) {
+    isolate->ThrowException(String::NewFromUtf8(
+        isolate, "Storage.iterate() requires only 3 arguments"));
+    return;
+  }
+
+  Local<Value> field = info[0];
+  if (!field->IsString()) {
+    isolate->ThrowException(
+        String::NewFromUtf8(isolate, "field must be string"));
+    return;
+  }
+
+  if (!info[1]->IsInt32() || !info[2]->IsInt32()) {
+    isolate->ThrowException(
+        String::NewFromUtf8(isolate, "offset and limit must be integer"));
+    return;
+  }
+
+  size_t cnt = 0;
+  char *value = ITERATE(handler->Value(), *String::Utf8Value(field->ToString()),
+                        info[1]->Int32Value(), info[2]->Int32Value(), &cnt);
+  if (value == NULL) {
+    info.GetReturnValue().SetNull();
+  } else {
+    info.GetReturnValue().Set(String::NewFromUtf8(isolate, value));
+    free(value);
+  }
+
+  // record storage usage.
+  IncrCounter(isolate, isolate->GetCurrentContext(), cnt);
+}
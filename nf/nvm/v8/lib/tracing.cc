@@ -32,7 +32,7 @@ static char inject_tracer_source_template[] =
     "(function(){\n"
     "const instCounter = require(\"instruction_counter.js\");\n"
     "const source = \"%s\";\n"
-    "return instCounter.processScript(source, %d);\n"
+    "return instCounter.processScript(source, %d, %s);\n"
     "})();";
 
 int InjectTracingInstructionDelegate(char **result, Isolate *isolate,
@@ -48,9 +48,16 @@ int InjectTracingInstructionDelegate(char **result, Isolate *isolate,
   s = ReplaceAll(s, "\r", "\\r");
   s = ReplaceAll(s, "\"", "\\\"");
 
+  // weights is a JSON object of the instruction counts, or null for the
+  // default counts.
+  const char *weights = tContext->weights;
+  if (weights == NULL || strlen(weights) == 0) {
+    weights = "null";
+  }
+
   char *injectTracerSource = NULL;
   asprintf(&injectTracerSource, inject_tracer_source_template, s.c_str(),
-           tContext->strictDisallowUsage);
+           tContext->strictDisallowUsage, weights);
 
   // Create a string containing the JavaScript source code.
   Local<String> src =
@@ -28,6 +28,7 @@ typedef struct {
   int source_line_offset;
   char *tracable_source;
   int strictDisallowUsage;
+  const char *weights;
 } TracingContext;
 
 int InjectTracingInstructionDelegate(char **result, Isolate *isolate,
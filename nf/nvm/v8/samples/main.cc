@@ -92,7 +92,8 @@ void RunScriptSourceDelegate(V8Engine *e, const char *data,
     e->limits_of_total_memory_size = limits_of_total_memory_size;
 
     char *traceableSource =
-        InjectTracingInstructions(e, data, &lineOffset, strict_disallow_usage);
+        InjectTracingInstructions(e, data, &lineOffset, strict_disallow_usage,
+                                  NULL);
     if (traceableSource == NULL) {
       fprintf(stderr, "Inject tracing instructions failed.\n");
     } else {
@@ -192,8 +193,8 @@ void ExecuteScript(const char *filename, V8ExecutionDelegate delegate) {
 
   // inject tracing code.
   if (enable_tracer_injection) {
-    char *traceableSource = InjectTracingInstructions(e, source, &lineOffset,
-                                                      strict_disallow_usage);
+    char *traceableSource = InjectTracingInstructions(
+        e, source, &lineOffset, strict_disallow_usage, NULL);
     if (traceableSource == NULL) {
       fprintf(stderr, "Inject tracing instructions failed.\n");
       free(source);
@@ -232,8 +233,9 @@ int main(int argc, const char *argv[]) {
   Initialize();
   InitializeLogger(logFunc);
   InitializeRequireDelegate(RequireDelegateFunc);
-  InitializeStorage(StorageGet, StoragePut, StorageDel);
-  InitializeBlockchain(GetTxByHash, GetAccountState, Transfer, VerifyAddress);
+  InitializeStorage(StorageGet, StoragePut, StorageDel, StorageIterate);
+  InitializeBlockchain(GetTxByHash, GetAccountState, Transfer, VerifyAddress,
+                       CallContract, GetBlock);
   InitializeEvent(eventTriggerFunc);
 
   int argcIdx = 1;
@@ -85,3 +85,39 @@ int StorageDel(void *handler, const char *key, size_t *cnt) {
 
   return 0;
 }
+
+char *StorageIterate(void *handler, const char *field, int offset, int limit,
+                     size_t *cnt) {
+  string prefix = genKey(handler, "@");
+  prefix.append(field);
+  prefix.append("[");
+
+  string keys = "[";
+  int visited = 0, count = 0;
+
+  mapMutex.lock();
+  for (auto it = memoryMap.begin(); it != memoryMap.end() && count < limit;
+       it++) {
+    const string &key = it->first;
+    if (key.compare(0, prefix.length(), prefix) != 0) {
+      continue;
+    }
+    if (visited++ < offset) {
+      continue;
+    }
+    if (count++ > 0) {
+      keys.append(",");
+    }
+    keys.append("\"");
+    keys.append(key.substr(prefix.length(), key.length() - prefix.length() - 1));
+    keys.append("\"");
+  }
+  mapMutex.unlock();
+  keys.append("]");
+
+  *cnt = visited;
+
+  char *ret = (char *)calloc(keys.length() + 1, sizeof(char));
+  strncpy(ret, keys.c_str(), keys.length());
+  return ret;
+}
@@ -28,5 +28,7 @@ void DeleteStorageHandler(void *handler);
 char *StorageGet(void *handler, const char *key, size_t *cnt);
 int StoragePut(void *handler, const char *key, const char *value, size_t *cnt);
 int StorageDel(void *handler, const char *key, size_t *cnt);
+char *StorageIterate(void *handler, const char *field, int offset, int limit,
+                     size_t *cnt);
 
 #endif // _NEBULAS_NF_NVM_V8_LIB_MEMORY_STORAGE_H_
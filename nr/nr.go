@@ -0,0 +1,289 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nr
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/nr/pb"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// const
+const (
+	// DefaultWindow blocks in a period, one day of 15s blocks.
+	DefaultWindow = uint64(5760)
+
+	updateInterval = 15 * time.Second
+)
+
+var (
+	latestKey = []byte("latest")
+)
+
+// Errors in nr
+var (
+	ErrInvalidPeriod    = errors.New("invalid nr period, should be positive")
+	ErrPeriodNotReady   = errors.New("the blocks of nr period are not irreversible yet")
+	ErrNRNotFound       = errors.New("nr of the period is not computed yet")
+	ErrMissingPeriodEnd = errors.New("the blocks of nr period are not found on chain")
+)
+
+// Neblet interface breaks cycle import dependency and hides unused services.
+type Neblet interface {
+	Genesis() *corepb.Genesis
+	Storage() storage.Storage
+	BlockChain() *core.BlockChain
+}
+
+// NR compute the Nebulas Rank of addresses period by period. The blocks after
+// genesis are split into periods of window blocks, the window is set in genesis
+// so that all nodes of the chain share it. The rank of a period is computed
+// once all of its blocks are irreversible, so it's the same on all nodes.
+type NR struct {
+	quitCh chan bool
+
+	chain   *core.BlockChain
+	storage storage.Storage
+	window  uint64
+}
+
+// NewNR create a nr instance.
+func NewNR(neb Neblet) (*NR, error) {
+	window := neb.Genesis().GetNr().GetWindow()
+	if window == 0 {
+		window = DefaultWindow
+	}
+	stor, err := storage.OpenKeyspace(neb.Storage(), storage.NRKeyspace)
+	if err != nil {
+		return nil, err
+	}
+	return &NR{
+		quitCh:  make(chan bool, 1),
+		chain:   neb.BlockChain(),
+		storage: stor,
+		window:  window,
+	}, nil
+}
+
+// Start start nr service.
+func (nr *NR) Start() {
+	logging.CLog().Info("Starting NR...")
+	go nr.loop()
+}
+
+// Stop stop nr service.
+func (nr *NR) Stop() {
+	logging.CLog().Info("Stopping NR...")
+	nr.quitCh <- true
+}
+
+// Window return the count of blocks in a period
+func (nr *NR) Window() uint64 {
+	return nr.window
+}
+
+// PeriodRange return the first and the last block height of period, both inclusive.
+func (nr *NR) PeriodRange(period uint64) (uint64, uint64) {
+	start := (period-1)*nr.window + 2
+	return start, start + nr.window - 1
+}
+
+// PeriodOf return the period of the block at height, 0 for genesis.
+func (nr *NR) PeriodOf(height uint64) uint64 {
+	if height < 2 {
+		return 0
+	}
+	return (height-2)/nr.window + 1
+}
+
+// LatestPeriod return the latest computed period, 0 if none.
+func (nr *NR) LatestPeriod() (uint64, error) {
+	value, err := nr.storage.Get(latestKey)
+	if err != nil {
+		if err == storage.ErrKeyNotFound {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return byteutils.Uint64(value), nil
+}
+
+// GetNRData return the nr of period, the latest one if period is 0.
+func (nr *NR) GetNRData(period uint64) (*nrpb.NRData, error) {
+	if period == 0 {
+		latest, err := nr.LatestPeriod()
+		if err != nil {
+			return nil, err
+		}
+		if latest == 0 {
+			return nil, ErrNRNotFound
+		}
+		period = latest
+	}
+	value, err := nr.storage.Get(byteutils.FromUint64(period))
+	if err != nil {
+		if err == storage.ErrKeyNotFound {
+			return nil, ErrNRNotFound
+		}
+		return nil, err
+	}
+	data := new(nrpb.NRData)
+	if err := proto.Unmarshal(value, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// GetNRByAddress return the nr of addr in period, the latest one if period is 0.
+// The address inactive in period ranks zero.
+func (nr *NR) GetNRByAddress(addr *core.Address, period uint64) (*nrpb.NRItem, *nrpb.NRData, error) {
+	data, err := nr.GetNRData(period)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, item := range data.Nrs {
+		if item.Address == addr.String() {
+			return item, data, nil
+		}
+	}
+	return &nrpb.NRItem{Address: addr.String(), InFlow: "0", OutFlow: "0", Stake: "0"}, data, nil
+}
+
+// Compute the nr of period from the irreversible blocks on canonical chain.
+func (nr *NR) Compute(period uint64) (*nrpb.NRData, error) {
+	if period == 0 {
+		return nil, ErrInvalidPeriod
+	}
+	_, end := nr.PeriodRange(period)
+	if end > nr.chain.LIB().Height() {
+		return nil, ErrPeriodNotReady
+	}
+	endBlock := nr.chain.GetBlockOnCanonicalChainByHeight(end)
+	if endBlock == nil {
+		return nil, ErrMissingPeriodEnd
+	}
+	return nr.ComputeByEndBlock(period, endBlock)
+}
+
+// ComputeByEndBlock compute the nr of period from endBlock and its ancestors, endBlock
+// should be the last block of period. It doesn't depend on the canonical chain, so the
+// result is the same on all forks sharing endBlock.
+func (nr *NR) ComputeByEndBlock(period uint64, endBlock *core.Block) (*nrpb.NRData, error) {
+	if period == 0 {
+		return nil, ErrInvalidPeriod
+	}
+	start, end := nr.PeriodRange(period)
+	if endBlock.Height() != end {
+		return nil, ErrMissingPeriodEnd
+	}
+
+	// the blocks are walked back from endBlock, while the coin age is weighted in the order of height.
+	blocks := [][]*transfer{}
+	block := endBlock
+	for {
+		transfers, err := collect(block)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, transfers)
+		if block.Height() == start {
+			break
+		}
+		if block = nr.chain.GetBlock(block.ParentHash()); block == nil {
+			return nil, ErrMissingPeriodEnd
+		}
+	}
+	g := newGraph(start)
+	for i := len(blocks) - 1; i >= 0; i-- {
+		for _, t := range blocks[i] {
+			g.add(t)
+		}
+	}
+	items, median, err := g.rank(endBlock)
+	if err != nil {
+		return nil, err
+	}
+	return &nrpb.NRData{
+		Period:      period,
+		StartHeight: start,
+		EndHeight:   end,
+		EndBlock:    endBlock.Hash(),
+		Median:      median.String(),
+		Nrs:         items,
+	}, nil
+}
+
+// update compute and store the periods whose blocks turned irreversible.
+func (nr *NR) update() error {
+	latest, err := nr.LatestPeriod()
+	if err != nil {
+		return err
+	}
+	for period := latest + 1; ; period++ {
+		if _, end := nr.PeriodRange(period); end > nr.chain.LIB().Height() {
+			return nil
+		}
+		data, err := nr.Compute(period)
+		if err != nil {
+			return err
+		}
+		value, err := proto.Marshal(data)
+		if err != nil {
+			return err
+		}
+		if err := nr.storage.Put(byteutils.FromUint64(period), value); err != nil {
+			return err
+		}
+		if err := nr.storage.Put(latestKey, byteutils.FromUint64(period)); err != nil {
+			return err
+		}
+		logging.VLog().WithFields(logrus.Fields{
+			"period": period,
+			"start":  data.StartHeight,
+			"end":    data.EndHeight,
+			"count":  len(data.Nrs),
+		}).Info("Computed nebulas rank.")
+	}
+}
+
+func (nr *NR) loop() {
+	logging.CLog().Info("Started NR.")
+	timeChan := time.NewTicker(updateInterval).C
+	for {
+		select {
+		case <-timeChan:
+			if err := nr.update(); err != nil {
+				logging.VLog().WithFields(logrus.Fields{
+					"err": err,
+				}).Debug("Failed to update nebulas rank.")
+			}
+		case <-nr.quitCh:
+			logging.CLog().Info("Stopped NR.")
+			return
+		}
+	}
+}
@@ -0,0 +1,252 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nr
+
+import (
+	"encoding/json"
+	"math/big"
+	"sort"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/nr/pb"
+)
+
+// const
+const (
+	dampingFactor      = 0.85
+	pageRankIterations = 50
+)
+
+// node an address in the transaction graph of a period.
+type node struct {
+	address string
+	in      *big.Int
+	out     *big.Int
+	edges   map[int]*big.Int // to index -> coin age weighted value
+}
+
+// transfer the value transferred between normal accounts by a succeeded tx.
+type transfer struct {
+	from   *core.Address
+	to     *core.Address
+	value  *big.Int
+	height uint64
+}
+
+// graph the coin age weighted value flows between accounts in a period.
+type graph struct {
+	nodes []*node
+	index map[string]int
+	start uint64
+	since map[string]uint64 // address -> height of the latest inflow in period
+}
+
+func newGraph(start uint64) *graph {
+	return &graph{
+		nodes: []*node{},
+		index: make(map[string]int),
+		start: start,
+		since: make(map[string]uint64),
+	}
+}
+
+func (g *graph) node(address *core.Address) *node {
+	if i, ok := g.index[address.String()]; ok {
+		return g.nodes[i]
+	}
+	n := &node{
+		address: address.String(),
+		in:      new(big.Int),
+		out:     new(big.Int),
+		edges:   make(map[int]*big.Int),
+	}
+	g.index[n.address] = len(g.nodes)
+	g.nodes = append(g.nodes, n)
+	return n
+}
+
+// collect the value transferred between normal accounts by the succeeded txs in block.
+func collect(block *core.Block) ([]*transfer, error) {
+	transfers := []*transfer{}
+	for _, tx := range block.Transactions() {
+		from, to := tx.From(), tx.To()
+		if from.Equals(to) || from.Type() != core.AccountAddress || to.Type() != core.AccountAddress {
+			continue
+		}
+		value := new(big.Int).SetBytes(tx.Value().Bytes())
+		if value.Sign() == 0 {
+			continue
+		}
+		result, err := block.FetchExecutionResultEvent(tx.Hash())
+		if err != nil {
+			return nil, err
+		}
+		txEvent := new(core.TransactionEvent)
+		if err := json.Unmarshal([]byte(result.Data), txEvent); err != nil {
+			return nil, err
+		}
+		if txEvent.Status != core.TxExecutionSuccess {
+			continue
+		}
+		transfers = append(transfers, &transfer{from: from, to: to, value: value, height: block.Height()})
+	}
+	return transfers, nil
+}
+
+// add the transfer weighted by coin age, the value times the count of blocks the sender held
+// its balance before the transfer, since its latest inflow in period or the start of period.
+// The transfers should be added in the order of execution.
+func (g *graph) add(t *transfer) {
+	since, ok := g.since[t.from.String()]
+	if !ok {
+		since = g.start
+	}
+	// the coins received in the same block are held 1 block, so that no flow is weighted 0.
+	weighted := new(big.Int).Mul(t.value, new(big.Int).SetUint64(t.height-since+1))
+	g.since[t.to.String()] = t.height
+
+	src, dst := g.node(t.from), g.node(t.to)
+	src.out.Add(src.out, weighted)
+	dst.in.Add(dst.in, weighted)
+	j := g.index[dst.address]
+	if _, ok := src.edges[j]; !ok {
+		src.edges[j] = new(big.Int)
+	}
+	src.edges[j].Add(src.edges[j], weighted)
+}
+
+// rank score the accounts by page rank over the value flows, weighted by their stake
+// against the median stake at the end block. The accounts are visited in address order
+// and every product is rounded explicitly, so the floating point result is reproducible.
+func (g *graph) rank(end *core.Block) ([]*nrpb.NRItem, *big.Int, error) {
+	sort.Slice(g.nodes, func(i, j int) bool {
+		return g.nodes[i].address < g.nodes[j].address
+	})
+	order := make(map[int]int, len(g.nodes))
+	for i, n := range g.nodes {
+		order[g.index[n.address]] = i
+	}
+
+	count := len(g.nodes)
+	stakes := make([]*big.Int, count)
+	for i, n := range g.nodes {
+		addr, err := core.AddressParse(n.address)
+		if err != nil {
+			return nil, nil, err
+		}
+		acc, err := end.GetAccount(addr.Bytes())
+		if err != nil {
+			return nil, nil, err
+		}
+		stakes[i] = new(big.Int).SetBytes(acc.Balance().Bytes())
+	}
+	median := medianOf(stakes)
+
+	scores := pageRank(g.nodes, order)
+	items := make([]*nrpb.NRItem, count)
+	for i, n := range g.nodes {
+		weight := stakeWeight(stakes[i], median)
+		items[i] = &nrpb.NRItem{
+			Address: n.address,
+			InFlow:  n.in.String(),
+			OutFlow: n.out.String(),
+			Stake:   stakes[i].String(),
+			Weight:  weight,
+			// scale by the count so that the average page rank is 1.
+			Score: float64(float64(scores[i]*weight) * float64(count)),
+		}
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Score > items[j].Score
+	})
+	return items, median, nil
+}
+
+// medianOf return the lower median of values, 0 if empty
+func medianOf(values []*big.Int) *big.Int {
+	if len(values) == 0 {
+		return new(big.Int)
+	}
+	sorted := make([]*big.Int, len(values))
+	copy(sorted, values)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Cmp(sorted[j]) < 0
+	})
+	return sorted[(len(sorted)-1)/2]
+}
+
+// stakeWeight return stake / (stake + median), 1 if both are 0
+func stakeWeight(stake *big.Int, median *big.Int) float64 {
+	total := new(big.Int).Add(stake, median)
+	if total.Sign() == 0 {
+		return 1
+	}
+	weight, _ := new(big.Rat).SetFrac(stake, total).Float64()
+	return weight
+}
+
+// pageRank of nodes with edges weighted by value flow, the rank of accounts
+// without outflow is spread over all.
+func pageRank(nodes []*node, order map[int]int) []float64 {
+	count := len(nodes)
+	if count == 0 {
+		return []float64{}
+	}
+
+	type link struct {
+		to     int
+		weight float64
+	}
+	links := make([][]link, count)
+	for i, n := range nodes {
+		for j, value := range n.edges {
+			weight, _ := new(big.Rat).SetFrac(value, n.out).Float64()
+			links[i] = append(links[i], link{to: order[j], weight: weight})
+		}
+		sort.Slice(links[i], func(a, b int) bool {
+			return links[i][a].to < links[i][b].to
+		})
+	}
+
+	size := float64(count)
+	ranks := make([]float64, count)
+	for i := range ranks {
+		ranks[i] = 1 / size
+	}
+	for iter := 0; iter < pageRankIterations; iter++ {
+		dangling := 0.0
+		for i := range nodes {
+			if len(links[i]) == 0 {
+				dangling += ranks[i]
+			}
+		}
+		base := float64((1-dampingFactor)/size) + float64(dampingFactor*float64(dangling/size))
+		next := make([]float64, count)
+		for i := range next {
+			next[i] = base
+		}
+		for i := range nodes {
+			for _, l := range links[i] {
+				next[l.to] += float64(dampingFactor * float64(ranks[i]*l.weight))
+			}
+		}
+		ranks = next
+	}
+	return ranks
+}
@@ -0,0 +1,135 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nr
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// const
+const (
+	rankerCacheSize = 16
+)
+
+var (
+	// scoreUnit scales the rank score to an integer, the score of a period sums to 1.
+	scoreUnit = new(big.Float).SetFloat64(1e18)
+)
+
+// Errors in ranker
+var (
+	ErrMissingParentBlock = errors.New("the parent block of election is not found")
+)
+
+// Ranker score the participants of PoD election by Nebulas Rank. The rank of the latest period
+// ended at or before the parent block is used, it's computed from the ancestors of the parent,
+// so the score is the same on all nodes. The deposit is used as score before the first period ends.
+type Ranker struct {
+	nr *NR
+
+	scores *lru.Cache // parent hash -> scores of the addresses ranked in period
+	lock   sync.Mutex
+}
+
+// NewRanker create a ranker instance.
+func NewRanker(nr *NR) (*Ranker, error) {
+	scores, err := lru.New(rankerCacheSize)
+	if err != nil {
+		return nil, err
+	}
+	return &Ranker{
+		nr:     nr,
+		scores: scores,
+	}, nil
+}
+
+// Score return the rank score of participant in the latest period ended before the parent.
+// The participant not ranked in the period scores zero.
+func (r *Ranker) Score(parent byteutils.Hash, participant byteutils.Hash, deposit *util.Uint128, timestamp int64) (*util.Uint128, error) {
+	if parent == nil {
+		return nil, ErrMissingParentBlock
+	}
+	scores, err := r.periodScores(parent)
+	if err != nil {
+		return nil, err
+	}
+	if scores == nil {
+		return deposit, nil
+	}
+	if score, ok := scores[participant.Hex()]; ok {
+		return score, nil
+	}
+	return util.NewUint128(), nil
+}
+
+// periodScores return the scores of the latest period ended before the parent, nil if none.
+func (r *Ranker) periodScores(parent byteutils.Hash) (map[byteutils.HexHash]*util.Uint128, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if v, ok := r.scores.Get(parent.Hex()); ok {
+		return v.(map[byteutils.HexHash]*util.Uint128), nil
+	}
+
+	block := r.nr.chain.GetBlock(parent)
+	if block == nil {
+		return nil, ErrMissingParentBlock
+	}
+	period := r.nr.PeriodOf(block.Height()+1) - 1
+	if period == 0 {
+		return nil, nil
+	}
+	_, end := r.nr.PeriodRange(period)
+	for block.Height() > end {
+		if block = r.nr.chain.GetBlock(block.ParentHash()); block == nil {
+			return nil, ErrMissingPeriodEnd
+		}
+	}
+
+	// the stored nr is reused if it's computed from the same end block.
+	data, err := r.nr.GetNRData(period)
+	if err != nil || !byteutils.Hash(data.EndBlock).Equals(block.Hash()) {
+		if data, err = r.nr.ComputeByEndBlock(period, block); err != nil {
+			return nil, err
+		}
+	}
+
+	scores := make(map[byteutils.HexHash]*util.Uint128)
+	for _, item := range data.Nrs {
+		addr, err := core.AddressParse(item.Address)
+		if err != nil {
+			return nil, err
+		}
+		value, _ := new(big.Float).Mul(new(big.Float).SetFloat64(item.Score), scoreUnit).Int(nil)
+		score, err := util.NewUint128FromBigInt(value)
+		if err != nil {
+			return nil, err
+		}
+		scores[byteutils.Hash(addr.Bytes()).Hex()] = score
+	}
+	r.scores.Add(parent.Hex(), scores)
+	return scores, nil
+}
@@ -0,0 +1,412 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nr
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/account"
+	"github.com/nebulasio/go-nebulas/consensus/dev"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testMiner    = "n1FF1nz6tarkDVwWQkMnnwFPuPKUaQTdptE"
+	testReceiver = "n1GmkKH6nBMw4rrjt16RrJ9WcgvKUtAZP1s"
+	testOutsider = "n1H4MYms9F55ehcvygwWE71J8tJC4CRr2so"
+)
+
+type Neb struct {
+	config    *nebletpb.Config
+	chain     *core.BlockChain
+	ns        net.Service
+	am        *account.Manager
+	genesis   *corepb.Genesis
+	storage   storage.Storage
+	consensus core.Consensus
+	emitter   *core.EventEmitter
+	nvm       core.NVM
+}
+
+func mockNeb(t *testing.T) *Neb {
+	storage, _ := storage.NewMemoryStorage()
+	eventEmitter := core.NewEventEmitter(1024)
+	consensus := dev.NewDev()
+	neb := &Neb{
+		genesis: &corepb.Genesis{
+			Meta: &corepb.GenesisMeta{ChainId: 0},
+			Consensus: &corepb.GenesisConsensus{
+				Engine: &corepb.GenesisConsensus_Dpos{
+					Dpos: &corepb.GenesisConsensusDpos{
+						Dynasty: []string{testMiner},
+					},
+				},
+			},
+			TokenDistribution: []*corepb.GenesisTokenDistribution{
+				&corepb.GenesisTokenDistribution{
+					Address: testMiner,
+					Value:   "5000000000000000000000000",
+				},
+			},
+			Nr: &corepb.GenesisNr{Window: 2},
+		},
+		storage:   storage,
+		emitter:   eventEmitter,
+		consensus: consensus,
+		nvm:       nvm.NewNebulasVM(),
+		config: &nebletpb.Config{
+			Chain: &nebletpb.ChainConfig{
+				ChainId:    0,
+				Keydir:     "keydir",
+				StartMine:  true,
+				Miner:      testMiner,
+				Passphrase: "passphrase",
+				Consensus:  "dev",
+			},
+		},
+		ns: mockNetService{},
+	}
+
+	am, _ := account.NewManager(neb)
+	neb.am = am
+
+	chain, err := core.NewBlockChain(neb)
+	assert.Nil(t, err)
+	neb.chain = chain
+	assert.Nil(t, consensus.Setup(neb))
+	assert.Nil(t, chain.Setup(neb))
+	assert.Nil(t, consensus.EnableMining("passphrase"))
+	consensus.ResumeMining()
+
+	eventEmitter.Start()
+	return neb
+}
+
+func (n *Neb) Config() *nebletpb.Config {
+	return n.config
+}
+
+func (n *Neb) BlockChain() *core.BlockChain {
+	return n.chain
+}
+
+func (n *Neb) NetService() net.Service {
+	return n.ns
+}
+
+func (n *Neb) IsActiveSyncing() bool {
+	return true
+}
+
+func (n *Neb) AccountManager() core.AccountManager {
+	return n.am
+}
+
+func (n *Neb) Genesis() *corepb.Genesis {
+	return n.genesis
+}
+
+func (n *Neb) SetGenesis(genesis *corepb.Genesis) {
+	n.genesis = genesis
+}
+
+func (n *Neb) Storage() storage.Storage {
+	return n.storage
+}
+
+func (n *Neb) EventEmitter() *core.EventEmitter {
+	return n.emitter
+}
+
+func (n *Neb) Consensus() core.Consensus {
+	return n.consensus
+}
+
+func (n *Neb) Nvm() core.NVM {
+	return n.nvm
+}
+
+func (n *Neb) StartActiveSync() {}
+
+func (n *Neb) StartPprof(string) error { return nil }
+
+type mockNetService struct{}
+
+func (n mockNetService) Start() error { return nil }
+func (n mockNetService) Stop()        {}
+
+func (n mockNetService) Node() *net.Node { return nil }
+
+func (n mockNetService) Sync(net.Serializable) error { return nil }
+
+func (n mockNetService) Register(...*net.Subscriber)   {}
+func (n mockNetService) Deregister(...*net.Subscriber) {}
+
+func (n mockNetService) Broadcast(name string, msg net.Serializable, priority int) {}
+func (n mockNetService) Relay(name string, msg net.Serializable, priority int)     {}
+func (n mockNetService) SendMsg(name string, msg []byte, target string, priority int) error {
+	return nil
+}
+
+func (n mockNetService) SendMessageToPeers(messageName string, data []byte, priority int, filter net.PeerFilterAlgorithm) []string {
+	return make([]string, 0)
+}
+func (n mockNetService) SendMessageToPeer(messageName string, data []byte, priority int, peerID string) error {
+	return nil
+}
+
+func (n mockNetService) ClosePeer(peerID string, reason error) {}
+
+func (n mockNetService) BroadcastNetworkID([]byte) {}
+
+func mockTransfer(t *testing.T, neb *Neb, from, to string, value uint64, nonce uint64) {
+	fromAddr, err := core.AddressParse(from)
+	assert.Nil(t, err)
+	toAddr, err := core.AddressParse(to)
+	assert.Nil(t, err)
+	assert.Nil(t, neb.am.Unlock(fromAddr, []byte("passphrase"), time.Minute))
+	tx, err := core.NewTransaction(neb.chain.ChainID(), fromAddr, toAddr, util.NewUint128FromUint(value), nonce, core.TxPayloadBinaryType, nil, core.TransactionGasPrice, core.TransactionMaxGas)
+	assert.Nil(t, err)
+	assert.Nil(t, neb.am.SignTransaction(fromAddr, tx))
+	assert.Nil(t, neb.chain.TransactionPool().Push(tx))
+}
+
+func mintBlock(t *testing.T, neb *Neb) *core.Block {
+	block, err := neb.consensus.(*dev.Dev).MintBlock()
+	assert.Nil(t, err)
+	return block
+}
+
+func TestPeriodRange(t *testing.T) {
+	neb := mockNeb(t)
+	nr, err := NewNR(neb)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2), nr.Window())
+
+	start, end := nr.PeriodRange(1)
+	assert.Equal(t, uint64(2), start)
+	assert.Equal(t, uint64(3), end)
+	start, end = nr.PeriodRange(3)
+	assert.Equal(t, uint64(6), start)
+	assert.Equal(t, uint64(7), end)
+
+	assert.Equal(t, uint64(0), nr.PeriodOf(1))
+	assert.Equal(t, uint64(1), nr.PeriodOf(2))
+	assert.Equal(t, uint64(1), nr.PeriodOf(3))
+	assert.Equal(t, uint64(2), nr.PeriodOf(4))
+}
+
+func TestCompute(t *testing.T) {
+	neb := mockNeb(t)
+	nr, err := NewNR(neb)
+	assert.Nil(t, err)
+
+	_, err = nr.Compute(0)
+	assert.Equal(t, ErrInvalidPeriod, err)
+	_, err = nr.Compute(1)
+	assert.Equal(t, ErrPeriodNotReady, err)
+
+	// block 2: miner sends to receiver, the balance of miner is held 1 block since the start of period.
+	mockTransfer(t, neb, testMiner, testReceiver, 1000000000000000000, 1)
+	mintBlock(t, neb)
+	// block 3: receiver returns part of it, held 2 blocks since the inflow in block 2.
+	mockTransfer(t, neb, testReceiver, testMiner, 1000, 1)
+	mockTransfer(t, neb, testMiner, testMiner, 1000, 2)
+	end := mintBlock(t, neb)
+	assert.Equal(t, end.Hash(), neb.chain.LIB().Hash())
+
+	data, err := nr.Compute(1)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), data.Period)
+	assert.Equal(t, uint64(2), data.StartHeight)
+	assert.Equal(t, uint64(3), data.EndHeight)
+	assert.Equal(t, []byte(end.Hash()), data.EndBlock)
+	// transfers to self are not counted.
+	assert.Equal(t, 2, len(data.Nrs))
+
+	scores := make(map[string]float64)
+	for _, item := range data.Nrs {
+		switch item.Address {
+		case testMiner:
+			assert.Equal(t, "1000000000000000000", item.OutFlow)
+			assert.Equal(t, "2000", item.InFlow)
+		case testReceiver:
+			assert.Equal(t, "2000", item.OutFlow)
+			assert.Equal(t, "1000000000000000000", item.InFlow)
+		default:
+			t.Errorf("unexpected address %s", item.Address)
+		}
+		scores[item.Address] = item.Score
+		assert.True(t, item.Weight > 0 && item.Weight < 1)
+	}
+	assert.True(t, scores[testMiner] > scores[testReceiver])
+	assert.True(t, data.Nrs[0].Score >= data.Nrs[1].Score)
+
+	// the result is reproducible.
+	again, err := nr.Compute(1)
+	assert.Nil(t, err)
+	assert.True(t, proto.Equal(data, again))
+}
+
+func TestUpdate(t *testing.T) {
+	neb := mockNeb(t)
+	nr, err := NewNR(neb)
+	assert.Nil(t, err)
+
+	_, err = nr.GetNRData(0)
+	assert.Equal(t, ErrNRNotFound, err)
+
+	mockTransfer(t, neb, testMiner, testReceiver, 1000000000000000000, 1)
+	mintBlock(t, neb)
+	assert.Nil(t, nr.update())
+	latest, err := nr.LatestPeriod()
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), latest)
+
+	mintBlock(t, neb)
+	mintBlock(t, neb)
+	assert.Nil(t, nr.update())
+	latest, err = nr.LatestPeriod()
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), latest)
+
+	receiver, err := core.AddressParse(testReceiver)
+	assert.Nil(t, err)
+	item, data, err := nr.GetNRByAddress(receiver, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), data.Period)
+	assert.Equal(t, testReceiver, item.Address)
+	assert.Equal(t, "2000000000000000000", item.InFlow)
+	assert.True(t, item.Score > 0)
+
+	outsider, err := core.AddressParse(testOutsider)
+	assert.Nil(t, err)
+	item, _, err = nr.GetNRByAddress(outsider, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, "0", item.InFlow)
+	assert.Equal(t, float64(0), item.Score)
+
+	_, _, err = nr.GetNRByAddress(outsider, 2)
+	assert.Equal(t, ErrNRNotFound, err)
+}
+
+func TestRanker(t *testing.T) {
+	neb := mockNeb(t)
+	nr, err := NewNR(neb)
+	assert.Nil(t, err)
+	ranker, err := NewRanker(nr)
+	assert.Nil(t, err)
+
+	miner, err := core.AddressParse(testMiner)
+	assert.Nil(t, err)
+	outsider, err := core.AddressParse(testOutsider)
+	assert.Nil(t, err)
+	deposit := util.NewUint128FromUint(100)
+
+	_, err = ranker.Score(nil, miner.Bytes(), deposit, 0)
+	assert.Equal(t, ErrMissingParentBlock, err)
+
+	// the deposit is the score before the first period ends.
+	mockTransfer(t, neb, testMiner, testReceiver, 1000000000000000000, 1)
+	block := mintBlock(t, neb)
+	score, err := ranker.Score(block.Hash(), miner.Bytes(), deposit, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, deposit, score)
+
+	// the nr of period 1 is computed from the ancestors of parent, not the stored one.
+	mintBlock(t, neb)
+	parent := mintBlock(t, neb)
+	_, err = nr.GetNRData(1)
+	assert.Equal(t, ErrNRNotFound, err)
+	score, err = ranker.Score(parent.Hash(), miner.Bytes(), deposit, 0)
+	assert.Nil(t, err)
+	assert.True(t, score.Cmp(util.NewUint128()) > 0)
+	assert.NotEqual(t, deposit, score)
+
+	score, err = ranker.Score(parent.Hash(), outsider.Bytes(), deposit, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, util.NewUint128(), score)
+
+	assert.Nil(t, nr.update())
+	again, err := ranker.Score(parent.Hash(), miner.Bytes(), deposit, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, score.Cmp(again))
+}
+
+func TestRankHelpers(t *testing.T) {
+	values := []*big.Int{big.NewInt(4), big.NewInt(1), big.NewInt(3), big.NewInt(2)}
+	assert.Equal(t, big.NewInt(2), medianOf(values))
+	assert.Equal(t, big.NewInt(4), values[0])
+	assert.Equal(t, big.NewInt(0), medianOf(nil))
+
+	assert.Equal(t, float64(1), stakeWeight(big.NewInt(0), big.NewInt(0)))
+	assert.Equal(t, 0.5, stakeWeight(big.NewInt(3), big.NewInt(3)))
+	assert.Equal(t, float64(0), stakeWeight(big.NewInt(0), big.NewInt(3)))
+
+	// a -> b, b -> c, c has no outflow.
+	g := newGraph(1)
+	a, _ := core.AddressParse(testMiner)
+	b, _ := core.AddressParse(testReceiver)
+	c, _ := core.AddressParse(testOutsider)
+	g.node(a).edges[1] = big.NewInt(1)
+	g.node(a).out = big.NewInt(1)
+	g.node(b).edges[2] = big.NewInt(1)
+	g.node(b).out = big.NewInt(1)
+	g.node(c)
+	order := map[int]int{0: 0, 1: 1, 2: 2}
+	ranks := pageRank(g.nodes, order)
+	sum := 0.0
+	for _, r := range ranks {
+		sum += r
+	}
+	assert.InDelta(t, 1, sum, 1e-9)
+	assert.True(t, ranks[2] > ranks[1] && ranks[1] > ranks[0])
+}
+
+func TestCoinAge(t *testing.T) {
+	a, _ := core.AddressParse(testMiner)
+	b, _ := core.AddressParse(testReceiver)
+	c, _ := core.AddressParse(testOutsider)
+
+	// a and b send the same value to c at height 20, b received an inflow at height 15.
+	g := newGraph(10)
+	g.add(&transfer{from: c, to: b, value: big.NewInt(1), height: 15})
+	g.add(&transfer{from: a, to: c, value: big.NewInt(100), height: 20})
+	g.add(&transfer{from: b, to: c, value: big.NewInt(100), height: 20})
+
+	// c held its balance since the start of period, a since the start too, b since the inflow.
+	assert.Equal(t, big.NewInt(6), g.node(c).out)
+	assert.Equal(t, big.NewInt(1100), g.node(a).out)
+	assert.Equal(t, big.NewInt(600), g.node(b).out)
+	assert.Equal(t, big.NewInt(1700), g.node(c).in)
+
+	// c spends the inflows received in the same block, held 1 block.
+	g.add(&transfer{from: c, to: a, value: big.NewInt(100), height: 20})
+	assert.Equal(t, big.NewInt(100), g.node(a).in)
+}
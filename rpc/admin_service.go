@@ -19,6 +19,7 @@
 package rpc
 
 import (
+	"errors"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
@@ -254,3 +255,18 @@ func (s *AdminService) NodeInfo(ctx context.Context, req *rpcpb.NonParamsRequest
 
 	return resp, nil
 }
+
+// MintBlock mint a block immediately if the consensus supports minting on demand.
+func (s *AdminService) MintBlock(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.MintBlockResponse, error) {
+
+	neb := s.server.Neblet()
+	minter, ok := neb.Consensus().(core.BlockMinter)
+	if !ok {
+		return nil, errors.New("consensus cannot mint block on demand")
+	}
+	block, err := minter.MintBlock()
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.MintBlockResponse{Hash: block.Hash().String(), Height: block.Height()}, nil
+}
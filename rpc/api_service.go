@@ -30,7 +30,9 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/nebulasio/go-nebulas/core"
 	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/dip"
 	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/nr"
 	"github.com/nebulasio/go-nebulas/rpc/pb"
 	"github.com/nebulasio/go-nebulas/util"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
@@ -198,6 +200,83 @@ func parseTransactionPayload(reqTx *rpcpb.TransactionRequest) (payloadType strin
 					return "", nil, err
 				}
 			}
+		case core.TxPayloadCandidateType:
+			{
+				payloadType = core.TxPayloadCandidateType
+				candidatePayload, err := core.LoadCandidatePayload(reqTx.Binary)
+				if err != nil {
+					return "", nil, err
+				}
+				if payload, err = candidatePayload.ToBytes(); err != nil {
+					return "", nil, err
+				}
+			}
+		case core.TxPayloadDelegateType:
+			{
+				payloadType = core.TxPayloadDelegateType
+				delegatePayload, err := core.LoadDelegatePayload(reqTx.Binary)
+				if err != nil {
+					return "", nil, err
+				}
+				if payload, err = delegatePayload.ToBytes(); err != nil {
+					return "", nil, err
+				}
+			}
+		case core.TxPayloadEvidenceType:
+			{
+				payloadType = core.TxPayloadEvidenceType
+				evidencePayload, err := core.LoadEvidencePayload(reqTx.Binary)
+				if err != nil {
+					return "", nil, err
+				}
+				if payload, err = evidencePayload.ToBytes(); err != nil {
+					return "", nil, err
+				}
+			}
+		case core.TxPayloadAuthorityType:
+			{
+				payloadType = core.TxPayloadAuthorityType
+				authorityPayload, err := core.LoadAuthorityPayload(reqTx.Binary)
+				if err != nil {
+					return "", nil, err
+				}
+				if payload, err = authorityPayload.ToBytes(); err != nil {
+					return "", nil, err
+				}
+			}
+		case core.TxPayloadDevotionType:
+			{
+				payloadType = core.TxPayloadDevotionType
+				devotionPayload, err := core.LoadDevotionPayload(reqTx.Binary)
+				if err != nil {
+					return "", nil, err
+				}
+				if payload, err = devotionPayload.ToBytes(); err != nil {
+					return "", nil, err
+				}
+			}
+		case core.TxPayloadDipType:
+			{
+				payloadType = core.TxPayloadDipType
+				dipPayload, err := core.LoadDipPayload(reqTx.Binary)
+				if err != nil {
+					return "", nil, err
+				}
+				if payload, err = dipPayload.ToBytes(); err != nil {
+					return "", nil, err
+				}
+			}
+		case core.TxPayloadUpgradeType:
+			{
+				payloadType = core.TxPayloadUpgradeType
+				upgradePayload, err := core.LoadUpgradePayload(reqTx.Binary)
+				if err != nil {
+					return "", nil, err
+				}
+				if payload, err = upgradePayload.ToBytes(); err != nil {
+					return "", nil, err
+				}
+			}
 		default:
 			return "", nil, core.ErrInvalidTxPayloadType
 		}
@@ -443,6 +522,128 @@ func (s *APIService) GetTransactionByContract(ctx context.Context, req *rpcpb.Ge
 	return s.toTransactionResponse(tx)
 }
 
+// GetContract get the source, deployer and public functions of the contract
+func (s *APIService) GetContract(ctx context.Context, req *rpcpb.GetContractRequest) (*rpcpb.ContractResponse, error) {
+
+	neb := s.server.Neblet()
+
+	addr, err := core.AddressParse(req.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+
+	contract, err := neb.BlockChain().GetContract(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	deployTx, err := neb.BlockChain().GetTransaction(contract.BirthPlace())
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := core.ContractVersion(contract)
+	if err != nil {
+		return nil, err
+	}
+
+	source, sourceType, err := neb.BlockChain().GetContractSource(contract)
+	if err != nil {
+		return nil, err
+	}
+
+	functions, err := neb.Nvm().PublicFunctions(source, sourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpcpb.ContractResponse{
+		Address:    addr.String(),
+		Source:     source,
+		SourceType: sourceType,
+		DeployTx:   deployTx.Hash().String(),
+		Deployer:   deployTx.From().String(),
+		Functions:  functions,
+		Version:    version,
+	}, nil
+}
+
+// GetGasSchedules get all versions of gas schedule for NVM operations
+func (s *APIService) GetGasSchedules(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.GasSchedulesResponse, error) {
+
+	neb := s.server.Neblet()
+
+	schedules, active, err := neb.BlockChain().GasSchedules()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.GasSchedulesResponse{ActiveVersion: active.Version}
+	for _, schedule := range schedules {
+		table, err := json.Marshal(schedule.Table)
+		if err != nil {
+			return nil, err
+		}
+		resp.Schedules = append(resp.Schedules, &rpcpb.GasSchedule{
+			Version: schedule.Version,
+			Height:  schedule.Height,
+			Table:   string(table),
+		})
+	}
+	return resp, nil
+}
+
+// GetFinalityProof get the pre-commits of block signed by its dynasty members
+func (s *APIService) GetFinalityProof(ctx context.Context, req *rpcpb.HashRequest) (*rpcpb.FinalityProofResponse, error) {
+	neb := s.server.Neblet()
+
+	if len(req.Hash) == 0 {
+		return nil, errors.New("please input valid hash")
+	}
+	hash, err := byteutils.FromHex(req.Hash)
+	if err != nil {
+		return nil, err
+	}
+	block := neb.BlockChain().GetBlock(hash)
+	if block == nil {
+		return nil, errors.New("block not found")
+	}
+
+	proof, err := neb.BlockChain().GetFinalityProof(hash)
+	if err != nil {
+		if err == storage.ErrKeyNotFound {
+			return nil, errors.New("block is not finalized")
+		}
+		return nil, err
+	}
+	data, err := proto.Marshal(proof)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.FinalityProofResponse{
+		BlockHash: block.Hash().String(),
+		Height:    block.Height(),
+		Proof:     data,
+	}
+	for _, pbVote := range proof.Votes {
+		vote := new(core.PreCommit)
+		if err := vote.FromProto(pbVote); err != nil {
+			return nil, err
+		}
+		signer, err := vote.Signer()
+		if err != nil {
+			return nil, err
+		}
+		resp.Votes = append(resp.Votes, &rpcpb.PreCommitVote{
+			Signer: signer.String(),
+			Alg:    pbVote.Alg,
+			Sign:   byteutils.Hex(pbVote.Sign),
+		})
+	}
+	return resp, nil
+}
+
 func (s *APIService) toTransactionResponse(tx *core.Transaction) (*rpcpb.TransactionResponse, error) {
 	var (
 		status         int32
@@ -625,3 +826,106 @@ func (s *APIService) GetDynasty(ctx context.Context, req *rpcpb.ByBlockHeightReq
 	}
 	return &rpcpb.GetDynastyResponse{Miners: result}, nil
 }
+
+// GetNRByAddress return the nebulas rank of the address in the period.
+func (s *APIService) GetNRByAddress(ctx context.Context, req *rpcpb.GetNRByAddressRequest) (*rpcpb.NRResponse, error) {
+	neb, ok := s.server.Neblet().(interface {
+		NR() *nr.NR
+	})
+	if !ok || neb.NR() == nil {
+		return nil, errors.New("nebulas rank is not supported")
+	}
+
+	addr, err := core.AddressParse(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	item, data, err := neb.NR().GetNRByAddress(addr, req.Period)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.NRResponse{
+		Period:      data.Period,
+		StartHeight: data.StartHeight,
+		EndHeight:   data.EndHeight,
+		Address:     addr.String(),
+		InFlow:      item.InFlow,
+		OutFlow:     item.OutFlow,
+		Stake:       item.Stake,
+		Median:      data.Median,
+		Weight:      item.Weight,
+		Score:       item.Score,
+	}, nil
+}
+
+// GetDipRewards return the developer incentive rewards of the period.
+func (s *APIService) GetDipRewards(ctx context.Context, req *rpcpb.GetDipRewardsRequest) (*rpcpb.DipRewardsResponse, error) {
+	neb, ok := s.server.Neblet().(interface {
+		Dip() *dip.Dip
+	})
+	if !ok || neb.Dip() == nil {
+		return nil, core.ErrDipNotEnabled
+	}
+
+	data, err := neb.Dip().GetDipData(req.Period)
+	if err != nil {
+		return nil, err
+	}
+	rewards := []*rpcpb.DipReward{}
+	for _, item := range data.Items {
+		rewards = append(rewards, &rpcpb.DipReward{
+			Contract: item.Contract,
+			Deployer: item.Deployer,
+			Callers:  item.Callers,
+			Weight:   item.Weight,
+			Reward:   item.Reward,
+		})
+	}
+	return &rpcpb.DipRewardsResponse{
+		Period:      data.Period,
+		StartHeight: data.StartHeight,
+		EndHeight:   data.EndHeight,
+		RewardValue: data.RewardValue,
+		Rewards:     rewards,
+	}, nil
+}
+
+// GetMinerStats is the RPC API handler.
+func (s *APIService) GetMinerStats(ctx context.Context, req *rpcpb.GetMinerStatsRequest) (*rpcpb.MinerStatsResponse, error) {
+	neb := s.server.Neblet()
+	recorder, ok := neb.Consensus().(core.MinerStatsRecorder)
+	if !ok {
+		return nil, errors.New("consensus doesn't record miner stats")
+	}
+
+	block := neb.BlockChain().LIB()
+	if req.Height > 0 {
+		block = neb.BlockChain().GetBlockOnCanonicalChainByHeight(req.Height)
+		if block == nil {
+			return nil, errors.New("block not found")
+		}
+	}
+
+	dynasty, stats, err := recorder.MinerStats(block.Timestamp())
+	if err != nil {
+		return nil, err
+	}
+	result := []*rpcpb.MinerStats{}
+	for _, v := range stats {
+		addr, err := core.AddressParseFromBytes(v.Miner)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, &rpcpb.MinerStats{
+			Miner:    addr.String(),
+			Produced: v.Produced,
+			Missed:   v.Missed,
+		})
+	}
+	return &rpcpb.MinerStatsResponse{
+		Dynasty: dynasty,
+		Height:  block.Height(),
+		Stats:   result,
+	}, nil
+}
@@ -51,6 +51,21 @@ It has these top-level messages:
 	PprofRequest
 	PprofResponse
 	GetConfigResponse
+	MintBlockResponse
+	GetNRByAddressRequest
+	NRResponse
+	GetDipRewardsRequest
+	DipRewardsResponse
+	DipReward
+	GetMinerStatsRequest
+	MinerStatsResponse
+	MinerStats
+	GetContractRequest
+	ContractResponse
+	GasSchedule
+	GasSchedulesResponse
+	PreCommitVote
+	FinalityProofResponse
 */
 package rpcpb
 
@@ -1447,6 +1462,608 @@ func (m *GetConfigResponse) GetConfig() *nebletpb.Config {
 	return nil
 }
 
+type MintBlockResponse struct {
+	// Hex hash of the minted block.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Height of the minted block.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *MintBlockResponse) Reset()                    { *m = MintBlockResponse{} }
+func (m *MintBlockResponse) String() string            { return proto.CompactTextString(m) }
+func (*MintBlockResponse) ProtoMessage()               {}
+func (*MintBlockResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{43} }
+
+func (m *MintBlockResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *MintBlockResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// Request message of GetNRByAddress rpc.
+type GetNRByAddressRequest struct {
+	// Hex string of the account address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Nebulas Rank period, 0 for the latest computed period.
+	Period uint64 `protobuf:"varint,2,opt,name=period,proto3" json:"period,omitempty"`
+}
+
+func (m *GetNRByAddressRequest) Reset()                    { *m = GetNRByAddressRequest{} }
+func (m *GetNRByAddressRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetNRByAddressRequest) ProtoMessage()               {}
+func (*GetNRByAddressRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{44} }
+
+func (m *GetNRByAddressRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetNRByAddressRequest) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+// Response message of GetNRByAddress rpc.
+type NRResponse struct {
+	// Nebulas Rank period.
+	Period uint64 `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"`
+	// First block height of the period.
+	StartHeight uint64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// Last block height of the period.
+	EndHeight uint64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// Hex string of the account address.
+	Address string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	// Coin-age weighted value transferred into the account.
+	InFlow string `protobuf:"bytes,5,opt,name=in_flow,json=inFlow,proto3" json:"in_flow,omitempty"`
+	// Coin-age weighted value transferred out of the account.
+	OutFlow string `protobuf:"bytes,6,opt,name=out_flow,json=outFlow,proto3" json:"out_flow,omitempty"`
+	// Balance of the account at the end of the period.
+	Stake string `protobuf:"bytes,7,opt,name=stake,proto3" json:"stake,omitempty"`
+	// Median stake of the active accounts in the period.
+	Median string `protobuf:"bytes,8,opt,name=median,proto3" json:"median,omitempty"`
+	// Stake weight of the account.
+	Weight float64 `protobuf:"fixed64,9,opt,name=weight,proto3" json:"weight,omitempty"`
+	// Nebulas Rank score of the account.
+	Score float64 `protobuf:"fixed64,10,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (m *NRResponse) Reset()                    { *m = NRResponse{} }
+func (m *NRResponse) String() string            { return proto.CompactTextString(m) }
+func (*NRResponse) ProtoMessage()               {}
+func (*NRResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{45} }
+
+func (m *NRResponse) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *NRResponse) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *NRResponse) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *NRResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *NRResponse) GetInFlow() string {
+	if m != nil {
+		return m.InFlow
+	}
+	return ""
+}
+
+func (m *NRResponse) GetOutFlow() string {
+	if m != nil {
+		return m.OutFlow
+	}
+	return ""
+}
+
+func (m *NRResponse) GetStake() string {
+	if m != nil {
+		return m.Stake
+	}
+	return ""
+}
+
+func (m *NRResponse) GetMedian() string {
+	if m != nil {
+		return m.Median
+	}
+	return ""
+}
+
+func (m *NRResponse) GetWeight() float64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func (m *NRResponse) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+// Request message of GetDipRewards rpc.
+type GetDipRewardsRequest struct {
+	// DIP period, 0 for the latest computed period.
+	Period uint64 `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"`
+}
+
+func (m *GetDipRewardsRequest) Reset()                    { *m = GetDipRewardsRequest{} }
+func (m *GetDipRewardsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetDipRewardsRequest) ProtoMessage()               {}
+func (*GetDipRewardsRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{46} }
+
+func (m *GetDipRewardsRequest) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+// Response message of GetDipRewards rpc.
+type DipRewardsResponse struct {
+	// DIP period.
+	Period uint64 `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"`
+	// First block height of the period.
+	StartHeight uint64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// Last block height of the period.
+	EndHeight uint64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// Total reward of the period in wei.
+	RewardValue string `protobuf:"bytes,4,opt,name=reward_value,json=rewardValue,proto3" json:"reward_value,omitempty"`
+	// Rewards sorted by weight in descending order.
+	Rewards []*DipReward `protobuf:"bytes,5,rep,name=rewards" json:"rewards,omitempty"`
+}
+
+func (m *DipRewardsResponse) Reset()                    { *m = DipRewardsResponse{} }
+func (m *DipRewardsResponse) String() string            { return proto.CompactTextString(m) }
+func (*DipRewardsResponse) ProtoMessage()               {}
+func (*DipRewardsResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{47} }
+
+func (m *DipRewardsResponse) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *DipRewardsResponse) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *DipRewardsResponse) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *DipRewardsResponse) GetRewardValue() string {
+	if m != nil {
+		return m.RewardValue
+	}
+	return ""
+}
+
+func (m *DipRewardsResponse) GetRewards() []*DipReward {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+// Reward of a contract in a DIP period.
+type DipReward struct {
+	// Hex string of the contract address.
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// Hex string of the deployer address.
+	Deployer string `protobuf:"bytes,2,opt,name=deployer,proto3" json:"deployer,omitempty"`
+	// Count of distinct callers in the period.
+	Callers uint64 `protobuf:"varint,3,opt,name=callers,proto3" json:"callers,omitempty"`
+	// Usage weight, the sum of nebulas rank score of the callers.
+	Weight float64 `protobuf:"fixed64,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	// Reward paid to the deployer in wei.
+	Reward string `protobuf:"bytes,5,opt,name=reward,proto3" json:"reward,omitempty"`
+}
+
+func (m *DipReward) Reset()                    { *m = DipReward{} }
+func (m *DipReward) String() string            { return proto.CompactTextString(m) }
+func (*DipReward) ProtoMessage()               {}
+func (*DipReward) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{48} }
+
+func (m *DipReward) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *DipReward) GetDeployer() string {
+	if m != nil {
+		return m.Deployer
+	}
+	return ""
+}
+
+func (m *DipReward) GetCallers() uint64 {
+	if m != nil {
+		return m.Callers
+	}
+	return 0
+}
+
+func (m *DipReward) GetWeight() float64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func (m *DipReward) GetReward() string {
+	if m != nil {
+		return m.Reward
+	}
+	return ""
+}
+
+// Request message of GetMinerStats rpc.
+type GetMinerStatsRequest struct {
+	// Block height in the dynasty, 0 for the latest irreversible block.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *GetMinerStatsRequest) Reset()                    { *m = GetMinerStatsRequest{} }
+func (m *GetMinerStatsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetMinerStatsRequest) ProtoMessage()               {}
+func (*GetMinerStatsRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{49} }
+
+func (m *GetMinerStatsRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// Response message of GetMinerStats rpc.
+type MinerStatsResponse struct {
+	// Dynasty index, the timestamp divided by the dynasty interval.
+	Dynasty int64 `protobuf:"varint,1,opt,name=dynasty,proto3" json:"dynasty,omitempty"`
+	// Height of the block the dynasty is resolved by.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// Stats of the miners, recorded up to the latest irreversible block.
+	Stats []*MinerStats `protobuf:"bytes,3,rep,name=stats" json:"stats,omitempty"`
+}
+
+func (m *MinerStatsResponse) Reset()                    { *m = MinerStatsResponse{} }
+func (m *MinerStatsResponse) String() string            { return proto.CompactTextString(m) }
+func (*MinerStatsResponse) ProtoMessage()               {}
+func (*MinerStatsResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{50} }
+
+func (m *MinerStatsResponse) GetDynasty() int64 {
+	if m != nil {
+		return m.Dynasty
+	}
+	return 0
+}
+
+func (m *MinerStatsResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MinerStatsResponse) GetStats() []*MinerStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+// Produced and missed blocks of a miner in a dynasty.
+type MinerStats struct {
+	// Hex string of the miner address.
+	Miner string `protobuf:"bytes,1,opt,name=miner,proto3" json:"miner,omitempty"`
+	// Count of irreversible blocks minted by the miner.
+	Produced uint64 `protobuf:"varint,2,opt,name=produced,proto3" json:"produced,omitempty"`
+	// Count of the miner's slots with no block minted.
+	Missed uint64 `protobuf:"varint,3,opt,name=missed,proto3" json:"missed,omitempty"`
+}
+
+func (m *MinerStats) Reset()                    { *m = MinerStats{} }
+func (m *MinerStats) String() string            { return proto.CompactTextString(m) }
+func (*MinerStats) ProtoMessage()               {}
+func (*MinerStats) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{51} }
+
+func (m *MinerStats) GetMiner() string {
+	if m != nil {
+		return m.Miner
+	}
+	return ""
+}
+
+func (m *MinerStats) GetProduced() uint64 {
+	if m != nil {
+		return m.Produced
+	}
+	return 0
+}
+
+func (m *MinerStats) GetMissed() uint64 {
+	if m != nil {
+		return m.Missed
+	}
+	return 0
+}
+
+// Request message of GetContract rpc.
+type GetContractRequest struct {
+	// string of contract address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *GetContractRequest) Reset()                    { *m = GetContractRequest{} }
+func (m *GetContractRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetContractRequest) ProtoMessage()               {}
+func (*GetContractRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{52} }
+
+func (m *GetContractRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// Response message of GetContract rpc.
+type ContractResponse struct {
+	// Hex string of the contract address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Source of the latest version of the contract.
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// Source type of the contract, js or ts.
+	SourceType string `protobuf:"bytes,3,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"`
+	// Hex string of the deploy tx hash.
+	DeployTx string `protobuf:"bytes,4,opt,name=deploy_tx,json=deployTx,proto3" json:"deploy_tx,omitempty"`
+	// Hex string of the deployer address.
+	Deployer string `protobuf:"bytes,5,opt,name=deployer,proto3" json:"deployer,omitempty"`
+	// Public functions callable by call payloads.
+	Functions []string `protobuf:"bytes,6,rep,name=functions" json:"functions,omitempty"`
+	// Version of the contract, 0 if never upgraded.
+	Version uint64 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *ContractResponse) Reset()                    { *m = ContractResponse{} }
+func (m *ContractResponse) String() string            { return proto.CompactTextString(m) }
+func (*ContractResponse) ProtoMessage()               {}
+func (*ContractResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{53} }
+
+func (m *ContractResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ContractResponse) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *ContractResponse) GetSourceType() string {
+	if m != nil {
+		return m.SourceType
+	}
+	return ""
+}
+
+func (m *ContractResponse) GetDeployTx() string {
+	if m != nil {
+		return m.DeployTx
+	}
+	return ""
+}
+
+func (m *ContractResponse) GetDeployer() string {
+	if m != nil {
+		return m.Deployer
+	}
+	return ""
+}
+
+func (m *ContractResponse) GetFunctions() []string {
+	if m != nil {
+		return m.Functions
+	}
+	return nil
+}
+
+func (m *ContractResponse) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+// Gas schedule message, a version of gas table activated since the height.
+type GasSchedule struct {
+	// Version of the gas schedule, 0 is the default one.
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Height since which the gas schedule is activated.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// Gas table in JSON.
+	Table string `protobuf:"bytes,3,opt,name=table,proto3" json:"table,omitempty"`
+}
+
+func (m *GasSchedule) Reset()                    { *m = GasSchedule{} }
+func (m *GasSchedule) String() string            { return proto.CompactTextString(m) }
+func (*GasSchedule) ProtoMessage()               {}
+func (*GasSchedule) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{54} }
+
+func (m *GasSchedule) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *GasSchedule) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GasSchedule) GetTable() string {
+	if m != nil {
+		return m.Table
+	}
+	return ""
+}
+
+// Response message of GetGasSchedules rpc.
+type GasSchedulesResponse struct {
+	// All versions of gas schedule in ascending order.
+	Schedules []*GasSchedule `protobuf:"bytes,1,rep,name=schedules" json:"schedules,omitempty"`
+	// Version of the gas schedule activated at the tail block.
+	ActiveVersion uint64 `protobuf:"varint,2,opt,name=active_version,json=activeVersion,proto3" json:"active_version,omitempty"`
+}
+
+func (m *GasSchedulesResponse) Reset()                    { *m = GasSchedulesResponse{} }
+func (m *GasSchedulesResponse) String() string            { return proto.CompactTextString(m) }
+func (*GasSchedulesResponse) ProtoMessage()               {}
+func (*GasSchedulesResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{55} }
+
+func (m *GasSchedulesResponse) GetSchedules() []*GasSchedule {
+	if m != nil {
+		return m.Schedules
+	}
+	return nil
+}
+
+func (m *GasSchedulesResponse) GetActiveVersion() uint64 {
+	if m != nil {
+		return m.ActiveVersion
+	}
+	return 0
+}
+
+// Pre-commit of a dynasty member for the finality of a block.
+type PreCommitVote struct {
+	// Address of the voter.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Signature algorithm.
+	Alg uint32 `protobuf:"varint,2,opt,name=alg,proto3" json:"alg,omitempty"`
+	// Hex string of signature on the pre-commit hash of block.
+	Sign string `protobuf:"bytes,3,opt,name=sign,proto3" json:"sign,omitempty"`
+}
+
+func (m *PreCommitVote) Reset()                    { *m = PreCommitVote{} }
+func (m *PreCommitVote) String() string            { return proto.CompactTextString(m) }
+func (*PreCommitVote) ProtoMessage()               {}
+func (*PreCommitVote) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{56} }
+
+func (m *PreCommitVote) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *PreCommitVote) GetAlg() uint32 {
+	if m != nil {
+		return m.Alg
+	}
+	return 0
+}
+
+func (m *PreCommitVote) GetSign() string {
+	if m != nil {
+		return m.Sign
+	}
+	return ""
+}
+
+// Response message of GetFinalityProof rpc.
+type FinalityProofResponse struct {
+	// Hex string of finalized block hash.
+	BlockHash string `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// Height of finalized block.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// Pre-commits of the dynasty members of block.
+	Votes []*PreCommitVote `protobuf:"bytes,3,rep,name=votes" json:"votes,omitempty"`
+	// Protobuf encoded proof for verification.
+	Proof []byte `protobuf:"bytes,4,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *FinalityProofResponse) Reset()                    { *m = FinalityProofResponse{} }
+func (m *FinalityProofResponse) String() string            { return proto.CompactTextString(m) }
+func (*FinalityProofResponse) ProtoMessage()               {}
+func (*FinalityProofResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{57} }
+
+func (m *FinalityProofResponse) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *FinalityProofResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *FinalityProofResponse) GetVotes() []*PreCommitVote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+func (m *FinalityProofResponse) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*SubscribeRequest)(nil), "rpcpb.SubscribeRequest")
 	proto.RegisterType((*SubscribeResponse)(nil), "rpcpb.SubscribeResponse")
@@ -1491,6 +2108,21 @@ func init() {
 	proto.RegisterType((*PprofRequest)(nil), "rpcpb.PprofRequest")
 	proto.RegisterType((*PprofResponse)(nil), "rpcpb.PprofResponse")
 	proto.RegisterType((*GetConfigResponse)(nil), "rpcpb.GetConfigResponse")
+	proto.RegisterType((*MintBlockResponse)(nil), "rpcpb.MintBlockResponse")
+	proto.RegisterType((*GetNRByAddressRequest)(nil), "rpcpb.GetNRByAddressRequest")
+	proto.RegisterType((*NRResponse)(nil), "rpcpb.NRResponse")
+	proto.RegisterType((*GetDipRewardsRequest)(nil), "rpcpb.GetDipRewardsRequest")
+	proto.RegisterType((*DipRewardsResponse)(nil), "rpcpb.DipRewardsResponse")
+	proto.RegisterType((*DipReward)(nil), "rpcpb.DipReward")
+	proto.RegisterType((*GetMinerStatsRequest)(nil), "rpcpb.GetMinerStatsRequest")
+	proto.RegisterType((*MinerStatsResponse)(nil), "rpcpb.MinerStatsResponse")
+	proto.RegisterType((*MinerStats)(nil), "rpcpb.MinerStats")
+	proto.RegisterType((*GetContractRequest)(nil), "rpcpb.GetContractRequest")
+	proto.RegisterType((*ContractResponse)(nil), "rpcpb.ContractResponse")
+	proto.RegisterType((*GasSchedule)(nil), "rpcpb.GasSchedule")
+	proto.RegisterType((*GasSchedulesResponse)(nil), "rpcpb.GasSchedulesResponse")
+	proto.RegisterType((*PreCommitVote)(nil), "rpcpb.PreCommitVote")
+	proto.RegisterType((*FinalityProofResponse)(nil), "rpcpb.FinalityProofResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1530,6 +2162,18 @@ type ApiServiceClient interface {
 	EstimateGas(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*GasResponse, error)
 	GetEventsByHash(ctx context.Context, in *HashRequest, opts ...grpc.CallOption) (*EventsResponse, error)
 	GetDynasty(ctx context.Context, in *ByBlockHeightRequest, opts ...grpc.CallOption) (*GetDynastyResponse, error)
+	// Return the nebulas rank of the address.
+	GetNRByAddress(ctx context.Context, in *GetNRByAddressRequest, opts ...grpc.CallOption) (*NRResponse, error)
+	// Return the developer incentive rewards of the period.
+	GetDipRewards(ctx context.Context, in *GetDipRewardsRequest, opts ...grpc.CallOption) (*DipRewardsResponse, error)
+	// Return the produced and missed blocks of the miners in a dynasty.
+	GetMinerStats(ctx context.Context, in *GetMinerStatsRequest, opts ...grpc.CallOption) (*MinerStatsResponse, error)
+	// Return the source, deployer and public functions of a contract.
+	GetContract(ctx context.Context, in *GetContractRequest, opts ...grpc.CallOption) (*ContractResponse, error)
+	// Return the gas schedules of NVM operations.
+	GetGasSchedules(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GasSchedulesResponse, error)
+	// Return the finality proof of block signed by its dynasty members.
+	GetFinalityProof(ctx context.Context, in *HashRequest, opts ...grpc.CallOption) (*FinalityProofResponse, error)
 }
 
 type apiServiceClient struct {
@@ -1689,6 +2333,60 @@ func (c *apiServiceClient) GetDynasty(ctx context.Context, in *ByBlockHeightRequ
 	return out, nil
 }
 
+func (c *apiServiceClient) GetNRByAddress(ctx context.Context, in *GetNRByAddressRequest, opts ...grpc.CallOption) (*NRResponse, error) {
+	out := new(NRResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetNRByAddress", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetDipRewards(ctx context.Context, in *GetDipRewardsRequest, opts ...grpc.CallOption) (*DipRewardsResponse, error) {
+	out := new(DipRewardsResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetDipRewards", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetMinerStats(ctx context.Context, in *GetMinerStatsRequest, opts ...grpc.CallOption) (*MinerStatsResponse, error) {
+	out := new(MinerStatsResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetMinerStats", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetContract(ctx context.Context, in *GetContractRequest, opts ...grpc.CallOption) (*ContractResponse, error) {
+	out := new(ContractResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetContract", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetGasSchedules(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GasSchedulesResponse, error) {
+	out := new(GasSchedulesResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetGasSchedules", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetFinalityProof(ctx context.Context, in *HashRequest, opts ...grpc.CallOption) (*FinalityProofResponse, error) {
+	out := new(FinalityProofResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetFinalityProof", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for ApiService service
 
 type ApiServiceServer interface {
@@ -1718,6 +2416,18 @@ type ApiServiceServer interface {
 	EstimateGas(context.Context, *TransactionRequest) (*GasResponse, error)
 	GetEventsByHash(context.Context, *HashRequest) (*EventsResponse, error)
 	GetDynasty(context.Context, *ByBlockHeightRequest) (*GetDynastyResponse, error)
+	// Return the nebulas rank of the address.
+	GetNRByAddress(context.Context, *GetNRByAddressRequest) (*NRResponse, error)
+	// Return the developer incentive rewards of the period.
+	GetDipRewards(context.Context, *GetDipRewardsRequest) (*DipRewardsResponse, error)
+	// Return the produced and missed blocks of the miners in a dynasty.
+	GetMinerStats(context.Context, *GetMinerStatsRequest) (*MinerStatsResponse, error)
+	// Return the source, deployer and public functions of a contract.
+	GetContract(context.Context, *GetContractRequest) (*ContractResponse, error)
+	// Return the gas schedules of NVM operations.
+	GetGasSchedules(context.Context, *NonParamsRequest) (*GasSchedulesResponse, error)
+	// Return the finality proof of block signed by its dynasty members.
+	GetFinalityProof(context.Context, *HashRequest) (*FinalityProofResponse, error)
 }
 
 func RegisterApiServiceServer(s *grpc.Server, srv ApiServiceServer) {
@@ -1979,6 +2689,114 @@ func _ApiService_GetDynasty_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_GetNRByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNRByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetNRByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetNRByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetNRByAddress(ctx, req.(*GetNRByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetDipRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDipRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetDipRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetDipRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetDipRewards(ctx, req.(*GetDipRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetMinerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMinerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetMinerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetMinerStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetMinerStats(ctx, req.(*GetMinerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetContract(ctx, req.(*GetContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetGasSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetGasSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetGasSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetGasSchedules(ctx, req.(*NonParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetFinalityProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetFinalityProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetFinalityProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetFinalityProof(ctx, req.(*HashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ApiService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "rpcpb.ApiService",
 	HandlerType: (*ApiServiceServer)(nil),
@@ -2035,6 +2853,30 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetDynasty",
 			Handler:    _ApiService_GetDynasty_Handler,
 		},
+		{
+			MethodName: "GetNRByAddress",
+			Handler:    _ApiService_GetNRByAddress_Handler,
+		},
+		{
+			MethodName: "GetDipRewards",
+			Handler:    _ApiService_GetDipRewards_Handler,
+		},
+		{
+			MethodName: "GetMinerStats",
+			Handler:    _ApiService_GetMinerStats_Handler,
+		},
+		{
+			MethodName: "GetContract",
+			Handler:    _ApiService_GetContract_Handler,
+		},
+		{
+			MethodName: "GetGasSchedules",
+			Handler:    _ApiService_GetGasSchedules_Handler,
+		},
+		{
+			MethodName: "GetFinalityProof",
+			Handler:    _ApiService_GetFinalityProof_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2071,6 +2913,8 @@ type AdminServiceClient interface {
 	GetConfig(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GetConfigResponse, error)
 	// Return the p2p node info.
 	NodeInfo(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*NodeInfoResponse, error)
+	// Mint a block immediately, only supported by the dev consensus.
+	MintBlock(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*MintBlockResponse, error)
 }
 
 type adminServiceClient struct {
@@ -2189,6 +3033,15 @@ func (c *adminServiceClient) NodeInfo(ctx context.Context, in *NonParamsRequest,
 	return out, nil
 }
 
+func (c *adminServiceClient) MintBlock(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*MintBlockResponse, error) {
+	out := new(MintBlockResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/MintBlock", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for AdminService service
 
 type AdminServiceServer interface {
@@ -2214,6 +3067,8 @@ type AdminServiceServer interface {
 	GetConfig(context.Context, *NonParamsRequest) (*GetConfigResponse, error)
 	// Return the p2p node info.
 	NodeInfo(context.Context, *NonParamsRequest) (*NodeInfoResponse, error)
+	// Mint a block immediately, only supported by the dev consensus.
+	MintBlock(context.Context, *NonParamsRequest) (*MintBlockResponse, error)
 }
 
 func RegisterAdminServiceServer(s *grpc.Server, srv AdminServiceServer) {
@@ -2436,6 +3291,24 @@ func _AdminService_NodeInfo_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_MintBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).MintBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/MintBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).MintBlock(ctx, req.(*NonParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AdminService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "rpcpb.AdminService",
 	HandlerType: (*AdminServiceServer)(nil),
@@ -2488,6 +3361,10 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "NodeInfo",
 			Handler:    _AdminService_NodeInfo_Handler,
 		},
+		{
+			MethodName: "MintBlock",
+			Handler:    _AdminService_MintBlock_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpc.proto",
@@ -2496,154 +3373,197 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("rpc.proto", fileDescriptorRpc) }
 
 var fileDescriptorRpc = []byte{
-	// 2380 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x4b, 0x6f, 0x1b, 0xc9,
-	0x11, 0x06, 0x45, 0xbd, 0x58, 0xa4, 0x24, 0xba, 0xf5, 0x1a, 0x51, 0x0f, 0x4b, 0xed, 0x8d, 0xad,
-	0x35, 0x76, 0xc5, 0xb5, 0x16, 0x70, 0x02, 0x2f, 0x36, 0x80, 0xed, 0x78, 0xb5, 0x0e, 0x0c, 0x43,
-	0x19, 0x79, 0x93, 0x05, 0x12, 0x87, 0x68, 0x0e, 0x9b, 0xe4, 0x64, 0x47, 0x33, 0x4c, 0x77, 0x53,
-	0xb6, 0x9c, 0x43, 0x00, 0x9f, 0x93, 0x53, 0x2e, 0x39, 0xe4, 0x47, 0xe4, 0x0f, 0xe4, 0x57, 0xe4,
-	0x90, 0x4b, 0x0e, 0x39, 0xe4, 0x77, 0x04, 0x41, 0xd7, 0x74, 0xcf, 0x8b, 0x43, 0x31, 0xce, 0x61,
-	0x6f, 0x53, 0xd5, 0xdd, 0x55, 0xd5, 0xf5, 0xf8, 0xba, 0xba, 0x07, 0x6a, 0x62, 0xe4, 0x9d, 0x8c,
-	0x44, 0xa4, 0x22, 0xb2, 0x20, 0x46, 0xde, 0xa8, 0xdb, 0xda, 0x1b, 0x44, 0xd1, 0x20, 0xe0, 0x6d,
-	0x36, 0xf2, 0xdb, 0x2c, 0x0c, 0x23, 0xc5, 0x94, 0x1f, 0x85, 0x32, 0x9e, 0xd4, 0xfa, 0xd1, 0xc0,
-	0x57, 0xc3, 0x71, 0xf7, 0xc4, 0x8b, 0x2e, 0xdb, 0x21, 0xef, 0x8e, 0x03, 0x26, 0xfd, 0xa8, 0x3d,
-	0x88, 0x3e, 0x35, 0x44, 0xdb, 0x8b, 0x42, 0xc9, 0x43, 0x39, 0x96, 0xed, 0x51, 0xb7, 0x2d, 0x15,
-	0x53, 0xdc, 0xac, 0x7c, 0x38, 0x6b, 0x65, 0xc8, 0xbb, 0x01, 0x57, 0x7a, 0x99, 0x17, 0x85, 0x7d,
-	0x7f, 0x10, 0xaf, 0xa3, 0xf7, 0xa1, 0x79, 0x31, 0xee, 0x4a, 0x4f, 0xf8, 0x5d, 0xee, 0xf2, 0xdf,
-	0x8e, 0xb9, 0x54, 0x64, 0x0b, 0x16, 0x55, 0x34, 0xf2, 0x3d, 0xe9, 0x54, 0x0e, 0xab, 0xc7, 0x35,
-	0xd7, 0x50, 0xf4, 0x4b, 0xb8, 0x95, 0x99, 0x2b, 0x47, 0xda, 0x16, 0xb2, 0x01, 0x0b, 0x38, 0xec,
-	0x54, 0x0e, 0x2b, 0xc7, 0x35, 0x37, 0x26, 0x08, 0x81, 0xf9, 0x1e, 0x53, 0xcc, 0x99, 0x43, 0x26,
-	0x7e, 0x53, 0x02, 0xcd, 0x97, 0x51, 0x78, 0xce, 0x04, 0xbb, 0x94, 0x46, 0x15, 0xfd, 0xcb, 0x9c,
-	0x66, 0xf6, 0xf8, 0xf3, 0xb0, 0x1f, 0x25, 0x22, 0x57, 0x61, 0xce, 0xef, 0x19, 0x79, 0x73, 0x7e,
-	0x8f, 0xec, 0xc0, 0xb2, 0x37, 0x64, 0x7e, 0xd8, 0xf1, 0x7b, 0x28, 0x70, 0xc5, 0x5d, 0x42, 0xfa,
-	0x79, 0x8f, 0xb4, 0x60, 0xd9, 0x8b, 0xfc, 0xb0, 0xcb, 0x24, 0x77, 0xaa, 0xb8, 0x20, 0xa1, 0xc9,
-	0x3e, 0xc0, 0x88, 0x73, 0xd1, 0xf1, 0xa2, 0x71, 0xa8, 0x9c, 0x79, 0x5c, 0x58, 0xd3, 0x9c, 0xa7,
-	0x9a, 0x41, 0x28, 0x34, 0xe4, 0x75, 0xe8, 0x0d, 0x45, 0x14, 0xfa, 0xef, 0x78, 0xcf, 0x59, 0x38,
-	0xac, 0x1c, 0x2f, 0xbb, 0x39, 0x1e, 0xb9, 0x0d, 0xf5, 0xee, 0xd8, 0xfb, 0x8e, 0xab, 0x8e, 0xf4,
-	0xdf, 0x71, 0x67, 0xf1, 0xb0, 0x72, 0xbc, 0xe0, 0x42, 0xcc, 0xba, 0xf0, 0xdf, 0x71, 0xf2, 0x31,
-	0x34, 0xd1, 0x8f, 0x5e, 0x14, 0x74, 0xae, 0xb8, 0x90, 0x7e, 0x14, 0x3a, 0x80, 0x76, 0xac, 0x59,
-	0xfe, 0xcf, 0x63, 0x36, 0x39, 0x85, 0xba, 0x88, 0xc6, 0x8a, 0x77, 0x14, 0xeb, 0x06, 0xdc, 0xa9,
-	0x1f, 0x56, 0x8f, 0xeb, 0xa7, 0xb7, 0x4e, 0x30, 0x2d, 0x4e, 0x5c, 0x3d, 0xf2, 0x4a, 0x0f, 0xb8,
-	0x20, 0x92, 0x6f, 0xfa, 0x10, 0x20, 0x1d, 0x99, 0xf0, 0x8b, 0x03, 0x4b, 0xac, 0xd7, 0x13, 0x5c,
-	0x4a, 0x67, 0x0e, 0x03, 0x65, 0x49, 0xfa, 0x8f, 0x0a, 0xac, 0x9f, 0x71, 0xf5, 0x92, 0x77, 0x2f,
-	0x74, 0x8e, 0x24, 0x9e, 0xcd, 0x7a, 0xb2, 0x92, 0xf7, 0x24, 0x81, 0x79, 0xc5, 0xfc, 0xc0, 0x46,
-	0x4c, 0x7f, 0x93, 0x26, 0x54, 0x03, 0xbf, 0x6b, 0x1c, 0xab, 0x3f, 0x75, 0x6a, 0x0c, 0xb9, 0x3f,
-	0x18, 0xc6, 0xfe, 0x9c, 0x77, 0x0d, 0x55, 0xea, 0x87, 0xc5, 0x72, 0x3f, 0x14, 0xfd, 0xbe, 0x54,
-	0xe2, 0x77, 0x07, 0x96, 0xac, 0x94, 0x65, 0x94, 0x62, 0x49, 0xfa, 0x19, 0x34, 0x1f, 0x7b, 0x18,
-	0x51, 0x99, 0xec, 0x6a, 0x0f, 0x6a, 0x66, 0xe3, 0xdc, 0xa6, 0x6c, 0xca, 0xa0, 0x3f, 0x85, 0xad,
-	0x33, 0xae, 0xcc, 0x22, 0xe3, 0x8e, 0x38, 0xcf, 0x33, 0xfe, 0x8b, 0x9d, 0x6a, 0xc9, 0xcc, 0x36,
-	0xe7, 0xb2, 0xdb, 0xa4, 0xaf, 0x61, 0x7b, 0x42, 0x96, 0x31, 0xc2, 0x81, 0xa5, 0x2e, 0x0b, 0x58,
-	0xe8, 0x71, 0x2b, 0xcc, 0x90, 0xba, 0x42, 0xc2, 0x48, 0xf3, 0x63, 0x59, 0x31, 0x81, 0xfe, 0xbe,
-	0x1e, 0xc5, 0x59, 0xbb, 0xe2, 0xe2, 0x37, 0xfd, 0x0d, 0x34, 0x9e, 0xb2, 0x20, 0x48, 0x64, 0x6e,
-	0xc1, 0xa2, 0xe0, 0x72, 0x1c, 0x28, 0x23, 0xd2, 0x50, 0x3a, 0x2d, 0xf9, 0x5b, 0xee, 0xe9, 0x64,
-	0xe2, 0x42, 0x98, 0x90, 0x81, 0x61, 0x3d, 0x13, 0x82, 0x1c, 0x41, 0x83, 0x4b, 0xe5, 0x5f, 0x32,
-	0xc5, 0x3b, 0x03, 0x26, 0x4d, 0x04, 0xeb, 0x96, 0x77, 0xc6, 0x24, 0x3d, 0x81, 0x8d, 0x27, 0xd7,
-	0x4f, 0x82, 0xc8, 0xfb, 0xee, 0x6b, 0xdc, 0x5b, 0xa6, 0xf8, 0xcd, 0xd6, 0x2b, 0xb9, 0xad, 0x7f,
-	0x02, 0xe4, 0x8c, 0xab, 0x9f, 0x5c, 0x87, 0x4c, 0xaa, 0xeb, 0xac, 0x85, 0x97, 0x7e, 0xc8, 0x45,
-	0x02, 0x15, 0x31, 0x45, 0xff, 0x53, 0x01, 0xf2, 0x4a, 0xb0, 0x50, 0x32, 0x4f, 0xe3, 0x9b, 0x15,
-	0x4e, 0x60, 0xbe, 0x2f, 0xa2, 0x4b, 0xb3, 0x1d, 0xfc, 0xd6, 0x59, 0xad, 0x22, 0xb3, 0x87, 0x39,
-	0x15, 0x69, 0x77, 0x5d, 0xb1, 0x60, 0x6c, 0xeb, 0x39, 0x26, 0x52, 0x27, 0xce, 0x67, 0x9d, 0xb8,
-	0x0b, 0xb5, 0x01, 0x93, 0x9d, 0x91, 0xf0, 0x3d, 0x8e, 0x05, 0x5c, 0x73, 0x97, 0x07, 0x4c, 0x9e,
-	0x6b, 0xda, 0x0e, 0x06, 0xfe, 0xa5, 0xaf, 0x4c, 0x32, 0xea, 0xc1, 0x17, 0x9a, 0x26, 0xa7, 0x1a,
-	0x38, 0x42, 0x25, 0x98, 0xa7, 0x30, 0x03, 0xeb, 0xa7, 0x5b, 0xa6, 0x14, 0x9f, 0x1a, 0xb6, 0xb1,
-	0xd9, 0x4d, 0xe6, 0xe9, 0xcd, 0x76, 0xfd, 0x90, 0x89, 0x6b, 0x2c, 0xf1, 0x86, 0x6b, 0xa8, 0x24,
-	0x94, 0x1b, 0xa6, 0x74, 0x74, 0x28, 0xdf, 0xc1, 0x5a, 0x41, 0x90, 0x5e, 0x2e, 0xa3, 0xb1, 0x48,
-	0x12, 0xc4, 0x50, 0x3a, 0x9a, 0xf1, 0x57, 0x07, 0xa5, 0x98, 0x68, 0xc6, 0xac, 0x57, 0xd7, 0x23,
-	0xae, 0x41, 0xae, 0x3f, 0x0e, 0xd1, 0x91, 0x16, 0xe4, 0x2c, 0xad, 0x75, 0x33, 0x31, 0x90, 0xe8,
-	0x96, 0x9a, 0x8b, 0xdf, 0xb4, 0x0d, 0x3b, 0x17, 0x3c, 0xec, 0xb9, 0xec, 0x4d, 0x79, 0x08, 0x10,
-	0x99, 0x2b, 0xb8, 0x85, 0x18, 0x99, 0x7f, 0x05, 0xdb, 0x7a, 0x41, 0x6e, 0x76, 0x1a, 0x60, 0xf5,
-	0x76, 0xc8, 0xe4, 0xd0, 0x1a, 0x1d, 0x53, 0xba, 0xe0, 0xad, 0x5f, 0x3a, 0x29, 0x08, 0x61, 0xc1,
-	0x5b, 0xfe, 0x63, 0x03, 0x46, 0x1d, 0xd8, 0x3c, 0xe3, 0x0a, 0x53, 0xed, 0xc9, 0xf5, 0xd7, 0x4c,
-	0x0e, 0x33, 0xa6, 0x64, 0x24, 0xe3, 0x37, 0x39, 0x85, 0xcd, 0xfe, 0x38, 0x08, 0x3a, 0x7d, 0x3f,
-	0x08, 0x3a, 0x2a, 0x35, 0x08, 0x85, 0x2f, 0xbb, 0xeb, 0x7a, 0xf0, 0x2b, 0x3f, 0x08, 0x32, 0xb6,
-	0x52, 0x8e, 0x55, 0x69, 0x15, 0xfc, 0x2f, 0xd9, 0xfc, 0x7f, 0xa9, 0x79, 0x00, 0xbb, 0x67, 0x5c,
-	0x65, 0x38, 0x33, 0x77, 0x43, 0xbf, 0x80, 0xdb, 0xc5, 0x25, 0xc5, 0xac, 0x98, 0x0a, 0x42, 0xf4,
-	0x9f, 0x55, 0x58, 0xc1, 0x4d, 0x25, 0xc1, 0x28, 0x73, 0xd8, 0x6d, 0xa8, 0x8f, 0x98, 0xe0, 0xa1,
-	0xea, 0xe0, 0x90, 0xc9, 0x9e, 0x98, 0xa5, 0xcd, 0xcb, 0xb8, 0xa0, 0x9a, 0x73, 0x41, 0x79, 0x45,
-	0x65, 0x0f, 0xd4, 0x85, 0xc2, 0x81, 0xba, 0x07, 0x35, 0xe5, 0x5f, 0x72, 0xa9, 0xd8, 0xe5, 0x08,
-	0x0b, 0xaa, 0xea, 0xa6, 0x8c, 0xdc, 0xd9, 0xb2, 0x94, 0x3f, 0x5b, 0xf6, 0x01, 0xb0, 0x57, 0xe9,
-	0x88, 0x28, 0x52, 0x06, 0xd1, 0x6b, 0xc8, 0x71, 0xa3, 0x48, 0xe9, 0x95, 0xea, 0xad, 0x8c, 0x07,
-	0x6b, 0xb1, 0x0f, 0xd4, 0x5b, 0x89, 0x43, 0x1a, 0xe9, 0xae, 0x78, 0xa8, 0xcc, 0x28, 0x18, 0xa4,
-	0x43, 0x16, 0x4e, 0x78, 0x0c, 0xab, 0x49, 0x4f, 0x14, 0xcf, 0xa9, 0x63, 0x35, 0xb7, 0x4e, 0x12,
-	0x76, 0x5c, 0xd3, 0xf1, 0xb7, 0x5e, 0xe3, 0xae, 0x78, 0x59, 0x52, 0x3b, 0x02, 0x51, 0xcb, 0x69,
-	0xc4, 0x80, 0x83, 0x84, 0xd6, 0xec, 0xcb, 0x4e, 0xdf, 0x0f, 0x59, 0xe0, 0xab, 0x6b, 0x67, 0x05,
-	0xf3, 0x02, 0x7c, 0xf9, 0x95, 0xe1, 0x90, 0x1f, 0x43, 0x23, 0x93, 0x38, 0xd2, 0xe9, 0xe1, 0x81,
-	0xde, 0x32, 0x28, 0x52, 0x52, 0x4b, 0x6e, 0x6e, 0x3e, 0xfd, 0x5b, 0x15, 0xd6, 0xcb, 0x2a, 0xae,
-	0x2c, 0xc8, 0x0e, 0x58, 0x5f, 0x16, 0x1b, 0x20, 0x8b, 0xa8, 0xd5, 0x09, 0x44, 0x9d, 0x9f, 0x44,
-	0xd4, 0x85, 0x52, 0x44, 0x5d, 0xcc, 0xc6, 0x3f, 0x17, 0xe3, 0xa5, 0x62, 0x8c, 0x2d, 0xd2, 0x2d,
-	0xa7, 0x48, 0x97, 0x00, 0x4a, 0x2d, 0x05, 0x94, 0x3c, 0x2e, 0xc3, 0x4d, 0xb8, 0x5c, 0x2f, 0xe0,
-	0x72, 0x19, 0xae, 0x34, 0x4a, 0x71, 0x05, 0xf1, 0x54, 0x31, 0x35, 0x96, 0x18, 0x9c, 0x05, 0xd7,
-	0x50, 0x3a, 0x9d, 0xb4, 0xfc, 0xb1, 0xe4, 0x3d, 0x67, 0x35, 0x4e, 0xa7, 0x01, 0x93, 0xdf, 0x48,
-	0xde, 0x23, 0x77, 0x60, 0x25, 0x73, 0x70, 0x46, 0xc2, 0x59, 0xc3, 0xf1, 0x46, 0x7a, 0x74, 0x46,
-	0x82, 0xfc, 0x00, 0x56, 0xed, 0x24, 0x73, 0xfa, 0x36, 0x71, 0x96, 0x5d, 0xea, 0x22, 0x93, 0x7e,
-	0x0e, 0xb7, 0x5e, 0xf2, 0x37, 0xa6, 0x17, 0xb0, 0xd5, 0x7c, 0x00, 0x30, 0x62, 0x52, 0x8e, 0x86,
-	0x42, 0x17, 0x50, 0xc5, 0x16, 0xa3, 0xe5, 0xd0, 0x13, 0x20, 0xd9, 0x45, 0x69, 0xef, 0x30, 0x05,
-	0x03, 0x02, 0xd8, 0xf8, 0x26, 0xd4, 0x18, 0x50, 0xd0, 0x33, 0xbd, 0x75, 0xc9, 0x5b, 0x30, 0x57,
-	0xb4, 0x40, 0x17, 0x78, 0x6f, 0x2c, 0x58, 0x72, 0x98, 0xcc, 0xbb, 0x09, 0x4d, 0xdb, 0xb0, 0x59,
-	0xd0, 0x56, 0xda, 0x88, 0x2c, 0xdb, 0x46, 0x44, 0x6f, 0xe7, 0xc5, 0x07, 0x18, 0x47, 0x3f, 0x85,
-	0xf5, 0x17, 0x1f, 0x20, 0xfe, 0x67, 0xb0, 0x76, 0xe1, 0x0f, 0xc2, 0x2c, 0xca, 0x4e, 0xdf, 0xb8,
-	0xad, 0x9b, 0xb9, 0x38, 0x0f, 0xb1, 0x6e, 0x9a, 0x50, 0x65, 0xc1, 0xc0, 0xf4, 0x58, 0xfa, 0x93,
-	0xde, 0x85, 0x66, 0x2a, 0x32, 0xad, 0xb8, 0x89, 0x23, 0xf1, 0x77, 0xb0, 0x73, 0xc6, 0x43, 0x2e,
-	0x34, 0x46, 0xb1, 0xb0, 0x17, 0x5d, 0x5e, 0x70, 0xde, 0x9b, 0x6d, 0x44, 0x8a, 0xc6, 0x92, 0xf3,
-	0x9e, 0xb1, 0xc5, 0xa0, 0xb1, 0x96, 0xa0, 0x33, 0x50, 0x37, 0x85, 0x52, 0x45, 0x22, 0x06, 0xec,
-	0x2a, 0x4e, 0x69, 0x58, 0xa6, 0x36, 0x8c, 0xbe, 0x82, 0x56, 0x99, 0xf2, 0xb4, 0x89, 0xbf, 0x12,
-	0xfd, 0x58, 0x41, 0x6c, 0xf2, 0xd2, 0x95, 0xe8, 0xa3, 0xf4, 0x5d, 0xa8, 0xe9, 0xa1, 0x91, 0x88,
-	0xa2, 0xbe, 0x51, 0xae, 0xe7, 0x9e, 0x6b, 0x9a, 0xfe, 0x1e, 0x0e, 0xf5, 0xd6, 0x33, 0x98, 0x73,
-	0x9e, 0xa4, 0x85, 0xdd, 0xd9, 0x17, 0x50, 0xcf, 0x9e, 0x86, 0x15, 0xc4, 0xd2, 0x9d, 0x32, 0x4c,
-	0x8b, 0x9b, 0xa3, 0xec, 0xec, 0x59, 0xa9, 0x47, 0x7f, 0x08, 0x47, 0x37, 0x18, 0x70, 0x43, 0x30,
-	0xb4, 0xe5, 0xf9, 0xfe, 0xe4, 0x7b, 0xb6, 0xbc, 0x0d, 0xcd, 0x33, 0x03, 0x5f, 0x89, 0xa1, 0x39,
-	0x8c, 0xab, 0xe4, 0x31, 0x8e, 0x1e, 0x41, 0x7d, 0x56, 0x6f, 0xf0, 0x00, 0xea, 0x67, 0x2c, 0xbd,
-	0xc4, 0x34, 0xa1, 0xaa, 0x3b, 0xf5, 0x78, 0x86, 0xfe, 0xd4, 0x9c, 0xb4, 0xbb, 0xd7, 0x9f, 0xf4,
-	0x21, 0xac, 0x3e, 0x8b, 0x8f, 0x3e, 0xbb, 0xea, 0x23, 0x58, 0x8c, 0x0f, 0x43, 0xec, 0xbf, 0xeb,
-	0xa7, 0x0d, 0xb3, 0x61, 0x9c, 0xe6, 0x9a, 0x31, 0xfa, 0x00, 0x16, 0x90, 0xf1, 0x01, 0x97, 0xf5,
-	0xbb, 0xd0, 0x38, 0x1f, 0x89, 0xa8, 0x9f, 0x69, 0xa4, 0x02, 0x5f, 0x2a, 0x1e, 0xda, 0x3e, 0x30,
-	0xa6, 0xe8, 0x3d, 0x58, 0x31, 0xf3, 0x66, 0xd4, 0xf2, 0x97, 0x70, 0xeb, 0x8c, 0xab, 0xa7, 0xf8,
-	0xf6, 0x90, 0x4c, 0x3e, 0x86, 0xc5, 0xf8, 0x35, 0xc2, 0xc4, 0xab, 0x79, 0x12, 0x3f, 0x53, 0xc4,
-	0x47, 0xb6, 0x9e, 0x69, 0xc6, 0x4f, 0xff, 0x55, 0x07, 0x78, 0x3c, 0xf2, 0x2f, 0xb8, 0xb8, 0xd2,
-	0x67, 0xc8, 0x6b, 0xa8, 0x67, 0xee, 0xb7, 0x64, 0xdb, 0x6c, 0xbb, 0xf8, 0xbe, 0xd0, 0xb2, 0xc7,
-	0x71, 0xc9, 0x65, 0x98, 0xee, 0xbc, 0xff, 0xfb, 0xbf, 0xff, 0x34, 0xb7, 0x4e, 0x6e, 0xb5, 0xaf,
-	0x1e, 0xb4, 0xc7, 0x92, 0x8b, 0x76, 0xc8, 0xbb, 0xd8, 0x95, 0x90, 0x5f, 0xc3, 0xf6, 0x0b, 0xa6,
-	0xb8, 0x54, 0xcf, 0x85, 0xe0, 0x78, 0xf5, 0xec, 0x06, 0x1c, 0x7b, 0xb1, 0xe9, 0xaa, 0x36, 0xcc,
-	0x40, 0xae, 0x65, 0xa3, 0x1b, 0xa8, 0x64, 0x95, 0x34, 0x12, 0x25, 0xfa, 0x1a, 0x2d, 0x60, 0xad,
-	0x70, 0x8f, 0x24, 0xfb, 0xa9, 0xa5, 0x25, 0x77, 0xd5, 0xd6, 0xc1, 0xb4, 0x61, 0xa3, 0xe7, 0x10,
-	0xf5, 0xb4, 0xe8, 0x66, 0xa2, 0x87, 0x99, 0x6b, 0xb2, 0x9e, 0xf6, 0xa8, 0x72, 0x9f, 0x9c, 0xc3,
-	0xbc, 0xbe, 0x5c, 0x92, 0xe9, 0x35, 0xd1, 0x5a, 0xb7, 0x57, 0xa0, 0xcc, 0x25, 0x94, 0x3a, 0x28,
-	0x99, 0xd0, 0x95, 0x44, 0xb2, 0xc7, 0x82, 0x40, 0x4b, 0x7c, 0x07, 0x64, 0xf2, 0x9e, 0x41, 0x0e,
-	0x8d, 0x90, 0xa9, 0x57, 0x90, 0x64, 0x2f, 0x53, 0xee, 0x1c, 0x94, 0xa2, 0xc6, 0x3d, 0xba, 0x9d,
-	0x68, 0x14, 0xec, 0x4d, 0xa6, 0x5c, 0xb5, 0xee, 0x21, 0xac, 0xe6, 0x2f, 0x15, 0x64, 0x2f, 0xf5,
-	0xd0, 0xe4, 0x5d, 0x63, 0x4a, 0x74, 0x26, 0x35, 0x0d, 0x72, 0xab, 0xb5, 0xa6, 0x10, 0x9a, 0xc5,
-	0xdb, 0x05, 0x39, 0x98, 0xd4, 0x95, 0xbd, 0x76, 0x4c, 0xd1, 0xf6, 0x11, 0x6a, 0x3b, 0xa0, 0x3b,
-	0x65, 0xda, 0x70, 0xbd, 0xd6, 0xf7, 0xbe, 0x82, 0xf7, 0xa5, 0x9c, 0x63, 0x3c, 0xee, 0x8f, 0x14,
-	0xa1, 0xa9, 0xd6, 0x69, 0xb7, 0x90, 0xd6, 0x0d, 0xfd, 0x27, 0xfd, 0x18, 0xf5, 0xdf, 0xa1, 0x07,
-	0x59, 0xfd, 0x93, 0x7a, 0xb4, 0x11, 0x7f, 0xa8, 0x80, 0x33, 0xed, 0xe6, 0x42, 0xee, 0x4e, 0xb1,
-	0xa3, 0x70, 0xb5, 0xb9, 0xd1, 0x96, 0x4f, 0xd0, 0x96, 0xbb, 0xf4, 0x68, 0x8a, 0x2d, 0xa9, 0x34,
-	0x6d, 0x4e, 0x07, 0x6a, 0xc9, 0xcb, 0x63, 0x52, 0x81, 0xc5, 0x77, 0xcb, 0x96, 0x33, 0x39, 0x60,
-	0xb4, 0xed, 0xa3, 0xb6, 0x6d, 0x4a, 0x12, 0x6d, 0xd2, 0xce, 0x79, 0x54, 0xb9, 0xff, 0x59, 0xc5,
-	0xe0, 0x89, 0xc5, 0xf8, 0xe9, 0x45, 0x6e, 0x07, 0x8a, 0xa7, 0x01, 0xdd, 0x43, 0x0d, 0x5b, 0x64,
-	0x23, 0xbb, 0x9f, 0x44, 0xde, 0x6b, 0xa8, 0x3f, 0x4b, 0xdf, 0x5e, 0x6e, 0x2a, 0x41, 0x92, 0x2a,
-	0x48, 0x64, 0xdf, 0x46, 0xd9, 0x3b, 0x34, 0x95, 0x9d, 0x79, 0xc8, 0xd1, 0xee, 0x61, 0x08, 0x27,
-	0xf1, 0xd1, 0x60, 0xaa, 0xc1, 0xca, 0xc9, 0xe6, 0xc6, 0x66, 0xf6, 0x70, 0x48, 0xc5, 0xdf, 0x41,
-	0xf1, 0xfb, 0xd4, 0xc9, 0x9a, 0x9e, 0x15, 0x16, 0xab, 0x80, 0xf4, 0xf9, 0x87, 0xec, 0xda, 0xfc,
-	0x2e, 0x79, 0x41, 0x6a, 0xed, 0xa4, 0xe9, 0x51, 0x78, 0x2e, 0xa2, 0xbb, 0xa8, 0x6a, 0x93, 0x36,
-	0x13, 0x55, 0xbd, 0x78, 0xc6, 0xa3, 0xca, 0xfd, 0xd3, 0xbf, 0x02, 0x34, 0x1e, 0xf7, 0x2e, 0xfd,
-	0xd0, 0x82, 0xfc, 0xb7, 0xb0, 0x6c, 0xdf, 0xfa, 0x66, 0x47, 0xa4, 0xf8, 0x2a, 0x48, 0x5b, 0xa8,
-	0x6b, 0x83, 0x60, 0xcc, 0x99, 0x96, 0x9b, 0x40, 0x22, 0xf1, 0x00, 0xd2, 0x36, 0x9c, 0xd8, 0xbc,
-	0x99, 0x68, 0xe7, 0x93, 0xad, 0x4c, 0xf6, 0xec, 0x79, 0xc0, 0xcd, 0x89, 0x6f, 0x87, 0xfc, 0x8d,
-	0x76, 0x59, 0x04, 0x2b, 0xb9, 0x6e, 0x3a, 0xf1, 0x5a, 0x59, 0x47, 0xdf, 0xda, 0x2b, 0x1f, 0x2c,
-	0x8b, 0x51, 0x5e, 0xdb, 0x18, 0x17, 0x68, 0x85, 0x03, 0xa8, 0x67, 0xba, 0xeb, 0x24, 0xcb, 0x26,
-	0x3b, 0xf4, 0xa4, 0x32, 0x4b, 0x9a, 0x71, 0x7a, 0x84, 0xaa, 0x76, 0xe9, 0xd6, 0xa4, 0x2a, 0xab,
-	0x28, 0x84, 0xb5, 0x02, 0x76, 0xdf, 0x94, 0xd2, 0xb3, 0xe0, 0xbe, 0xc4, 0x93, 0x05, 0xb0, 0xff,
-	0x25, 0x2c, 0xdb, 0xa6, 0x9d, 0xd8, 0x67, 0xba, 0xc2, 0xc5, 0x20, 0xc9, 0x83, 0x62, 0x77, 0x4f,
-	0x0f, 0x50, 0xbc, 0x43, 0xd7, 0x53, 0xf1, 0xd2, 0x1f, 0x84, 0xed, 0xa1, 0xc9, 0xec, 0xf7, 0x15,
-	0x20, 0x93, 0xdd, 0x76, 0x72, 0x8c, 0x4d, 0xbd, 0x05, 0xb4, 0x8e, 0x6e, 0x98, 0x61, 0x74, 0xdf,
-	0x43, 0xdd, 0x47, 0x74, 0x2f, 0xd5, 0x3d, 0x98, 0x98, 0xad, 0x8d, 0xf8, 0x63, 0x05, 0xf6, 0x0b,
-	0xbd, 0xf1, 0x2f, 0x7c, 0x35, 0x4c, 0xdb, 0x5c, 0x72, 0x2f, 0xb3, 0xbf, 0x9b, 0x1a, 0xe1, 0xd6,
-	0xf1, 0xec, 0x89, 0xf9, 0x06, 0x88, 0xae, 0xe6, 0x3d, 0xa3, 0xed, 0xf9, 0xb3, 0xb6, 0x27, 0x1f,
-	0xaf, 0x69, 0xf6, 0xcc, 0x68, 0xcc, 0x67, 0x86, 0xff, 0x04, 0xad, 0x38, 0xa6, 0x77, 0x4a, 0xc3,
-	0x9f, 0xd7, 0xaa, 0x4d, 0xbb, 0x00, 0xb8, 0x50, 0x4c, 0x28, 0x6c, 0x3b, 0x89, 0x6d, 0x59, 0xb2,
-	0xcd, 0x6a, 0x72, 0xfc, 0xe6, 0x3a, 0x53, 0x0b, 0x08, 0x74, 0x2d, 0x55, 0x34, 0xd2, 0x13, 0xe2,
-	0x0c, 0xab, 0x25, 0xdd, 0xe9, 0x74, 0xac, 0x71, 0x52, 0x64, 0xcb, 0x37, 0xb2, 0x16, 0xd8, 0xc8,
-	0x7a, 0x36, 0xd0, 0x56, 0xde, 0xb7, 0xb0, 0x6c, 0xff, 0x71, 0xcd, 0xc6, 0xb1, 0xe2, 0xdf, 0xb0,
-	0x32, 0x1c, 0x0b, 0xa3, 0x1e, 0xf7, 0xc3, 0x7e, 0xd4, 0x5d, 0xc4, 0x9f, 0x2b, 0x9f, 0xff, 0x37,
-	0x00, 0x00, 0xff, 0xff, 0x94, 0x56, 0xd2, 0x2d, 0x68, 0x1c, 0x00, 0x00,
+	// 3070 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x1a, 0x5d, 0x6f, 0x1b, 0xc7,
+	0x11, 0xa7, 0x6f, 0x0e, 0x29, 0x99, 0x5e, 0xc9, 0xd6, 0x89, 0xfa, 0xb0, 0xb4, 0x4e, 0x6d, 0x45,
+	0x48, 0xc4, 0x58, 0x01, 0xd2, 0xc2, 0x41, 0x5a, 0xd8, 0x6e, 0xa2, 0xb8, 0x70, 0x0c, 0xf5, 0xe4,
+	0xb8, 0x29, 0xda, 0x84, 0x38, 0xf2, 0x56, 0xd4, 0x25, 0xc7, 0x3b, 0xf6, 0x76, 0x29, 0x59, 0xee,
+	0x43, 0x81, 0x3c, 0x37, 0x40, 0x80, 0xbe, 0xf4, 0xa1, 0x7f, 0x25, 0xbf, 0xa0, 0x8f, 0x05, 0xda,
+	0x97, 0x3e, 0xf6, 0x77, 0x14, 0xc5, 0xce, 0xee, 0xde, 0xed, 0x1d, 0x8f, 0x62, 0x52, 0xa0, 0x7d,
+	0xbb, 0x99, 0x9d, 0x9d, 0x99, 0x9d, 0x9d, 0x99, 0x9d, 0x19, 0x12, 0x6a, 0xe9, 0xb0, 0x77, 0x38,
+	0x4c, 0x13, 0x91, 0x90, 0xf9, 0x74, 0xd8, 0x1b, 0x76, 0x5b, 0x5b, 0xfd, 0x24, 0xe9, 0x47, 0xac,
+	0xed, 0x0f, 0xc3, 0xb6, 0x1f, 0xc7, 0x89, 0xf0, 0x45, 0x98, 0xc4, 0x5c, 0x11, 0xb5, 0x7e, 0xd2,
+	0x0f, 0xc5, 0xf9, 0xa8, 0x7b, 0xd8, 0x4b, 0x06, 0xed, 0x98, 0x75, 0x47, 0x91, 0xcf, 0xc3, 0xa4,
+	0xdd, 0x4f, 0xde, 0xd6, 0x40, 0xbb, 0x97, 0xc4, 0x9c, 0xc5, 0x7c, 0xc4, 0xdb, 0xc3, 0x6e, 0x9b,
+	0x0b, 0x5f, 0x30, 0xbd, 0xf3, 0xbd, 0x69, 0x3b, 0x63, 0xd6, 0x8d, 0x98, 0x90, 0xdb, 0x7a, 0x49,
+	0x7c, 0x16, 0xf6, 0xd5, 0x3e, 0x7a, 0x00, 0xcd, 0xd3, 0x51, 0x97, 0xf7, 0xd2, 0xb0, 0xcb, 0x3c,
+	0xf6, 0xbb, 0x11, 0xe3, 0x82, 0xdc, 0x86, 0x05, 0x91, 0x0c, 0xc3, 0x1e, 0x77, 0x9d, 0xdd, 0xd9,
+	0xfd, 0x9a, 0xa7, 0x21, 0xfa, 0x01, 0xdc, 0xb4, 0x68, 0xf9, 0x50, 0xea, 0x42, 0xd6, 0x60, 0x1e,
+	0x97, 0x5d, 0x67, 0xd7, 0xd9, 0xaf, 0x79, 0x0a, 0x20, 0x04, 0xe6, 0x02, 0x5f, 0xf8, 0xee, 0x0c,
+	0x22, 0xf1, 0x9b, 0x12, 0x68, 0x3e, 0x4f, 0xe2, 0x13, 0x3f, 0xf5, 0x07, 0x5c, 0x8b, 0xa2, 0x7f,
+	0x99, 0x91, 0xc8, 0x80, 0x3d, 0x8d, 0xcf, 0x92, 0x8c, 0xe5, 0x0a, 0xcc, 0x84, 0x81, 0xe6, 0x37,
+	0x13, 0x06, 0x64, 0x03, 0x96, 0x7a, 0xe7, 0x7e, 0x18, 0x77, 0xc2, 0x00, 0x19, 0x2e, 0x7b, 0x8b,
+	0x08, 0x3f, 0x0d, 0x48, 0x0b, 0x96, 0x7a, 0x49, 0x18, 0x77, 0x7d, 0xce, 0xdc, 0x59, 0xdc, 0x90,
+	0xc1, 0x64, 0x1b, 0x60, 0xc8, 0x58, 0xda, 0xe9, 0x25, 0xa3, 0x58, 0xb8, 0x73, 0xb8, 0xb1, 0x26,
+	0x31, 0x4f, 0x24, 0x82, 0x50, 0x68, 0xf0, 0xab, 0xb8, 0x77, 0x9e, 0x26, 0x71, 0xf8, 0x9a, 0x05,
+	0xee, 0xfc, 0xae, 0xb3, 0xbf, 0xe4, 0x15, 0x70, 0xe4, 0x0e, 0xd4, 0xbb, 0xa3, 0xde, 0x57, 0x4c,
+	0x74, 0x78, 0xf8, 0x9a, 0xb9, 0x0b, 0xbb, 0xce, 0xfe, 0xbc, 0x07, 0x0a, 0x75, 0x1a, 0xbe, 0x66,
+	0xe4, 0x4d, 0x68, 0xa2, 0x1d, 0x7b, 0x49, 0xd4, 0xb9, 0x60, 0x29, 0x0f, 0x93, 0xd8, 0x05, 0xd4,
+	0xe3, 0x86, 0xc1, 0xbf, 0x54, 0x68, 0x72, 0x04, 0xf5, 0x34, 0x19, 0x09, 0xd6, 0x11, 0x7e, 0x37,
+	0x62, 0x6e, 0x7d, 0x77, 0x76, 0xbf, 0x7e, 0x74, 0xf3, 0x10, 0xdd, 0xe2, 0xd0, 0x93, 0x2b, 0x2f,
+	0xe4, 0x82, 0x07, 0x69, 0xf6, 0x4d, 0xdf, 0x03, 0xc8, 0x57, 0xc6, 0xec, 0xe2, 0xc2, 0xa2, 0x1f,
+	0x04, 0x29, 0xe3, 0xdc, 0x9d, 0xc1, 0x8b, 0x32, 0x20, 0xfd, 0x87, 0x03, 0xab, 0xc7, 0x4c, 0x3c,
+	0x67, 0xdd, 0x53, 0xe9, 0x23, 0x99, 0x65, 0x6d, 0x4b, 0x3a, 0x45, 0x4b, 0x12, 0x98, 0x13, 0x7e,
+	0x18, 0x99, 0x1b, 0x93, 0xdf, 0xa4, 0x09, 0xb3, 0x51, 0xd8, 0xd5, 0x86, 0x95, 0x9f, 0xd2, 0x35,
+	0xce, 0x59, 0xd8, 0x3f, 0x57, 0xf6, 0x9c, 0xf3, 0x34, 0x54, 0x69, 0x87, 0x85, 0x6a, 0x3b, 0x94,
+	0xed, 0xbe, 0x58, 0x61, 0x77, 0x17, 0x16, 0x0d, 0x97, 0x25, 0xe4, 0x62, 0x40, 0xfa, 0x0e, 0x34,
+	0x1f, 0xf5, 0xf0, 0x46, 0x79, 0x76, 0xaa, 0x2d, 0xa8, 0xe9, 0x83, 0x33, 0xe3, 0xb2, 0x39, 0x82,
+	0xfe, 0x02, 0x6e, 0x1f, 0x33, 0xa1, 0x37, 0x69, 0x73, 0x28, 0x3f, 0xb7, 0xec, 0xa7, 0x8c, 0x6a,
+	0x40, 0xeb, 0x98, 0x33, 0xf6, 0x31, 0xe9, 0xe7, 0xb0, 0x3e, 0xc6, 0x4b, 0x2b, 0xe1, 0xc2, 0x62,
+	0xd7, 0x8f, 0xfc, 0xb8, 0xc7, 0x0c, 0x33, 0x0d, 0xca, 0x08, 0x89, 0x13, 0x89, 0x57, 0xbc, 0x14,
+	0x80, 0xf6, 0xbe, 0x1a, 0x2a, 0xaf, 0x5d, 0xf6, 0xf0, 0x9b, 0x7e, 0x09, 0x8d, 0x27, 0x7e, 0x14,
+	0x65, 0x3c, 0x6f, 0xc3, 0x42, 0xca, 0xf8, 0x28, 0x12, 0x9a, 0xa5, 0x86, 0xa4, 0x5b, 0xb2, 0x57,
+	0xac, 0x27, 0x9d, 0x89, 0xa5, 0xa9, 0xbe, 0x32, 0xd0, 0xa8, 0x0f, 0xd3, 0x94, 0xec, 0x41, 0x83,
+	0x71, 0x11, 0x0e, 0x7c, 0xc1, 0x3a, 0x7d, 0x9f, 0xeb, 0x1b, 0xac, 0x1b, 0xdc, 0xb1, 0xcf, 0xe9,
+	0x21, 0xac, 0x3d, 0xbe, 0x7a, 0x1c, 0x25, 0xbd, 0xaf, 0x3e, 0xc6, 0xb3, 0x59, 0xc1, 0xaf, 0x8f,
+	0xee, 0x14, 0x8e, 0xfe, 0x16, 0x90, 0x63, 0x26, 0x7e, 0x7e, 0x15, 0xfb, 0x5c, 0x5c, 0xd9, 0x1a,
+	0x0e, 0xc2, 0x98, 0xa5, 0x59, 0xaa, 0x50, 0x10, 0xfd, 0xb7, 0x03, 0xe4, 0x45, 0xea, 0xc7, 0xdc,
+	0xef, 0xc9, 0xfc, 0x66, 0x98, 0x13, 0x98, 0x3b, 0x4b, 0x93, 0x81, 0x3e, 0x0e, 0x7e, 0x4b, 0xaf,
+	0x16, 0x89, 0x3e, 0xc3, 0x8c, 0x48, 0xa4, 0xb9, 0x2e, 0xfc, 0x68, 0x64, 0xe2, 0x59, 0x01, 0xb9,
+	0x11, 0xe7, 0x6c, 0x23, 0x6e, 0x42, 0xad, 0xef, 0xf3, 0xce, 0x30, 0x0d, 0x7b, 0x0c, 0x03, 0xb8,
+	0xe6, 0x2d, 0xf5, 0x7d, 0x7e, 0x92, 0x86, 0xf9, 0x62, 0x14, 0x0e, 0x42, 0xe1, 0x2e, 0x64, 0x8b,
+	0xcf, 0x24, 0x4c, 0x8e, 0x64, 0xe2, 0x88, 0x45, 0xea, 0xf7, 0x04, 0x7a, 0x60, 0xfd, 0xe8, 0xb6,
+	0x0e, 0xc5, 0x27, 0x1a, 0xad, 0x75, 0xf6, 0x32, 0x3a, 0x79, 0xd8, 0x6e, 0x18, 0xfb, 0xe9, 0x15,
+	0x86, 0x78, 0xc3, 0xd3, 0x50, 0x76, 0x95, 0x6b, 0x3a, 0x74, 0xe4, 0x55, 0xbe, 0x86, 0x1b, 0x25,
+	0x46, 0x72, 0x3b, 0x4f, 0x46, 0x69, 0xe6, 0x20, 0x1a, 0x92, 0xb7, 0xa9, 0xbe, 0x3a, 0xc8, 0x45,
+	0xdf, 0xa6, 0x42, 0xbd, 0xb8, 0x1a, 0x32, 0x99, 0xe4, 0xce, 0x46, 0x31, 0x1a, 0xd2, 0x24, 0x39,
+	0x03, 0x4b, 0xd9, 0x7e, 0xda, 0xe7, 0x68, 0x96, 0x9a, 0x87, 0xdf, 0xb4, 0x0d, 0x1b, 0xa7, 0x2c,
+	0x0e, 0x3c, 0xff, 0xb2, 0xfa, 0x0a, 0x30, 0x33, 0x3b, 0x78, 0x04, 0xfc, 0xa6, 0xbf, 0x85, 0x75,
+	0xb9, 0xa1, 0x40, 0x9d, 0x5f, 0xb0, 0x78, 0x75, 0xee, 0xf3, 0x73, 0xa3, 0xb4, 0x82, 0x64, 0xc0,
+	0x1b, 0xbb, 0x74, 0xf2, 0x24, 0x84, 0x01, 0x6f, 0xf0, 0x8f, 0x14, 0x9a, 0x76, 0xe0, 0xd6, 0x31,
+	0x13, 0xe8, 0x6a, 0x8f, 0xaf, 0x3e, 0xf6, 0xf9, 0xb9, 0xa5, 0x8a, 0xc5, 0x19, 0xbf, 0xc9, 0x11,
+	0xdc, 0x3a, 0x1b, 0x45, 0x51, 0xe7, 0x2c, 0x8c, 0xa2, 0x8e, 0xc8, 0x15, 0x42, 0xe6, 0x4b, 0xde,
+	0xaa, 0x5c, 0xfc, 0x28, 0x8c, 0x22, 0x4b, 0x57, 0xca, 0x60, 0xdd, 0x12, 0xf0, 0x7d, 0xbc, 0xf9,
+	0xbf, 0x12, 0xf3, 0x00, 0x36, 0x8f, 0x99, 0xb0, 0x30, 0x53, 0x4f, 0x43, 0xdf, 0x87, 0x3b, 0xe5,
+	0x2d, 0x65, 0xaf, 0x98, 0x98, 0x84, 0xe8, 0x3f, 0x67, 0x61, 0x19, 0x0f, 0x95, 0x5d, 0x46, 0x95,
+	0xc1, 0xee, 0x40, 0x7d, 0xe8, 0xa7, 0x2c, 0x16, 0x1d, 0x5c, 0xd2, 0xde, 0xa3, 0x50, 0x52, 0x3d,
+	0xcb, 0x04, 0xb3, 0x05, 0x13, 0x54, 0x47, 0x94, 0xfd, 0xa0, 0xce, 0x97, 0x1e, 0xd4, 0x2d, 0xa8,
+	0x89, 0x70, 0xc0, 0xb8, 0xf0, 0x07, 0x43, 0x0c, 0xa8, 0x59, 0x2f, 0x47, 0x14, 0xde, 0x96, 0xc5,
+	0xe2, 0xdb, 0xb2, 0x0d, 0x80, 0xb5, 0x4a, 0x27, 0x4d, 0x12, 0xa1, 0x33, 0x7a, 0x0d, 0x31, 0x5e,
+	0x92, 0x08, 0xb9, 0x53, 0xbc, 0xe2, 0x6a, 0xb1, 0xa6, 0x6c, 0x20, 0x5e, 0x71, 0x5c, 0x92, 0x99,
+	0xee, 0x82, 0xc5, 0x42, 0xaf, 0x82, 0xce, 0x74, 0x88, 0x42, 0x82, 0x47, 0xb0, 0x92, 0xd5, 0x44,
+	0x8a, 0xa6, 0x8e, 0xd1, 0xdc, 0x3a, 0xcc, 0xd0, 0x2a, 0xa6, 0xd5, 0xb7, 0xdc, 0xe3, 0x2d, 0xf7,
+	0x6c, 0x50, 0x1a, 0x02, 0xb3, 0x96, 0xdb, 0x50, 0x09, 0x07, 0x01, 0x29, 0x39, 0xe4, 0x9d, 0xb3,
+	0x30, 0xf6, 0xa3, 0x50, 0x5c, 0xb9, 0xcb, 0xe8, 0x17, 0x10, 0xf2, 0x8f, 0x34, 0x86, 0xfc, 0x14,
+	0x1a, 0x96, 0xe3, 0x70, 0x37, 0xc0, 0x07, 0xbd, 0xa5, 0xb3, 0x48, 0x45, 0x2c, 0x79, 0x05, 0x7a,
+	0xfa, 0xdd, 0x2c, 0xac, 0x56, 0x45, 0x5c, 0xd5, 0x25, 0xbb, 0x60, 0x6c, 0x59, 0x2e, 0x80, 0x4c,
+	0x46, 0x9d, 0x1d, 0xcb, 0xa8, 0x73, 0xe3, 0x19, 0x75, 0xbe, 0x32, 0xa3, 0x2e, 0xd8, 0xf7, 0x5f,
+	0xb8, 0xe3, 0xc5, 0xf2, 0x1d, 0x9b, 0x4c, 0xb7, 0x94, 0x67, 0xba, 0x2c, 0xa1, 0xd4, 0xf2, 0x84,
+	0x52, 0xcc, 0xcb, 0x70, 0x5d, 0x5e, 0xae, 0x97, 0xf2, 0x72, 0x55, 0x5e, 0x69, 0x54, 0xe6, 0x15,
+	0xcc, 0xa7, 0xc2, 0x17, 0x23, 0x8e, 0x97, 0x33, 0xef, 0x69, 0x48, 0xba, 0x93, 0xe4, 0x3f, 0xe2,
+	0x2c, 0x70, 0x57, 0x94, 0x3b, 0xf5, 0x7d, 0xfe, 0x29, 0x67, 0x01, 0xb9, 0x0b, 0xcb, 0xd6, 0xc3,
+	0x99, 0xa4, 0xee, 0x0d, 0x5c, 0x6f, 0xe4, 0x4f, 0x67, 0x92, 0x92, 0x1f, 0xc1, 0x8a, 0x21, 0xd2,
+	0xaf, 0x6f, 0x13, 0xa9, 0xcc, 0x56, 0x0f, 0x91, 0xf4, 0x5d, 0xb8, 0xf9, 0x9c, 0x5d, 0xea, 0x5a,
+	0xc0, 0x44, 0xf3, 0x0e, 0xc0, 0xd0, 0xe7, 0x7c, 0x78, 0x9e, 0xca, 0x00, 0x72, 0x4c, 0x30, 0x1a,
+	0x0c, 0x3d, 0x04, 0x62, 0x6f, 0xca, 0x6b, 0x87, 0x09, 0x39, 0x20, 0x82, 0xb5, 0x4f, 0x63, 0x99,
+	0x03, 0x4a, 0x72, 0x26, 0xee, 0x28, 0x69, 0x30, 0x53, 0xd6, 0x40, 0x06, 0x78, 0x30, 0x4a, 0xfd,
+	0xec, 0x31, 0x99, 0xf3, 0x32, 0x98, 0xb6, 0xe1, 0x56, 0x49, 0x5a, 0x65, 0x21, 0xb2, 0x64, 0x0a,
+	0x11, 0x79, 0x9c, 0x67, 0x3f, 0x40, 0x39, 0xfa, 0x36, 0xac, 0x3e, 0xfb, 0x01, 0xec, 0x7f, 0x09,
+	0x37, 0x4e, 0xc3, 0x7e, 0x6c, 0x67, 0xd9, 0xc9, 0x07, 0x37, 0x71, 0x33, 0xa3, 0xfc, 0x50, 0x7e,
+	0xcb, 0x02, 0xd6, 0x8f, 0xfa, 0xba, 0xc6, 0x92, 0x9f, 0xf4, 0x1e, 0x34, 0x73, 0x96, 0x79, 0xc4,
+	0x8d, 0x3d, 0x89, 0xbf, 0x87, 0x8d, 0x63, 0x16, 0xb3, 0x54, 0xe6, 0x28, 0x3f, 0x0e, 0x92, 0xc1,
+	0x29, 0x63, 0xc1, 0x74, 0x25, 0xf2, 0x6c, 0xcc, 0x19, 0x0b, 0xb4, 0x2e, 0x3a, 0x1b, 0x9f, 0x32,
+	0xe5, 0x81, 0x7e, 0xdc, 0x63, 0x5c, 0x24, 0xa9, 0x4a, 0xd8, 0xb3, 0x48, 0xd2, 0x30, 0x48, 0xa9,
+	0x18, 0x7d, 0x01, 0xad, 0x2a, 0xe1, 0x79, 0x11, 0x7f, 0x91, 0x9e, 0x29, 0x01, 0x4a, 0xe5, 0xc5,
+	0x8b, 0xf4, 0x0c, 0xb9, 0x6f, 0x42, 0x4d, 0x2e, 0x0d, 0xd3, 0x24, 0x39, 0xd3, 0xc2, 0x25, 0xed,
+	0x89, 0x84, 0xe9, 0x1f, 0x60, 0x57, 0x1e, 0xdd, 0xca, 0x39, 0x27, 0x99, 0x5b, 0x98, 0x93, 0xbd,
+	0x0f, 0x75, 0xfb, 0x35, 0x74, 0x30, 0x97, 0x6e, 0x54, 0xe5, 0x34, 0xa4, 0xf7, 0x6c, 0xea, 0x69,
+	0xae, 0x47, 0x7f, 0x0c, 0x7b, 0xd7, 0x28, 0x70, 0xcd, 0x65, 0x48, 0xcd, 0x8b, 0xf5, 0xc9, 0xff,
+	0x59, 0xf3, 0x36, 0x34, 0x8f, 0x75, 0xfa, 0xca, 0x14, 0x2d, 0xe4, 0x38, 0xa7, 0x98, 0xe3, 0xe8,
+	0x1e, 0xd4, 0xa7, 0xd5, 0x06, 0x0f, 0xa0, 0x7e, 0xec, 0xe7, 0x4d, 0x4c, 0x13, 0x66, 0x65, 0xa5,
+	0xae, 0x28, 0xe4, 0xa7, 0xc4, 0xe4, 0xd5, 0xbd, 0xfc, 0xa4, 0xef, 0xc1, 0xca, 0x87, 0xea, 0xe9,
+	0x33, 0xbb, 0xde, 0x80, 0x05, 0xf5, 0x18, 0x62, 0xfd, 0x5d, 0x3f, 0x6a, 0xe8, 0x03, 0x23, 0x99,
+	0xa7, 0xd7, 0xe8, 0x03, 0x98, 0x47, 0xc4, 0x0f, 0x68, 0xd6, 0xef, 0x41, 0xe3, 0x64, 0x98, 0x26,
+	0x67, 0x56, 0x21, 0x15, 0x85, 0x5c, 0xb0, 0xd8, 0xd4, 0x81, 0x0a, 0xa2, 0xf7, 0x61, 0x59, 0xd3,
+	0x4d, 0x89, 0xe5, 0x0f, 0xe0, 0xe6, 0x31, 0x13, 0x4f, 0x70, 0xf6, 0x90, 0x11, 0xef, 0xc3, 0x82,
+	0x9a, 0x46, 0xe8, 0xfb, 0x6a, 0x1e, 0xaa, 0x31, 0x85, 0x7a, 0xb2, 0x25, 0xa5, 0x5e, 0xa7, 0x3f,
+	0x83, 0x9b, 0x9f, 0x84, 0xb1, 0x98, 0x5e, 0x0f, 0x4d, 0x6a, 0xdd, 0x9e, 0x62, 0x15, 0xfa, 0xdc,
+	0x7b, 0x7c, 0xa5, 0xdf, 0x8f, 0xef, 0xd5, 0x05, 0x0e, 0x59, 0x1a, 0x26, 0x81, 0x61, 0xa5, 0x20,
+	0xfa, 0xed, 0x0c, 0xc0, 0x73, 0xcf, 0x3e, 0xb1, 0x26, 0x73, 0x6c, 0x32, 0xd9, 0x84, 0x71, 0xe1,
+	0xa7, 0xa2, 0x53, 0xd0, 0xa7, 0x8e, 0x38, 0x55, 0xa5, 0xca, 0xc2, 0x88, 0xc5, 0x41, 0xa7, 0x50,
+	0x9f, 0xd5, 0x58, 0x1c, 0xe8, 0x65, 0x4b, 0xb5, 0xb9, 0xa2, 0x6a, 0xeb, 0xb0, 0x18, 0xc6, 0x9d,
+	0xb3, 0x28, 0xb9, 0xd4, 0x8f, 0xfa, 0x42, 0x18, 0x7f, 0x14, 0x25, 0x97, 0x32, 0x39, 0x24, 0x23,
+	0xa1, 0x56, 0x54, 0xcf, 0xb3, 0x98, 0x8c, 0x04, 0x2e, 0xad, 0xc1, 0x3c, 0x17, 0xfe, 0x57, 0x0c,
+	0x9f, 0xf5, 0x9a, 0xa7, 0x00, 0xec, 0xe0, 0x58, 0x10, 0xfa, 0xa6, 0xd3, 0xd6, 0x90, 0xc4, 0x5f,
+	0x2a, 0xb5, 0xe4, 0xc3, 0xee, 0x78, 0x1a, 0x42, 0x2e, 0xbd, 0x24, 0x55, 0xcf, 0xba, 0xe3, 0x29,
+	0x40, 0x76, 0x93, 0xb2, 0x3b, 0x0c, 0x87, 0x1e, 0xbb, 0xf4, 0xd3, 0x80, 0x5b, 0x6e, 0x53, 0x65,
+	0x1b, 0xfa, 0x9d, 0x03, 0xc4, 0xa6, 0xfe, 0x9f, 0x9b, 0x72, 0x0f, 0x1a, 0x29, 0x0a, 0xeb, 0xa8,
+	0x52, 0x48, 0xd9, 0xb3, 0xae, 0x70, 0x2f, 0x25, 0x8a, 0x1c, 0xc0, 0xa2, 0x02, 0xb9, 0x3b, 0x8f,
+	0xc1, 0xd4, 0xd4, 0xc1, 0x94, 0x29, 0xea, 0x19, 0x02, 0xfa, 0x8d, 0x03, 0xb5, 0x0c, 0xad, 0x8a,
+	0x66, 0xdd, 0x4c, 0x3a, 0xa6, 0x68, 0x56, 0xb0, 0x5c, 0x0b, 0xd8, 0x30, 0x4a, 0xae, 0x98, 0x09,
+	0xe5, 0x0c, 0xc6, 0xb2, 0xce, 0x8f, 0x22, 0xd9, 0x3e, 0x2b, 0x85, 0x0d, 0x68, 0x59, 0x7f, 0xae,
+	0x60, 0x7d, 0x8c, 0x2e, 0x29, 0xd3, 0x5c, 0xbb, 0x82, 0xb4, 0xfd, 0x3f, 0x91, 0x95, 0xab, 0x1c,
+	0x4b, 0xf0, 0x69, 0xdd, 0x7c, 0x02, 0xc4, 0x26, 0xce, 0xeb, 0x90, 0x40, 0x35, 0xf8, 0x48, 0x3e,
+	0xeb, 0x19, 0x70, 0x52, 0x54, 0x91, 0xfb, 0xe8, 0x53, 0x42, 0xea, 0x6f, 0x8f, 0xb3, 0x2c, 0xde,
+	0x6a, 0x9d, 0xbe, 0x04, 0xc8, 0x91, 0x79, 0xc9, 0xed, 0xd8, 0x25, 0x77, 0x0b, 0x96, 0x86, 0x69,
+	0x12, 0x8c, 0x7a, 0xcc, 0x44, 0x5c, 0x06, 0xab, 0x41, 0x03, 0x97, 0x25, 0x9d, 0xee, 0x62, 0x14,
+	0x24, 0x2b, 0x10, 0x95, 0x56, 0xbe, 0x67, 0x53, 0xf5, 0x77, 0x07, 0x9a, 0x39, 0xf5, 0xb4, 0xfa,
+	0xcb, 0xea, 0xd9, 0x67, 0xae, 0xeb, 0xd9, 0x67, 0xc7, 0x7a, 0xf6, 0x4d, 0xa8, 0xa9, 0x6b, 0xee,
+	0x88, 0x57, 0xee, 0x9c, 0x7d, 0xef, 0x2f, 0x5e, 0x15, 0x7c, 0x62, 0xbe, 0xe4, 0x13, 0x5b, 0x50,
+	0x33, 0xcd, 0x3d, 0x77, 0x17, 0xd4, 0x30, 0x2b, 0x43, 0xd8, 0x83, 0xb1, 0x45, 0xe5, 0x31, 0x1a,
+	0xa4, 0x9f, 0xe2, 0x73, 0x72, 0xda, 0x3b, 0x67, 0xc1, 0x28, 0x62, 0x36, 0xa1, 0x53, 0x20, 0x9c,
+	0x78, 0x95, 0xf2, 0x6d, 0xc0, 0xc9, 0xa4, 0x9e, 0xbb, 0x20, 0x40, 0x13, 0x58, 0xb3, 0xd8, 0xe6,
+	0xae, 0xf2, 0x0e, 0xd4, 0xb8, 0x41, 0xea, 0xb7, 0x87, 0xe8, 0xcb, 0xb7, 0xe8, 0xbd, 0x9c, 0x48,
+	0x96, 0xd5, 0xf2, 0xb5, 0xbd, 0x60, 0xd9, 0x80, 0x50, 0xc9, 0x5f, 0x56, 0x58, 0x3d, 0x1e, 0xa4,
+	0x9f, 0xc0, 0xf2, 0x49, 0xca, 0x9e, 0x24, 0x83, 0x41, 0x28, 0x5e, 0x26, 0x02, 0x73, 0x02, 0x0f,
+	0xfb, 0xb9, 0xb3, 0x68, 0xc8, 0xd4, 0x76, 0x33, 0x59, 0x6d, 0x27, 0x9f, 0x03, 0xb9, 0x66, 0x7a,
+	0x21, 0xf9, 0x4d, 0xbf, 0x75, 0xe0, 0x96, 0x69, 0xd9, 0xb0, 0x0c, 0xca, 0x4e, 0xb0, 0x0d, 0xd0,
+	0x95, 0xaf, 0x49, 0xc7, 0x7a, 0x42, 0x6a, 0x88, 0xf9, 0xf8, 0x9a, 0x77, 0x84, 0x1c, 0xc0, 0xfc,
+	0x45, 0x22, 0x98, 0xf1, 0xf8, 0x35, 0x7d, 0xe8, 0x82, 0xce, 0x9e, 0x22, 0x91, 0x26, 0x55, 0xa5,
+	0xd8, 0x1c, 0x16, 0x33, 0x0a, 0x38, 0xfa, 0x6b, 0x13, 0xe0, 0xd1, 0x30, 0x3c, 0x65, 0xe9, 0x85,
+	0x6c, 0x87, 0x3e, 0x87, 0xba, 0x35, 0xaa, 0x25, 0xeb, 0x9a, 0x61, 0x79, 0x54, 0xde, 0x32, 0x9d,
+	0x65, 0xc5, 0x5c, 0x97, 0x6e, 0x7c, 0xfd, 0xb7, 0x7f, 0xfd, 0x69, 0x66, 0x95, 0xdc, 0x6c, 0x5f,
+	0x3c, 0x68, 0x8f, 0x38, 0x4b, 0xe5, 0xb8, 0x1f, 0x1b, 0x6c, 0xf2, 0x05, 0xac, 0x3f, 0xf3, 0x05,
+	0xe3, 0xe2, 0x69, 0x9a, 0x32, 0xb4, 0x7c, 0x37, 0x62, 0xf8, 0x8c, 0x4e, 0x16, 0x65, 0x0e, 0x55,
+	0x78, 0x6d, 0xe9, 0x1a, 0x0a, 0x59, 0x21, 0x8d, 0x4c, 0x88, 0x9c, 0x08, 0xa7, 0x70, 0xa3, 0x34,
+	0x12, 0x25, 0xdb, 0xb9, 0xa6, 0x15, 0x63, 0xd7, 0xd6, 0xce, 0xa4, 0x65, 0x2d, 0x67, 0x17, 0xe5,
+	0xb4, 0xe8, 0xad, 0x4c, 0x8e, 0xaf, 0xc8, 0xf0, 0x40, 0x0f, 0x9d, 0x03, 0x72, 0x02, 0x73, 0x72,
+	0x4e, 0x4a, 0x26, 0x97, 0x77, 0xad, 0x55, 0x33, 0xcd, 0xb3, 0xe6, 0xa9, 0xd4, 0x45, 0xce, 0x84,
+	0x2e, 0x67, 0x9c, 0x65, 0xbe, 0x95, 0x1c, 0x5f, 0x03, 0x19, 0x1f, 0x99, 0x91, 0x5d, 0xcd, 0x64,
+	0xe2, 0x34, 0xad, 0xb5, 0x63, 0x51, 0x54, 0x34, 0xf3, 0x94, 0xa2, 0xc4, 0x2d, 0xba, 0x9e, 0x49,
+	0x4c, 0xfd, 0x4b, 0xab, 0xf2, 0x94, 0xb2, 0xcf, 0x61, 0xa5, 0x38, 0x1f, 0x23, 0x5b, 0xb9, 0x85,
+	0xc6, 0xc7, 0x66, 0x13, 0x6e, 0x67, 0x5c, 0x52, 0xbf, 0xb0, 0x5b, 0x4a, 0x8a, 0xa1, 0x59, 0x1e,
+	0x94, 0x91, 0x9d, 0x71, 0x59, 0xf6, 0x04, 0x6d, 0x82, 0xb4, 0x37, 0x50, 0xda, 0x0e, 0xdd, 0xa8,
+	0x92, 0x86, 0xfb, 0xa5, 0xbc, 0xaf, 0x1d, 0x2c, 0xba, 0x0a, 0x86, 0xe9, 0xb1, 0x70, 0x28, 0x08,
+	0xcd, 0xa5, 0x4e, 0x1a, 0xa8, 0xb5, 0xae, 0x19, 0xa5, 0xd0, 0x37, 0x51, 0xfe, 0x5d, 0xba, 0x63,
+	0xcb, 0x1f, 0x97, 0x23, 0x95, 0xf8, 0xa3, 0x03, 0xee, 0xa4, 0x21, 0x1c, 0xb9, 0x37, 0x41, 0x8f,
+	0xd2, 0x83, 0x72, 0xad, 0x2e, 0x6f, 0xa1, 0x2e, 0xf7, 0xe8, 0xde, 0x04, 0x5d, 0x72, 0x6e, 0x52,
+	0x9d, 0x0e, 0xd4, 0xb2, 0x1f, 0xd1, 0xb2, 0x08, 0x2c, 0xff, 0x04, 0xd7, 0x72, 0xc7, 0x17, 0xb4,
+	0xb4, 0x6d, 0x94, 0xb6, 0x4e, 0x49, 0x26, 0x8d, 0x1b, 0x9a, 0x87, 0xce, 0xc1, 0x3b, 0x8e, 0xce,
+	0x27, 0xa6, 0x5d, 0x99, 0x1c, 0xe4, 0xeb, 0x79, 0xba, 0x2e, 0x34, 0x36, 0x74, 0x0b, 0x25, 0xdc,
+	0x26, 0x6b, 0xf6, 0x79, 0x32, 0x7e, 0x9f, 0x43, 0xfd, 0xc3, 0xfc, 0x67, 0x84, 0xeb, 0x42, 0xd0,
+	0x7a, 0x0f, 0x32, 0xde, 0x77, 0x90, 0xf7, 0x06, 0xcd, 0x79, 0x5b, 0xbf, 0x49, 0x48, 0xf3, 0xf8,
+	0x98, 0x4e, 0x54, 0x97, 0xa3, 0xa3, 0xc1, 0xf0, 0xb1, 0x7d, 0xe3, 0x96, 0xdd, 0xe7, 0xe4, 0xec,
+	0xef, 0x22, 0xfb, 0x6d, 0xea, 0xda, 0xaa, 0xdb, 0xcc, 0x94, 0x08, 0xc8, 0x7f, 0xc9, 0x20, 0x9b,
+	0xc6, 0xbf, 0x2b, 0x7e, 0x0c, 0x69, 0x6d, 0xe4, 0xee, 0x51, 0xfa, 0xe5, 0x83, 0x6e, 0xa2, 0xa8,
+	0x5b, 0xb4, 0x99, 0x89, 0xd2, 0xb5, 0x92, 0x14, 0xf1, 0x6b, 0x58, 0x29, 0x36, 0x1b, 0x76, 0x48,
+	0x8f, 0xf7, 0x20, 0x2d, 0x53, 0x37, 0xe5, 0x5d, 0x05, 0xbd, 0x8d, 0xfc, 0x9b, 0xb4, 0x9e, 0xf1,
+	0x8f, 0x53, 0xc9, 0xba, 0x0f, 0xcb, 0x85, 0x4a, 0x3b, 0x3b, 0x40, 0x55, 0xfd, 0x9d, 0x1d, 0x60,
+	0xbc, 0xd6, 0xa6, 0x3b, 0x28, 0xc0, 0xa5, 0xab, 0xf9, 0x01, 0x32, 0xa2, 0x5c, 0x90, 0x55, 0xb4,
+	0x59, 0x82, 0xc6, 0x0a, 0xcd, 0x4c, 0xd0, 0x78, 0x55, 0x59, 0x21, 0x68, 0x90, 0x11, 0x49, 0x41,
+	0x5d, 0x74, 0xd8, 0x2c, 0x24, 0x2d, 0x9b, 0x97, 0xa3, 0x70, 0x7d, 0xec, 0x27, 0x9a, 0x89, 0x6e,
+	0xd5, 0xcf, 0x77, 0x4b, 0x19, 0x11, 0xba, 0x95, 0x5d, 0xc9, 0x4c, 0x0e, 0x8c, 0xcd, 0xf1, 0x3a,
+	0x66, 0x8a, 0x87, 0xd9, 0x94, 0x52, 0xda, 0x97, 0x98, 0x67, 0x0b, 0x65, 0x47, 0xa5, 0x17, 0x1b,
+	0xa7, 0xa8, 0x2c, 0x50, 0xaa, 0x73, 0x6c, 0x81, 0xf4, 0xa1, 0x73, 0x70, 0xf4, 0x6d, 0x1d, 0x1a,
+	0x8f, 0x82, 0x41, 0x18, 0x9b, 0x7a, 0xe2, 0x33, 0x58, 0x32, 0xbf, 0x90, 0x4e, 0x0f, 0xfe, 0xf2,
+	0x6f, 0xa9, 0xb4, 0x85, 0x42, 0xd7, 0x08, 0xa6, 0x17, 0x5f, 0xf2, 0xcd, 0x5e, 0x5f, 0xd2, 0x03,
+	0xc8, 0x87, 0x97, 0xc4, 0xa4, 0xa8, 0xb1, 0x21, 0x68, 0x6b, 0xa3, 0x62, 0xa5, 0xea, 0x6d, 0x2f,
+	0xb0, 0x6f, 0xc7, 0xec, 0x52, 0xda, 0x2e, 0x81, 0xe5, 0xc2, 0x0c, 0x32, 0x73, 0xbb, 0xaa, 0x39,
+	0x68, 0x6b, 0xab, 0x7a, 0xb1, 0xea, 0xb2, 0x8a, 0xd2, 0x46, 0xb8, 0x41, 0xf9, 0x79, 0xdd, 0x9a,
+	0x49, 0x66, 0xee, 0x37, 0x3e, 0xd7, 0x6c, 0xb5, 0xaa, 0x96, 0xb4, 0xa8, 0x3d, 0x14, 0xb5, 0x49,
+	0x6f, 0x8f, 0x8b, 0x32, 0x82, 0x62, 0xb8, 0x51, 0x2a, 0x13, 0xae, 0xcb, 0x9e, 0xd3, 0x2a, 0x8b,
+	0x0a, 0x4b, 0x96, 0xea, 0x8a, 0xdf, 0xc0, 0x92, 0x19, 0x75, 0x12, 0xf3, 0xe3, 0x66, 0x69, 0x9c,
+	0xda, 0x5a, 0x1f, 0xc3, 0x57, 0x05, 0xad, 0x62, 0x2f, 0xeb, 0xe9, 0xf6, 0xb9, 0x4e, 0xa2, 0x5f,
+	0x3b, 0xb2, 0xf1, 0x2a, 0xcf, 0x28, 0xb3, 0x8a, 0x69, 0xe2, 0xec, 0xb4, 0xb5, 0x77, 0x0d, 0x85,
+	0x96, 0x7d, 0x1f, 0x65, 0xef, 0xd1, 0xad, 0x5c, 0x76, 0x7f, 0x8c, 0x5a, 0x2a, 0xf1, 0x8d, 0x03,
+	0xdb, 0xa5, 0x89, 0xe2, 0xaf, 0x42, 0x71, 0x9e, 0x0f, 0x07, 0xc9, 0x7d, 0xeb, 0x7c, 0xd7, 0x8d,
+	0x0f, 0x5b, 0xfb, 0xd3, 0x09, 0x8b, 0xb5, 0x36, 0x5d, 0x29, 0x5a, 0x46, 0xea, 0xf3, 0x67, 0xa9,
+	0x4f, 0xf1, 0xbe, 0x26, 0xe9, 0x33, 0x65, 0x9c, 0x39, 0xf5, 0xfa, 0x0f, 0x51, 0x8b, 0x7d, 0x7a,
+	0xb7, 0xf2, 0xfa, 0x8b, 0x52, 0xa5, 0x6a, 0xa7, 0x00, 0xa7, 0xc2, 0x4f, 0x05, 0x0e, 0xeb, 0x88,
+	0xa9, 0x8e, 0xed, 0x11, 0x5f, 0x6b, 0xad, 0x88, 0x2c, 0x26, 0x04, 0x7a, 0x23, 0x17, 0x34, 0x94,
+	0x04, 0xca, 0xc3, 0x6a, 0xd9, 0x4c, 0x6f, 0x72, 0xae, 0x71, 0x0b, 0x09, 0xdd, 0x1a, 0xff, 0x99,
+	0x37, 0x94, 0xac, 0xda, 0x17, 0x6d, 0xf8, 0x7d, 0x06, 0x4b, 0xe6, 0x9f, 0x41, 0xd3, 0xf3, 0x58,
+	0xf9, 0x3f, 0x44, 0x55, 0x79, 0x2c, 0x4e, 0x02, 0x16, 0x4a, 0x6e, 0x5f, 0x40, 0x2d, 0x9b, 0x25,
+	0x4e, 0x57, 0x7b, 0x6c, 0xec, 0x58, 0x15, 0x1b, 0x03, 0x43, 0xf4, 0xd0, 0x39, 0xe8, 0x2e, 0xe0,
+	0x5f, 0x5e, 0xde, 0xfd, 0xcf, 0x00, 0xfa, 0x9a, 0xdd, 0x27, 0xfe, 0x25, 0x00, 0x00,
 }
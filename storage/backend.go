@@ -0,0 +1,111 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+import (
+	"errors"
+	"sort"
+	"sync"
+)
+
+// Storage backends, RocksBackend needs cgo and is excluded by build tag norocksdb.
+const (
+	RocksBackend  = "rocksdb"
+	LevelBackend  = "leveldb"
+	BoltBackend   = "boltdb"
+	MemoryBackend = "memory"
+)
+
+// DefaultBackend is used if no backend is configured, it is rocksdb if compiled in, otherwise leveldb.
+var DefaultBackend = LevelBackend
+
+// Errors
+var (
+	ErrUnknownBackend    = errors.New("unknown storage backend")
+	ErrDuplicatedBackend = errors.New("storage backend is already registered")
+)
+
+// ClosableStorage a Storage backed by files that must be closed.
+type ClosableStorage interface {
+	Storage
+
+	// Close close the storage.
+	Close() error
+}
+
+// Opener open the storage of a backend at path.
+type Opener func(path string) (ClosableStorage, error)
+
+var (
+	backendsLock sync.RWMutex
+	backends     = make(map[string]Opener)
+)
+
+func init() {
+	Register(LevelBackend, func(path string) (ClosableStorage, error) {
+		return NewDiskStorage(path)
+	})
+	Register(BoltBackend, func(path string) (ClosableStorage, error) {
+		return NewBoltStorage(path)
+	})
+	Register(MemoryBackend, func(path string) (ClosableStorage, error) {
+		return NewMemoryStorage()
+	})
+}
+
+// Register register the opener of a storage backend.
+func Register(name string, opener Opener) error {
+	backendsLock.Lock()
+	defer backendsLock.Unlock()
+
+	if _, ok := backends[name]; ok {
+		return ErrDuplicatedBackend
+	}
+	backends[name] = opener
+	return nil
+}
+
+// Backends return the names of registered backends.
+func Backends() []string {
+	backendsLock.RLock()
+	defer backendsLock.RUnlock()
+
+	names := make([]string, 0, len(backends))
+	for name := range backends {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NewStorage open the storage of the backend at path, the default backend is used if name is empty.
+func NewStorage(name string, path string) (ClosableStorage, error) {
+	if len(name) == 0 {
+		name = DefaultBackend
+	}
+
+	backendsLock.RLock()
+	opener, ok := backends[name]
+	backendsLock.RUnlock()
+
+	if !ok {
+		return nil, ErrUnknownBackend
+	}
+	return opener(path)
+}
@@ -0,0 +1,51 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewStorage(t *testing.T) {
+	for _, backend := range []string{LevelBackend, BoltBackend, MemoryBackend} {
+		file := backend + ".db"
+		os.RemoveAll(file)
+
+		stor, err := NewStorage(backend, file)
+		assert.Nil(t, err, backend)
+		assert.Nil(t, stor.Put([]byte("key"), []byte("value")), backend)
+		value, err := stor.Get([]byte("key"))
+		assert.Nil(t, err, backend)
+		assert.Equal(t, []byte("value"), value, backend)
+		_, ok := stor.(KeyspaceStorage)
+		assert.True(t, ok, backend)
+		assert.Nil(t, stor.Close(), backend)
+
+		os.RemoveAll(file)
+	}
+
+	_, err := NewStorage("unknown", "unknown.db")
+	assert.Equal(t, ErrUnknownBackend, err)
+	assert.Equal(t, ErrDuplicatedBackend, Register(MemoryBackend, nil))
+	assert.Contains(t, Backends(), BoltBackend)
+	assert.Contains(t, Backends(), DefaultBackend)
+}
@@ -0,0 +1,497 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+const (
+	// boltFile the database file in the data dir.
+	boltFile = "chain.bolt"
+
+	// boltIteratorPage count of entries an iterator reads in one transaction,
+	// iterators don't hold a transaction between moves so writes are never blocked.
+	boltIteratorPage = 256
+)
+
+// BoltStorage a pure Go storage on boltDB, keyspaces are buckets.
+type BoltStorage struct {
+	db          *bolt.DB
+	enableBatch bool
+	mutex       sync.Mutex
+	batchOpts   map[string]*boltBatchOpt
+}
+
+type boltBatchOpt struct {
+	bucket  string
+	key     []byte
+	value   []byte
+	deleted bool
+}
+
+// NewBoltStorage init a storage
+func NewBoltStorage(path string) (*BoltStorage, error) {
+	if err := os.MkdirAll(path, 0700); err != nil {
+		return nil, err
+	}
+	db, err := bolt.Open(filepath.Join(path, boltFile), 0600, &bolt.Options{
+		Timeout: time.Second,
+		// a snapshot holds the mmap, writers growing the file beyond it wait
+		// until the snapshot is released.
+		InitialMmapSize: 1 << 30,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists([]byte(DefaultKeyspace))
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &BoltStorage{
+		db:          db,
+		enableBatch: false,
+		batchOpts:   make(map[string]*boltBatchOpt),
+	}, nil
+}
+
+// Get return value to the key in Storage
+func (storage *BoltStorage) Get(key []byte) ([]byte, error) {
+	return storage.get(DefaultKeyspace, key)
+}
+
+// Put put the key-value entry to Storage
+func (storage *BoltStorage) Put(key []byte, value []byte) error {
+	return storage.put(DefaultKeyspace, key, value)
+}
+
+// Del delete the key in Storage.
+func (storage *BoltStorage) Del(key []byte) error {
+	return storage.del(DefaultKeyspace, key)
+}
+
+func (storage *BoltStorage) get(name string, key []byte) ([]byte, error) {
+	var value []byte
+	err := storage.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(name))
+		if bucket == nil {
+			return ErrKeyNotFound
+		}
+		// the value is only valid in the transaction.
+		value = copyBytes(bucket.Get(key))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, ErrKeyNotFound
+	}
+	return value, nil
+}
+
+func (storage *BoltStorage) put(name string, key []byte, value []byte) error {
+	if storage.enableBatch {
+		storage.mutex.Lock()
+		defer storage.mutex.Unlock()
+
+		storage.batchOpts[name+"/"+byteutils.Hex(key)] = &boltBatchOpt{
+			bucket:  name,
+			key:     key,
+			value:   value,
+			deleted: false,
+		}
+
+		return nil
+	}
+
+	return storage.db.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists([]byte(name))
+		if err != nil {
+			return err
+		}
+		return bucket.Put(key, value)
+	})
+}
+
+func (storage *BoltStorage) del(name string, key []byte) error {
+	if storage.enableBatch {
+		storage.mutex.Lock()
+		defer storage.mutex.Unlock()
+
+		storage.batchOpts[name+"/"+byteutils.Hex(key)] = &boltBatchOpt{
+			bucket:  name,
+			key:     key,
+			deleted: true,
+		}
+
+		return nil
+	}
+
+	return storage.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(name))
+		if bucket == nil {
+			return nil
+		}
+		return bucket.Delete(key)
+	})
+}
+
+// NewIterator return an iterator over the entries whose key has the prefix.
+func (storage *BoltStorage) NewIterator(prefix []byte) (Iterator, error) {
+	start, limit := PrefixRange(prefix)
+	return storage.NewRangeIterator(start, limit)
+}
+
+// NewRangeIterator return an iterator over the entries in [start, limit).
+func (storage *BoltStorage) NewRangeIterator(start []byte, limit []byte) (Iterator, error) {
+	return storage.newRangeIterator(DefaultKeyspace, start, limit)
+}
+
+// NewSnapshot return a point-in-time read-only view of Storage.
+func (storage *BoltStorage) NewSnapshot() (Snapshot, error) {
+	return storage.newSnapshot(DefaultKeyspace)
+}
+
+func (storage *BoltStorage) newRangeIterator(name string, start []byte, limit []byte) (Iterator, error) {
+	return &boltIterator{
+		db:     storage.db,
+		bucket: []byte(name),
+		start:  start,
+		limit:  limit,
+	}, nil
+}
+
+func (storage *BoltStorage) newSnapshot(name string) (Snapshot, error) {
+	tx, err := storage.db.Begin(false)
+	if err != nil {
+		return nil, err
+	}
+	return &BoltSnapshot{
+		tx:     tx,
+		bucket: []byte(name),
+	}, nil
+}
+
+// Keyspace return the Storage of the named keyspace, the bucket is created if missing.
+func (storage *BoltStorage) Keyspace(name string) (Storage, error) {
+	if name == DefaultKeyspace {
+		return storage, nil
+	}
+	if len(name) == 0 {
+		return nil, ErrInvalidKeyspace
+	}
+	err := storage.db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists([]byte(name))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &BoltKeyspace{storage: storage, name: name}, nil
+}
+
+// DropKeyspace delete the bucket of the keyspace and recreate it empty.
+func (storage *BoltStorage) DropKeyspace(name string) error {
+	if len(name) == 0 || name == DefaultKeyspace {
+		return ErrInvalidKeyspace
+	}
+	return storage.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.DeleteBucket([]byte(name)); err != nil && err != bolt.ErrBucketNotFound {
+			return err
+		}
+		_, err := tx.CreateBucket([]byte(name))
+		return err
+	})
+}
+
+// Close boltDB
+func (storage *BoltStorage) Close() error {
+	return storage.db.Close()
+}
+
+// EnableBatch enable batch write.
+func (storage *BoltStorage) EnableBatch() {
+	storage.enableBatch = true
+}
+
+// Flush write and flush pending batch write in one transaction.
+func (storage *BoltStorage) Flush() error {
+	storage.mutex.Lock()
+	defer storage.mutex.Unlock()
+
+	if !storage.enableBatch {
+		return nil
+	}
+
+	opts := storage.batchOpts
+	storage.batchOpts = make(map[string]*boltBatchOpt)
+
+	return storage.db.Update(func(tx *bolt.Tx) error {
+		for _, opt := range opts {
+			bucket, err := tx.CreateBucketIfNotExists([]byte(opt.bucket))
+			if err != nil {
+				return err
+			}
+			if opt.deleted {
+				err = bucket.Delete(opt.key)
+			} else {
+				err = bucket.Put(opt.key, opt.value)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// DisableBatch disable batch write.
+func (storage *BoltStorage) DisableBatch() {
+	storage.mutex.Lock()
+	defer storage.mutex.Unlock()
+	storage.batchOpts = make(map[string]*boltBatchOpt)
+
+	storage.enableBatch = false
+}
+
+// BoltKeyspace a bucket of BoltStorage, it shares the batch write with BoltStorage.
+type BoltKeyspace struct {
+	storage *BoltStorage
+	name    string
+}
+
+// Get return value to the key in keyspace
+func (ks *BoltKeyspace) Get(key []byte) ([]byte, error) {
+	return ks.storage.get(ks.name, key)
+}
+
+// Put put the key-value entry to keyspace
+func (ks *BoltKeyspace) Put(key []byte, value []byte) error {
+	return ks.storage.put(ks.name, key, value)
+}
+
+// Del delete the key in keyspace.
+func (ks *BoltKeyspace) Del(key []byte) error {
+	return ks.storage.del(ks.name, key)
+}
+
+// NewIterator return an iterator over the entries whose key has the prefix.
+func (ks *BoltKeyspace) NewIterator(prefix []byte) (Iterator, error) {
+	start, limit := PrefixRange(prefix)
+	return ks.NewRangeIterator(start, limit)
+}
+
+// NewRangeIterator return an iterator over the entries in [start, limit).
+func (ks *BoltKeyspace) NewRangeIterator(start []byte, limit []byte) (Iterator, error) {
+	return ks.storage.newRangeIterator(ks.name, start, limit)
+}
+
+// NewSnapshot return a point-in-time read-only view of keyspace.
+func (ks *BoltKeyspace) NewSnapshot() (Snapshot, error) {
+	return ks.storage.newSnapshot(ks.name)
+}
+
+// EnableBatch enable batch write.
+func (ks *BoltKeyspace) EnableBatch() {
+	ks.storage.EnableBatch()
+}
+
+// DisableBatch disable batch write.
+func (ks *BoltKeyspace) DisableBatch() {
+	ks.storage.DisableBatch()
+}
+
+// Flush write and flush pending batch write.
+func (ks *BoltKeyspace) Flush() error {
+	return ks.storage.Flush()
+}
+
+// BoltSnapshot a point-in-time view of BoltStorage, it's a read-only transaction.
+type BoltSnapshot struct {
+	mutex    sync.Mutex
+	tx       *bolt.Tx
+	bucket   []byte
+	released bool
+}
+
+// Get return value to the key in Snapshot
+func (snapshot *BoltSnapshot) Get(key []byte) ([]byte, error) {
+	snapshot.mutex.Lock()
+	defer snapshot.mutex.Unlock()
+
+	if snapshot.released {
+		return nil, ErrSnapshotReleased
+	}
+
+	bucket := snapshot.tx.Bucket(snapshot.bucket)
+	if bucket == nil {
+		return nil, ErrKeyNotFound
+	}
+	value := bucket.Get(key)
+	if value == nil {
+		return nil, ErrKeyNotFound
+	}
+	return copyBytes(value), nil
+}
+
+// NewIterator return an iterator over the entries whose key has the prefix.
+func (snapshot *BoltSnapshot) NewIterator(prefix []byte) (Iterator, error) {
+	start, limit := PrefixRange(prefix)
+	return snapshot.NewRangeIterator(start, limit)
+}
+
+// NewRangeIterator return an iterator over the entries in [start, limit).
+func (snapshot *BoltSnapshot) NewRangeIterator(start []byte, limit []byte) (Iterator, error) {
+	snapshot.mutex.Lock()
+	defer snapshot.mutex.Unlock()
+
+	if snapshot.released {
+		return nil, ErrSnapshotReleased
+	}
+	return &boltIterator{
+		snapshot: snapshot,
+		bucket:   snapshot.bucket,
+		start:    start,
+		limit:    limit,
+	}, nil
+}
+
+// Release release the snapshot.
+func (snapshot *BoltSnapshot) Release() {
+	snapshot.mutex.Lock()
+	defer snapshot.mutex.Unlock()
+
+	if snapshot.released {
+		return
+	}
+	snapshot.released = true
+	snapshot.tx.Rollback()
+}
+
+// boltIterator iterates the entries in [start, limit) page by page,
+// the pages are read from the snapshot if it's not nil.
+type boltIterator struct {
+	db       *bolt.DB
+	snapshot *BoltSnapshot
+	bucket   []byte
+	start    []byte
+	limit    []byte
+	entries  []*kv
+	pos      int
+	done     bool
+}
+
+// Next move to the next entry.
+func (it *boltIterator) Next() (bool, error) {
+	if it.pos < len(it.entries) {
+		it.pos++
+	}
+	if it.pos < len(it.entries) {
+		return true, nil
+	}
+	if it.done {
+		return false, nil
+	}
+
+	if err := it.load(); err != nil {
+		return false, err
+	}
+	it.pos = 0
+	return len(it.entries) > 0, nil
+}
+
+func (it *boltIterator) load() error {
+	if it.snapshot == nil {
+		return it.db.View(it.read)
+	}
+
+	it.snapshot.mutex.Lock()
+	defer it.snapshot.mutex.Unlock()
+
+	if it.snapshot.released {
+		return ErrSnapshotReleased
+	}
+	return it.read(it.snapshot.tx)
+}
+
+func (it *boltIterator) read(tx *bolt.Tx) error {
+	it.entries = nil
+	bucket := tx.Bucket(it.bucket)
+	if bucket == nil {
+		it.done = true
+		return nil
+	}
+
+	c := bucket.Cursor()
+	var k, v []byte
+	if it.start == nil {
+		k, v = c.First()
+	} else {
+		k, v = c.Seek(it.start)
+	}
+	for ; k != nil; k, v = c.Next() {
+		if it.limit != nil && bytes.Compare(k, it.limit) >= 0 {
+			break
+		}
+		if len(it.entries) == boltIteratorPage {
+			// the next page starts from the first entry not read.
+			it.start = copyBytes(k)
+			return nil
+		}
+		it.entries = append(it.entries, &kv{k: copyBytes(k), v: copyBytes(v)})
+	}
+	it.done = true
+	return nil
+}
+
+// Key return the key of current entry.
+func (it *boltIterator) Key() []byte {
+	if it.pos < 0 || it.pos >= len(it.entries) {
+		return nil
+	}
+	return it.entries[it.pos].k
+}
+
+// Value return the value of current entry.
+func (it *boltIterator) Value() []byte {
+	if it.pos < 0 || it.pos >= len(it.entries) {
+		return nil
+	}
+	return it.entries[it.pos].v
+}
+
+// Release release the iterator.
+func (it *boltIterator) Release() {
+	it.entries = nil
+	it.done = true
+}
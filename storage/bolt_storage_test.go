@@ -0,0 +1,103 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewBoltStorage(t *testing.T) {
+	file := "bolt.db"
+	os.RemoveAll(file)
+	defer os.RemoveAll(file)
+
+	stor, err := NewBoltStorage(file)
+	assert.Nil(t, err)
+
+	key := []byte("key")
+	assert.Nil(t, stor.Put(key, []byte("value")))
+	value, err := stor.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), value)
+
+	// batch write is invisible until flush.
+	stor.EnableBatch()
+	assert.Nil(t, stor.Put([]byte("key1"), []byte("value1")))
+	assert.Nil(t, stor.Del(key))
+	_, err = stor.Get([]byte("key1"))
+	assert.Equal(t, ErrKeyNotFound, err)
+	assert.Nil(t, stor.Flush())
+	stor.DisableBatch()
+	value, err = stor.Get([]byte("key1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value1"), value)
+	_, err = stor.Get(key)
+	assert.Equal(t, ErrKeyNotFound, err)
+
+	// entries are persisted.
+	assert.Nil(t, stor.Close())
+	stor, err = NewBoltStorage(file)
+	assert.Nil(t, err)
+	defer stor.Close()
+	value, err = stor.Get([]byte("key1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value1"), value)
+}
+
+func TestBoltStorage_Iterator(t *testing.T) {
+	file := "bolt_iterator.db"
+	os.RemoveAll(file)
+	defer os.RemoveAll(file)
+
+	stor, err := NewBoltStorage(file)
+	assert.Nil(t, err)
+	defer stor.Close()
+
+	testStorageIterator(t, stor)
+
+	// iterators read across pages.
+	stor.EnableBatch()
+	for i := 0; i < boltIteratorPage*2+1; i++ {
+		assert.Nil(t, stor.Put([]byte(fmt.Sprintf("page%04d", i)), []byte("value")))
+	}
+	assert.Nil(t, stor.Flush())
+	stor.DisableBatch()
+	iter, err := stor.NewIterator([]byte("page"))
+	assert.Nil(t, err)
+	keys := collectKeys(t, iter)
+	assert.Equal(t, boltIteratorPage*2+1, len(keys))
+	assert.Equal(t, "page0000", keys[0])
+	assert.Equal(t, fmt.Sprintf("page%04d", boltIteratorPage*2), keys[len(keys)-1])
+}
+
+func TestBoltStorage_Keyspace(t *testing.T) {
+	file := "bolt_keyspace.db"
+	os.RemoveAll(file)
+	defer os.RemoveAll(file)
+
+	stor, err := NewBoltStorage(file)
+	assert.Nil(t, err)
+	defer stor.Close()
+
+	testKeyspaceStorage(t, stor)
+}
@@ -25,7 +25,9 @@ import (
 
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/filter"
+	"github.com/syndtr/goleveldb/leveldb/iterator"
 	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
 )
 
 // DiskStorage the nodes in trie.
@@ -120,6 +122,58 @@ func (storage *DiskStorage) Del(key []byte) error {
 	return storage.db.Delete(key, nil)
 }
 
+// NewIterator return an iterator over the entries whose key has the prefix.
+func (storage *DiskStorage) NewIterator(prefix []byte) (Iterator, error) {
+	return &defaultIterator{&levelIterator{iter: storage.db.NewIterator(util.BytesPrefix(prefix), nil)}}, nil
+}
+
+// NewRangeIterator return an iterator over the entries in [start, limit).
+func (storage *DiskStorage) NewRangeIterator(start []byte, limit []byte) (Iterator, error) {
+	iter, err := storage.rawRangeIterator(start, limit)
+	if err != nil {
+		return nil, err
+	}
+	return &defaultIterator{iter}, nil
+}
+
+// NewSnapshot return a point-in-time read-only view of Storage.
+func (storage *DiskStorage) NewSnapshot() (Snapshot, error) {
+	snap, err := storage.rawSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	return &defaultSnapshot{snap}, nil
+}
+
+// Keyspace return the Storage of the named keyspace.
+func (storage *DiskStorage) Keyspace(name string) (Storage, error) {
+	if name == DefaultKeyspace {
+		return storage, nil
+	}
+	return newPrefixKeyspace(storage, name)
+}
+
+// DropKeyspace delete all entries in the named keyspace.
+func (storage *DiskStorage) DropKeyspace(name string) error {
+	ks, err := newPrefixKeyspace(storage, name)
+	if err != nil {
+		return err
+	}
+	return ks.drop()
+}
+
+func (storage *DiskStorage) rawRangeIterator(start []byte, limit []byte) (Iterator, error) {
+	return &levelIterator{iter: storage.db.NewIterator(&util.Range{Start: start, Limit: limit}, nil)}, nil
+}
+
+func (storage *DiskStorage) rawSnapshot() (Snapshot, error) {
+	snap, err := storage.db.GetSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	return &DiskSnapshot{snap: snap}, nil
+}
+
 // Close levelDB
 func (storage *DiskStorage) Close() error {
 	return storage.db.Close()
@@ -160,3 +214,68 @@ func (storage *DiskStorage) DisableBatch() {
 	storage.batchOpts = make(map[string]*batchOpt)
 	storage.enableBatch = false
 }
+
+// DiskSnapshot a point-in-time view of DiskStorage.
+type DiskSnapshot struct {
+	snap *leveldb.Snapshot
+}
+
+// Get return value to the key in Snapshot
+func (snapshot *DiskSnapshot) Get(key []byte) ([]byte, error) {
+	value, err := snapshot.snap.Get(key, nil)
+	if err == leveldb.ErrNotFound {
+		return nil, ErrKeyNotFound
+	}
+	if err == leveldb.ErrSnapshotReleased {
+		return nil, ErrSnapshotReleased
+	}
+	return value, err
+}
+
+// NewIterator return an iterator over the entries whose key has the prefix.
+func (snapshot *DiskSnapshot) NewIterator(prefix []byte) (Iterator, error) {
+	return &levelIterator{iter: snapshot.snap.NewIterator(util.BytesPrefix(prefix), nil)}, nil
+}
+
+// NewRangeIterator return an iterator over the entries in [start, limit).
+func (snapshot *DiskSnapshot) NewRangeIterator(start []byte, limit []byte) (Iterator, error) {
+	return &levelIterator{iter: snapshot.snap.NewIterator(&util.Range{Start: start, Limit: limit}, nil)}, nil
+}
+
+// Release release the snapshot.
+func (snapshot *DiskSnapshot) Release() {
+	snapshot.snap.Release()
+}
+
+// levelIterator wraps the levelDB iterator, the returned key and value are copies
+// because levelDB reuses its buffers between moves.
+type levelIterator struct {
+	iter iterator.Iterator
+}
+
+// Next move to the next entry.
+func (it *levelIterator) Next() (bool, error) {
+	if it.iter.Next() {
+		return true, nil
+	}
+	err := it.iter.Error()
+	if err == leveldb.ErrSnapshotReleased {
+		err = ErrSnapshotReleased
+	}
+	return false, err
+}
+
+// Key return the key of current entry.
+func (it *levelIterator) Key() []byte {
+	return copyBytes(it.iter.Key())
+}
+
+// Value return the value of current entry.
+func (it *levelIterator) Value() []byte {
+	return copyBytes(it.iter.Value())
+}
+
+// Release release the iterator.
+func (it *levelIterator) Release() {
+	it.iter.Release()
+}
@@ -48,6 +48,18 @@ func TestNewDiskStorage(t *testing.T) {
 	assert.NotNil(t, err2)
 }
 
+func TestDiskStorage_Iterator(t *testing.T) {
+	file := "iterator.db"
+	os.RemoveAll(file)
+	defer os.RemoveAll(file)
+
+	stor, err := NewDiskStorage(file)
+	assert.Nil(t, err)
+	defer stor.Close()
+
+	testStorageIterator(t, stor)
+}
+
 const letterBytes = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
 
 func randBytes(n int) []byte {
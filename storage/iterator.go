@@ -0,0 +1,109 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+import (
+	"bytes"
+	"sort"
+)
+
+// PrefixRange return the key range [start, limit) covering all keys with the prefix.
+func PrefixRange(prefix []byte) ([]byte, []byte) {
+	if len(prefix) == 0 {
+		return nil, nil
+	}
+
+	var limit []byte
+	for i := len(prefix) - 1; i >= 0; i-- {
+		c := prefix[i]
+		if c < 0xff {
+			limit = make([]byte, i+1)
+			copy(limit, prefix)
+			limit[i] = c + 1
+			break
+		}
+	}
+	return prefix, limit
+}
+
+// inRange check whether the key is in [start, limit).
+func inRange(key []byte, start []byte, limit []byte) bool {
+	if start != nil && bytes.Compare(key, start) < 0 {
+		return false
+	}
+	if limit != nil && bytes.Compare(key, limit) >= 0 {
+		return false
+	}
+	return true
+}
+
+func copyBytes(src []byte) []byte {
+	if src == nil {
+		return nil
+	}
+	dst := make([]byte, len(src))
+	copy(dst, src)
+	return dst
+}
+
+// sliceIterator iterates over sorted entries held in memory.
+type sliceIterator struct {
+	entries []*kv
+	pos     int
+}
+
+func newSliceIterator(entries []*kv) *sliceIterator {
+	sort.Slice(entries, func(i, j int) bool {
+		return bytes.Compare(entries[i].k, entries[j].k) < 0
+	})
+	return &sliceIterator{
+		entries: entries,
+		pos:     -1,
+	}
+}
+
+// Next move to the next entry.
+func (it *sliceIterator) Next() (bool, error) {
+	if it.pos < len(it.entries) {
+		it.pos++
+	}
+	return it.pos < len(it.entries), nil
+}
+
+// Key return the key of current entry.
+func (it *sliceIterator) Key() []byte {
+	if it.pos < 0 || it.pos >= len(it.entries) {
+		return nil
+	}
+	return it.entries[it.pos].k
+}
+
+// Value return the value of current entry.
+func (it *sliceIterator) Value() []byte {
+	if it.pos < 0 || it.pos >= len(it.entries) {
+		return nil
+	}
+	return it.entries[it.pos].v
+}
+
+// Release release the iterator.
+func (it *sliceIterator) Release() {
+	it.entries = nil
+	it.pos = 0
+}
@@ -0,0 +1,286 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+import (
+	"bytes"
+	"errors"
+)
+
+// Keyspaces of chain data. A keyspace is a column family in RocksStorage and
+// a key prefix namespace in DiskStorage and MemoryStorage.
+const (
+	DefaultKeyspace   = "default"
+	BlockKeyspace     = "block"
+	StateKeyspace     = "state"
+	IndexKeyspace     = "index"
+	MetaKeyspace      = "meta"
+	FinalityKeyspace  = "finality"
+	NRKeyspace        = "nr"
+	DipKeyspace       = "dip"
+	MinerKeyspace     = "miner"
+	SignerKeyspace    = "signer"
+	PreCommitKeyspace = "precommit"
+	CodeCacheKeyspace = "codecache"
+)
+
+// Errors
+var (
+	ErrInvalidKeyspace = errors.New("invalid keyspace name")
+)
+
+// KeyspaceStorage is a Storage partitioned into named keyspaces.
+// The methods of Storage operate on the default keyspace, and the batch
+// write is shared by all keyspaces of the same KeyspaceStorage.
+type KeyspaceStorage interface {
+	Storage
+
+	// Keyspace return the Storage of the named keyspace, create it if missing.
+	Keyspace(name string) (Storage, error)
+
+	// DropKeyspace delete all entries in the named keyspace.
+	DropKeyspace(name string) error
+}
+
+// OpenKeyspace return the named keyspace of the storage,
+// or the storage itself if it doesn't support keyspaces.
+func OpenKeyspace(stor Storage, name string) (Storage, error) {
+	if ks, ok := stor.(KeyspaceStorage); ok {
+		return ks.Keyspace(name)
+	}
+	return stor, nil
+}
+
+// keyspaceMark leads the keys of all prefix namespaced keyspaces, it keeps
+// them apart from the entries of default keyspace.
+var keyspaceMark = []byte("\x00\x00nebks:")
+
+func keyspacePrefix(name string) ([]byte, error) {
+	if len(name) == 0 || name == DefaultKeyspace || bytes.IndexByte([]byte(name), '/') >= 0 {
+		return nil, ErrInvalidKeyspace
+	}
+	prefix := make([]byte, 0, len(keyspaceMark)+len(name)+1)
+	prefix = append(prefix, keyspaceMark...)
+	prefix = append(prefix, name...)
+	return append(prefix, '/'), nil
+}
+
+// prefixBackend is a storage whose raw keyspace can hold prefix namespaces.
+type prefixBackend interface {
+	Storage
+	rawRangeIterator(start []byte, limit []byte) (Iterator, error)
+	rawSnapshot() (Snapshot, error)
+}
+
+// prefixKeyspace a keyspace stored under a key prefix.
+type prefixKeyspace struct {
+	backend prefixBackend
+	prefix  []byte
+}
+
+func newPrefixKeyspace(backend prefixBackend, name string) (*prefixKeyspace, error) {
+	prefix, err := keyspacePrefix(name)
+	if err != nil {
+		return nil, err
+	}
+	return &prefixKeyspace{
+		backend: backend,
+		prefix:  prefix,
+	}, nil
+}
+
+func (ks *prefixKeyspace) key(key []byte) []byte {
+	k := make([]byte, 0, len(ks.prefix)+len(key))
+	k = append(k, ks.prefix...)
+	return append(k, key...)
+}
+
+// Get return value to the key in keyspace
+func (ks *prefixKeyspace) Get(key []byte) ([]byte, error) {
+	return ks.backend.Get(ks.key(key))
+}
+
+// Put put the key-value entry to keyspace
+func (ks *prefixKeyspace) Put(key []byte, value []byte) error {
+	return ks.backend.Put(ks.key(key), value)
+}
+
+// Del delete the key in keyspace.
+func (ks *prefixKeyspace) Del(key []byte) error {
+	return ks.backend.Del(ks.key(key))
+}
+
+// NewIterator return an iterator over the entries whose key has the prefix.
+func (ks *prefixKeyspace) NewIterator(prefix []byte) (Iterator, error) {
+	start, limit := PrefixRange(prefix)
+	return ks.NewRangeIterator(start, limit)
+}
+
+// NewRangeIterator return an iterator over the entries in [start, limit).
+func (ks *prefixKeyspace) NewRangeIterator(start []byte, limit []byte) (Iterator, error) {
+	s, l := ks.keyRange(start, limit)
+	iter, err := ks.backend.rawRangeIterator(s, l)
+	if err != nil {
+		return nil, err
+	}
+	return &prefixIterator{Iterator: iter, size: len(ks.prefix)}, nil
+}
+
+// NewSnapshot return a point-in-time read-only view of keyspace.
+func (ks *prefixKeyspace) NewSnapshot() (Snapshot, error) {
+	snap, err := ks.backend.rawSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	return &prefixSnapshot{snap: snap, ks: ks}, nil
+}
+
+// EnableBatch enable batch write.
+func (ks *prefixKeyspace) EnableBatch() {
+	ks.backend.EnableBatch()
+}
+
+// DisableBatch disable batch write.
+func (ks *prefixKeyspace) DisableBatch() {
+	ks.backend.DisableBatch()
+}
+
+// Flush write and flush pending batch write.
+func (ks *prefixKeyspace) Flush() error {
+	return ks.backend.Flush()
+}
+
+func (ks *prefixKeyspace) keyRange(start []byte, limit []byte) ([]byte, []byte) {
+	s := ks.key(start)
+	if limit != nil {
+		return s, ks.key(limit)
+	}
+	_, l := PrefixRange(ks.prefix)
+	return s, l
+}
+
+// drop delete all entries in the keyspace.
+func (ks *prefixKeyspace) drop() error {
+	iter, err := ks.backend.rawRangeIterator(PrefixRange(ks.prefix))
+	if err != nil {
+		return err
+	}
+	defer iter.Release()
+
+	for {
+		exist, err := iter.Next()
+		if err != nil {
+			return err
+		}
+		if !exist {
+			return nil
+		}
+		if err := ks.backend.Del(iter.Key()); err != nil {
+			return err
+		}
+	}
+}
+
+// prefixSnapshot a snapshot of prefix namespaced keyspace.
+type prefixSnapshot struct {
+	snap Snapshot
+	ks   *prefixKeyspace
+}
+
+// Get return value to the key in Snapshot
+func (snapshot *prefixSnapshot) Get(key []byte) ([]byte, error) {
+	return snapshot.snap.Get(snapshot.ks.key(key))
+}
+
+// NewIterator return an iterator over the entries whose key has the prefix.
+func (snapshot *prefixSnapshot) NewIterator(prefix []byte) (Iterator, error) {
+	start, limit := PrefixRange(prefix)
+	return snapshot.NewRangeIterator(start, limit)
+}
+
+// NewRangeIterator return an iterator over the entries in [start, limit).
+func (snapshot *prefixSnapshot) NewRangeIterator(start []byte, limit []byte) (Iterator, error) {
+	s, l := snapshot.ks.keyRange(start, limit)
+	iter, err := snapshot.snap.NewRangeIterator(s, l)
+	if err != nil {
+		return nil, err
+	}
+	return &prefixIterator{Iterator: iter, size: len(snapshot.ks.prefix)}, nil
+}
+
+// Release release the snapshot.
+func (snapshot *prefixSnapshot) Release() {
+	snapshot.snap.Release()
+}
+
+// prefixIterator strips the keyspace prefix from keys.
+type prefixIterator struct {
+	Iterator
+	size int
+}
+
+// Key return the key of current entry.
+func (it *prefixIterator) Key() []byte {
+	key := it.Iterator.Key()
+	if len(key) < it.size {
+		return nil
+	}
+	return key[it.size:]
+}
+
+// defaultIterator skips the entries of prefix namespaced keyspaces.
+type defaultIterator struct {
+	Iterator
+}
+
+// Next move to the next entry of default keyspace.
+func (it *defaultIterator) Next() (bool, error) {
+	for {
+		exist, err := it.Iterator.Next()
+		if err != nil || !exist {
+			return exist, err
+		}
+		if !bytes.HasPrefix(it.Iterator.Key(), keyspaceMark) {
+			return true, nil
+		}
+	}
+}
+
+// defaultSnapshot a snapshot of default keyspace.
+type defaultSnapshot struct {
+	Snapshot
+}
+
+// NewIterator return an iterator over the entries whose key has the prefix.
+func (snapshot *defaultSnapshot) NewIterator(prefix []byte) (Iterator, error) {
+	iter, err := snapshot.Snapshot.NewIterator(prefix)
+	if err != nil {
+		return nil, err
+	}
+	return &defaultIterator{iter}, nil
+}
+
+// NewRangeIterator return an iterator over the entries in [start, limit).
+func (snapshot *defaultSnapshot) NewRangeIterator(start []byte, limit []byte) (Iterator, error) {
+	iter, err := snapshot.Snapshot.NewRangeIterator(start, limit)
+	if err != nil {
+		return nil, err
+	}
+	return &defaultIterator{iter}, nil
+}
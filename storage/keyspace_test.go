@@ -0,0 +1,96 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testKeyspaceStorage(t *testing.T, stor KeyspaceStorage) {
+	block, err := stor.Keyspace(BlockKeyspace)
+	assert.Nil(t, err)
+	state, err := stor.Keyspace(StateKeyspace)
+	assert.Nil(t, err)
+
+	key := []byte("key")
+	assert.Nil(t, stor.Put(key, []byte("default")))
+	assert.Nil(t, block.Put(key, []byte("block")))
+	assert.Nil(t, state.Put(key, []byte("state")))
+	assert.Nil(t, state.Put([]byte("key2"), []byte("state2")))
+
+	value, err := stor.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("default"), value)
+	value, err = block.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("block"), value)
+	value, err = state.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("state"), value)
+
+	iter, err := stor.NewIterator(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"key"}, collectKeys(t, iter))
+	iter, err = state.NewIterator(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"key", "key2"}, collectKeys(t, iter))
+	iter, err = state.NewRangeIterator([]byte("key1"), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"key2"}, collectKeys(t, iter))
+
+	snap, err := block.NewSnapshot()
+	assert.Nil(t, err)
+	assert.Nil(t, block.Del(key))
+	value, err = snap.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("block"), value)
+	snap.Release()
+	_, err = block.Get(key)
+	assert.Equal(t, ErrKeyNotFound, err)
+
+	assert.Nil(t, stor.DropKeyspace(StateKeyspace))
+	_, err = state.Get(key)
+	assert.Equal(t, ErrKeyNotFound, err)
+	value, err = stor.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("default"), value)
+
+	assert.Equal(t, ErrInvalidKeyspace, stor.DropKeyspace(DefaultKeyspace))
+}
+
+func TestMemoryStorage_Keyspace(t *testing.T) {
+	stor, err := NewMemoryStorage()
+	assert.Nil(t, err)
+	testKeyspaceStorage(t, stor)
+}
+
+func TestDiskStorage_Keyspace(t *testing.T) {
+	file := "keyspace.db"
+	os.RemoveAll(file)
+	defer os.RemoveAll(file)
+
+	stor, err := NewDiskStorage(file)
+	assert.Nil(t, err)
+	defer stor.Close()
+
+	testKeyspaceStorage(t, stor)
+}
@@ -65,6 +65,87 @@ func (db *MemoryStorage) Del(key []byte) error {
 	return nil
 }
 
+// NewIterator return an iterator over the entries whose key has the prefix.
+func (db *MemoryStorage) NewIterator(prefix []byte) (Iterator, error) {
+	start, limit := PrefixRange(prefix)
+	return db.NewRangeIterator(start, limit)
+}
+
+// NewRangeIterator return an iterator over the entries in [start, limit).
+func (db *MemoryStorage) NewRangeIterator(start []byte, limit []byte) (Iterator, error) {
+	iter, err := db.rawRangeIterator(start, limit)
+	if err != nil {
+		return nil, err
+	}
+	return &defaultIterator{iter}, nil
+}
+
+// NewSnapshot return a point-in-time read-only view of Storage.
+func (db *MemoryStorage) NewSnapshot() (Snapshot, error) {
+	snap, err := db.rawSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	return &defaultSnapshot{snap}, nil
+}
+
+// Keyspace return the Storage of the named keyspace.
+func (db *MemoryStorage) Keyspace(name string) (Storage, error) {
+	if name == DefaultKeyspace {
+		return db, nil
+	}
+	return newPrefixKeyspace(db, name)
+}
+
+// DropKeyspace delete all entries in the named keyspace.
+func (db *MemoryStorage) DropKeyspace(name string) error {
+	ks, err := newPrefixKeyspace(db, name)
+	if err != nil {
+		return err
+	}
+	return ks.drop()
+}
+
+func (db *MemoryStorage) rawRangeIterator(start []byte, limit []byte) (Iterator, error) {
+	entries, err := db.collect(start, limit)
+	if err != nil {
+		return nil, err
+	}
+	return newSliceIterator(entries), nil
+}
+
+func (db *MemoryStorage) rawSnapshot() (Snapshot, error) {
+	entries, err := db.collect(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make(map[string][]byte, len(entries))
+	for _, entry := range entries {
+		data[byteutils.Hex(entry.k)] = entry.v
+	}
+	return &MemorySnapshot{data: data}, nil
+}
+
+func (db *MemoryStorage) collect(start []byte, limit []byte) ([]*kv, error) {
+	var (
+		entries []*kv
+		err     error
+	)
+	db.data.Range(func(key, value interface{}) bool {
+		k, e := byteutils.FromHex(key.(string))
+		if e != nil {
+			err = e
+			return false
+		}
+		if inRange(k, start, limit) {
+			entries = append(entries, &kv{k, value.([]byte)})
+		}
+		return true
+	})
+	return entries, err
+}
+
 // EnableBatch enable batch write.
 func (db *MemoryStorage) EnableBatch() {
 }
@@ -77,3 +158,56 @@ func (db *MemoryStorage) Flush() error {
 // DisableBatch disable batch write.
 func (db *MemoryStorage) DisableBatch() {
 }
+
+// Close MemoryStorage, the entries are kept.
+func (db *MemoryStorage) Close() error {
+	return nil
+}
+
+// MemorySnapshot a point-in-time copy of MemoryStorage.
+type MemorySnapshot struct {
+	data     map[string][]byte
+	released bool
+}
+
+// Get return value to the key in Snapshot
+func (snap *MemorySnapshot) Get(key []byte) ([]byte, error) {
+	if snap.released {
+		return nil, ErrSnapshotReleased
+	}
+	if value, ok := snap.data[byteutils.Hex(key)]; ok {
+		return value, nil
+	}
+	return nil, ErrKeyNotFound
+}
+
+// NewIterator return an iterator over the entries whose key has the prefix.
+func (snap *MemorySnapshot) NewIterator(prefix []byte) (Iterator, error) {
+	start, limit := PrefixRange(prefix)
+	return snap.NewRangeIterator(start, limit)
+}
+
+// NewRangeIterator return an iterator over the entries in [start, limit).
+func (snap *MemorySnapshot) NewRangeIterator(start []byte, limit []byte) (Iterator, error) {
+	if snap.released {
+		return nil, ErrSnapshotReleased
+	}
+
+	var entries []*kv
+	for key, value := range snap.data {
+		k, err := byteutils.FromHex(key)
+		if err != nil {
+			return nil, err
+		}
+		if inRange(k, start, limit) {
+			entries = append(entries, &kv{k, value})
+		}
+	}
+	return newSliceIterator(entries), nil
+}
+
+// Release release the snapshot.
+func (snap *MemorySnapshot) Release() {
+	snap.released = true
+	snap.data = nil
+}
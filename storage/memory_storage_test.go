@@ -0,0 +1,108 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func collectKeys(t *testing.T, iter Iterator) []string {
+	var keys []string
+	for {
+		exist, err := iter.Next()
+		assert.Nil(t, err)
+		if !exist {
+			break
+		}
+		keys = append(keys, string(iter.Key()))
+	}
+	iter.Release()
+	return keys
+}
+
+func testStorageIterator(t *testing.T, stor Storage) {
+	for _, k := range []string{"b2", "a1", "b1", "c1", "b3"} {
+		assert.Nil(t, stor.Put([]byte(k), []byte("v"+k)))
+	}
+
+	iter, err := stor.NewIterator([]byte("b"))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"b1", "b2", "b3"}, collectKeys(t, iter))
+
+	iter, err = stor.NewIterator(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a1", "b1", "b2", "b3", "c1"}, collectKeys(t, iter))
+
+	iter, err = stor.NewRangeIterator([]byte("b2"), []byte("c1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"b2", "b3"}, collectKeys(t, iter))
+
+	iter, err = stor.NewIterator([]byte("b1"))
+	assert.Nil(t, err)
+	exist, err := iter.Next()
+	assert.Nil(t, err)
+	assert.True(t, exist)
+	assert.Equal(t, []byte("vb1"), iter.Value())
+	iter.Release()
+
+	snap, err := stor.NewSnapshot()
+	assert.Nil(t, err)
+	assert.Nil(t, stor.Put([]byte("b4"), []byte("vb4")))
+	assert.Nil(t, stor.Del([]byte("a1")))
+
+	value, err := snap.Get([]byte("a1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("va1"), value)
+	_, err = snap.Get([]byte("b4"))
+	assert.Equal(t, ErrKeyNotFound, err)
+
+	iter, err = snap.NewIterator([]byte("b"))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"b1", "b2", "b3"}, collectKeys(t, iter))
+	snap.Release()
+
+	iter, err = stor.NewIterator([]byte("b"))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"b1", "b2", "b3", "b4"}, collectKeys(t, iter))
+}
+
+func TestMemoryStorage_Iterator(t *testing.T) {
+	stor, err := NewMemoryStorage()
+	assert.Nil(t, err)
+	testStorageIterator(t, stor)
+}
+
+func TestPrefixRange(t *testing.T) {
+	tests := []struct {
+		prefix []byte
+		limit  []byte
+	}{
+		{nil, nil},
+		{[]byte{0x01}, []byte{0x02}},
+		{[]byte{0x01, 0xff}, []byte{0x02}},
+		{[]byte{0xff, 0xff}, nil},
+	}
+	for _, tt := range tests {
+		start, limit := PrefixRange(tt.prefix)
+		assert.Equal(t, tt.prefix, start)
+		assert.Equal(t, tt.limit, limit)
+	}
+}
@@ -1,6 +1,9 @@
+// +build !norocksdb
+
 package storage
 
 import (
+	"bytes"
 	"strconv"
 	"sync"
 	"time"
@@ -15,42 +18,69 @@ type RocksStorage struct {
 	db          *gorocksdb.DB
 	enableBatch bool
 	mutex       sync.Mutex
-	batchOpts   map[string]*batchOpt
+	batchOpts   map[string]*rocksBatchOpt
 
 	ro *gorocksdb.ReadOptions
 	wo *gorocksdb.WriteOptions
 
 	cache *gorocksdb.Cache
+
+	cfMutex sync.RWMutex
+	cfs     map[string]*gorocksdb.ColumnFamilyHandle
+}
+
+type rocksBatchOpt struct {
+	cf      *gorocksdb.ColumnFamilyHandle
+	key     []byte
+	value   []byte
+	deleted bool
+}
+
+func init() {
+	Register(RocksBackend, func(path string) (ClosableStorage, error) {
+		return NewRocksStorage(path)
+	})
+	DefaultBackend = RocksBackend
 }
 
 // NewRocksStorage init a storage
 func NewRocksStorage(path string) (*RocksStorage, error) {
 
-	filter := gorocksdb.NewBloomFilter(10)
-	bbto := gorocksdb.NewDefaultBlockBasedTableOptions()
-	bbto.SetFilterPolicy(filter)
-
 	cache := gorocksdb.NewLRUCache(512 << 20)
-	bbto.SetBlockCache(cache)
-	opts := gorocksdb.NewDefaultOptions()
-	opts.SetBlockBasedTableFactory(bbto)
+	opts := rocksKeyspaceOptions(DefaultKeyspace, cache)
 	opts.SetCreateIfMissing(true)
+	opts.SetCreateIfMissingColumnFamilies(true)
 	opts.SetMaxOpenFiles(500)
-	opts.SetWriteBufferSize(64 * opt.MiB) //Default: 4MB
-	opts.IncreaseParallelism(4)           //flush and compaction thread
+	opts.IncreaseParallelism(4) //flush and compaction thread
+
+	// open all existing column families, a new database only has the default one.
+	names, err := gorocksdb.ListColumnFamilies(opts, path)
+	if err != nil || len(names) == 0 {
+		names = []string{DefaultKeyspace}
+	}
+	cfOpts := make([]*gorocksdb.Options, len(names))
+	for i, name := range names {
+		cfOpts[i] = rocksKeyspaceOptions(name, cache)
+	}
 
-	db, err := gorocksdb.OpenDb(opts, path)
+	db, handles, err := gorocksdb.OpenDbColumnFamilies(opts, path, names, cfOpts)
 	if err != nil {
 		return nil, err
 	}
 
+	cfs := make(map[string]*gorocksdb.ColumnFamilyHandle)
+	for i, name := range names {
+		cfs[name] = handles[i]
+	}
+
 	storage := &RocksStorage{
 		db:          db,
 		cache:       cache,
 		enableBatch: false,
-		batchOpts:   make(map[string]*batchOpt),
+		batchOpts:   make(map[string]*rocksBatchOpt),
 		ro:          gorocksdb.NewDefaultReadOptions(),
 		wo:          gorocksdb.NewDefaultWriteOptions(),
+		cfs:         cfs,
 	}
 
 	//go RecordMetrics(storage)
@@ -58,29 +88,102 @@ func NewRocksStorage(path string) (*RocksStorage, error) {
 	return storage, nil
 }
 
+// rocksKeyspaceOptions return the column family options tuned for the keyspace.
+func rocksKeyspaceOptions(name string, cache *gorocksdb.Cache) *gorocksdb.Options {
+	filter := gorocksdb.NewBloomFilter(10)
+	bbto := gorocksdb.NewDefaultBlockBasedTableOptions()
+	bbto.SetFilterPolicy(filter)
+	bbto.SetBlockCache(cache)
+
+	opts := gorocksdb.NewDefaultOptions()
+	opts.SetBlockBasedTableFactory(bbto)
+
+	switch name {
+	case StateKeyspace:
+		// trie nodes are random written and dominate the database.
+		opts.SetWriteBufferSize(128 * opt.MiB)
+		opts.SetMaxWriteBufferNumber(4)
+	case IndexKeyspace, MetaKeyspace, FinalityKeyspace, NRKeyspace, DipKeyspace, MinerKeyspace, SignerKeyspace, PreCommitKeyspace:
+		// small keyspaces of point lookups.
+		opts.SetWriteBufferSize(4 * opt.MiB)
+	default:
+		opts.SetWriteBufferSize(64 * opt.MiB) //Default: 4MB
+	}
+	return opts
+}
+
+func (storage *RocksStorage) handle(name string) (*gorocksdb.ColumnFamilyHandle, error) {
+	storage.cfMutex.RLock()
+	cf := storage.cfs[name]
+	storage.cfMutex.RUnlock()
+	if cf != nil {
+		return cf, nil
+	}
+
+	if len(name) == 0 {
+		return nil, ErrInvalidKeyspace
+	}
+
+	storage.cfMutex.Lock()
+	defer storage.cfMutex.Unlock()
+
+	if cf = storage.cfs[name]; cf != nil {
+		return cf, nil
+	}
+	cf, err := storage.db.CreateColumnFamily(rocksKeyspaceOptions(name, storage.cache), name)
+	if err != nil {
+		return nil, err
+	}
+	storage.cfs[name] = cf
+	return cf, nil
+}
+
 // Get return value to the key in Storage
 func (storage *RocksStorage) Get(key []byte) ([]byte, error) {
+	return storage.get(DefaultKeyspace, key)
+}
 
-	value, err := storage.db.GetBytes(storage.ro, key)
+// Put put the key-value entry to Storage
+func (storage *RocksStorage) Put(key []byte, value []byte) error {
+	return storage.put(DefaultKeyspace, key, value)
+}
+
+// Del delete the key in Storage.
+func (storage *RocksStorage) Del(key []byte) error {
+	return storage.del(DefaultKeyspace, key)
+}
 
+func (storage *RocksStorage) get(name string, key []byte) ([]byte, error) {
+	cf, err := storage.handle(name)
 	if err != nil {
 		return nil, err
 	}
 
-	if value == nil {
+	value, err := storage.db.GetCF(storage.ro, cf, key)
+	if err != nil {
+		return nil, err
+	}
+	defer value.Free()
+
+	if value.Data() == nil {
 		return nil, ErrKeyNotFound
 	}
 
-	return value, err
+	return copyBytes(value.Data()), nil
 }
 
-// Put put the key-value entry to Storage
-func (storage *RocksStorage) Put(key []byte, value []byte) error {
+func (storage *RocksStorage) put(name string, key []byte, value []byte) error {
+	cf, err := storage.handle(name)
+	if err != nil {
+		return err
+	}
+
 	if storage.enableBatch {
 		storage.mutex.Lock()
 		defer storage.mutex.Unlock()
 
-		storage.batchOpts[byteutils.Hex(key)] = &batchOpt{
+		storage.batchOpts[name+"/"+byteutils.Hex(key)] = &rocksBatchOpt{
+			cf:      cf,
 			key:     key,
 			value:   value,
 			deleted: false,
@@ -89,27 +192,119 @@ func (storage *RocksStorage) Put(key []byte, value []byte) error {
 		return nil
 	}
 
-	return storage.db.Put(storage.wo, key, value)
+	return storage.db.PutCF(storage.wo, cf, key, value)
 }
 
-// Del delete the key in Storage.
-func (storage *RocksStorage) Del(key []byte) error {
+func (storage *RocksStorage) del(name string, key []byte) error {
+	cf, err := storage.handle(name)
+	if err != nil {
+		return err
+	}
+
 	if storage.enableBatch {
 		storage.mutex.Lock()
 		defer storage.mutex.Unlock()
 
-		storage.batchOpts[byteutils.Hex(key)] = &batchOpt{
+		storage.batchOpts[name+"/"+byteutils.Hex(key)] = &rocksBatchOpt{
+			cf:      cf,
 			key:     key,
 			deleted: true,
 		}
 
 		return nil
 	}
-	return storage.db.Delete(storage.wo, key)
+	return storage.db.DeleteCF(storage.wo, cf, key)
+}
+
+// NewIterator return an iterator over the entries whose key has the prefix.
+func (storage *RocksStorage) NewIterator(prefix []byte) (Iterator, error) {
+	start, limit := PrefixRange(prefix)
+	return storage.NewRangeIterator(start, limit)
+}
+
+// NewRangeIterator return an iterator over the entries in [start, limit).
+func (storage *RocksStorage) NewRangeIterator(start []byte, limit []byte) (Iterator, error) {
+	return storage.newRangeIterator(DefaultKeyspace, start, limit)
+}
+
+// NewSnapshot return a point-in-time read-only view of Storage.
+func (storage *RocksStorage) NewSnapshot() (Snapshot, error) {
+	return storage.newSnapshot(DefaultKeyspace)
+}
+
+func (storage *RocksStorage) newRangeIterator(name string, start []byte, limit []byte) (Iterator, error) {
+	cf, err := storage.handle(name)
+	if err != nil {
+		return nil, err
+	}
+	return newRocksIterator(storage.db.NewIteratorCF(storage.ro, cf), start, limit), nil
+}
+
+func (storage *RocksStorage) newSnapshot(name string) (Snapshot, error) {
+	cf, err := storage.handle(name)
+	if err != nil {
+		return nil, err
+	}
+
+	snap := storage.db.NewSnapshot()
+	ro := gorocksdb.NewDefaultReadOptions()
+	ro.SetSnapshot(snap)
+
+	return &RocksSnapshot{
+		db:   storage.db,
+		cf:   cf,
+		snap: snap,
+		ro:   ro,
+	}, nil
+}
+
+// Keyspace return the Storage of the named keyspace, the column family is created if missing.
+func (storage *RocksStorage) Keyspace(name string) (Storage, error) {
+	if name == DefaultKeyspace {
+		return storage, nil
+	}
+	if _, err := storage.handle(name); err != nil {
+		return nil, err
+	}
+	return &RocksKeyspace{storage: storage, name: name}, nil
+}
+
+// DropKeyspace drop the column family of the keyspace and recreate it empty.
+func (storage *RocksStorage) DropKeyspace(name string) error {
+	if len(name) == 0 || name == DefaultKeyspace {
+		return ErrInvalidKeyspace
+	}
+
+	storage.cfMutex.Lock()
+	defer storage.cfMutex.Unlock()
+
+	cf := storage.cfs[name]
+	if cf == nil {
+		return nil
+	}
+	if err := storage.db.DropColumnFamily(cf); err != nil {
+		return err
+	}
+	cf.Destroy()
+	delete(storage.cfs, name)
+
+	cf, err := storage.db.CreateColumnFamily(rocksKeyspaceOptions(name, storage.cache), name)
+	if err != nil {
+		return err
+	}
+	storage.cfs[name] = cf
+	return nil
 }
 
 // Close levelDB
 func (storage *RocksStorage) Close() error {
+	storage.cfMutex.Lock()
+	for _, cf := range storage.cfs {
+		cf.Destroy()
+	}
+	storage.cfs = make(map[string]*gorocksdb.ColumnFamilyHandle)
+	storage.cfMutex.Unlock()
+
 	storage.db.Close()
 	return nil
 }
@@ -137,12 +332,12 @@ func (storage *RocksStorage) Flush() error {
 
 	for _, opt := range storage.batchOpts {
 		if opt.deleted {
-			wb.Delete(opt.key)
+			wb.DeleteCF(opt.cf, opt.key)
 		} else {
-			wb.Put(opt.key, opt.value)
+			wb.PutCF(opt.cf, opt.key, opt.value)
 		}
 	}
-	storage.batchOpts = make(map[string]*batchOpt)
+	storage.batchOpts = make(map[string]*rocksBatchOpt)
 
 	err := storage.db.Write(storage.wo, wb)
 
@@ -157,11 +352,185 @@ func (storage *RocksStorage) Flush() error {
 func (storage *RocksStorage) DisableBatch() {
 	storage.mutex.Lock()
 	defer storage.mutex.Unlock()
-	storage.batchOpts = make(map[string]*batchOpt)
+	storage.batchOpts = make(map[string]*rocksBatchOpt)
 
 	storage.enableBatch = false
 }
 
+// RocksKeyspace a column family of RocksStorage, it shares the batch write with RocksStorage.
+type RocksKeyspace struct {
+	storage *RocksStorage
+	name    string
+}
+
+// Get return value to the key in keyspace
+func (ks *RocksKeyspace) Get(key []byte) ([]byte, error) {
+	return ks.storage.get(ks.name, key)
+}
+
+// Put put the key-value entry to keyspace
+func (ks *RocksKeyspace) Put(key []byte, value []byte) error {
+	return ks.storage.put(ks.name, key, value)
+}
+
+// Del delete the key in keyspace.
+func (ks *RocksKeyspace) Del(key []byte) error {
+	return ks.storage.del(ks.name, key)
+}
+
+// NewIterator return an iterator over the entries whose key has the prefix.
+func (ks *RocksKeyspace) NewIterator(prefix []byte) (Iterator, error) {
+	start, limit := PrefixRange(prefix)
+	return ks.NewRangeIterator(start, limit)
+}
+
+// NewRangeIterator return an iterator over the entries in [start, limit).
+func (ks *RocksKeyspace) NewRangeIterator(start []byte, limit []byte) (Iterator, error) {
+	return ks.storage.newRangeIterator(ks.name, start, limit)
+}
+
+// NewSnapshot return a point-in-time read-only view of keyspace.
+func (ks *RocksKeyspace) NewSnapshot() (Snapshot, error) {
+	return ks.storage.newSnapshot(ks.name)
+}
+
+// EnableBatch enable batch write.
+func (ks *RocksKeyspace) EnableBatch() {
+	ks.storage.EnableBatch()
+}
+
+// DisableBatch disable batch write.
+func (ks *RocksKeyspace) DisableBatch() {
+	ks.storage.DisableBatch()
+}
+
+// Flush write and flush pending batch write.
+func (ks *RocksKeyspace) Flush() error {
+	return ks.storage.Flush()
+}
+
+// RocksSnapshot a point-in-time view of RocksStorage.
+type RocksSnapshot struct {
+	mutex    sync.Mutex
+	db       *gorocksdb.DB
+	cf       *gorocksdb.ColumnFamilyHandle
+	snap     *gorocksdb.Snapshot
+	ro       *gorocksdb.ReadOptions
+	released bool
+}
+
+// Get return value to the key in Snapshot
+func (snapshot *RocksSnapshot) Get(key []byte) ([]byte, error) {
+	snapshot.mutex.Lock()
+	defer snapshot.mutex.Unlock()
+
+	if snapshot.released {
+		return nil, ErrSnapshotReleased
+	}
+
+	value, err := snapshot.db.GetCF(snapshot.ro, snapshot.cf, key)
+	if err != nil {
+		return nil, err
+	}
+	defer value.Free()
+
+	if value.Data() == nil {
+		return nil, ErrKeyNotFound
+	}
+	return copyBytes(value.Data()), nil
+}
+
+// NewIterator return an iterator over the entries whose key has the prefix.
+func (snapshot *RocksSnapshot) NewIterator(prefix []byte) (Iterator, error) {
+	start, limit := PrefixRange(prefix)
+	return snapshot.NewRangeIterator(start, limit)
+}
+
+// NewRangeIterator return an iterator over the entries in [start, limit).
+func (snapshot *RocksSnapshot) NewRangeIterator(start []byte, limit []byte) (Iterator, error) {
+	snapshot.mutex.Lock()
+	defer snapshot.mutex.Unlock()
+
+	if snapshot.released {
+		return nil, ErrSnapshotReleased
+	}
+	return newRocksIterator(snapshot.db.NewIteratorCF(snapshot.ro, snapshot.cf), start, limit), nil
+}
+
+// Release release the snapshot, iterators created from it must be released before.
+func (snapshot *RocksSnapshot) Release() {
+	snapshot.mutex.Lock()
+	defer snapshot.mutex.Unlock()
+
+	if snapshot.released {
+		return
+	}
+	snapshot.released = true
+	snapshot.ro.Destroy()
+	snapshot.db.ReleaseSnapshot(snapshot.snap)
+}
+
+// rocksIterator iterates the entries in [start, limit).
+type rocksIterator struct {
+	iter    *gorocksdb.Iterator
+	limit   []byte
+	started bool
+	key     []byte
+	value   []byte
+}
+
+func newRocksIterator(iter *gorocksdb.Iterator, start []byte, limit []byte) *rocksIterator {
+	if start == nil {
+		iter.SeekToFirst()
+	} else {
+		iter.Seek(start)
+	}
+	return &rocksIterator{
+		iter:  iter,
+		limit: limit,
+	}
+}
+
+// Next move to the next entry.
+func (it *rocksIterator) Next() (bool, error) {
+	if it.started {
+		it.iter.Next()
+	}
+	it.started = true
+	it.key, it.value = nil, nil
+
+	if !it.iter.Valid() {
+		return false, it.iter.Err()
+	}
+
+	key := it.iter.Key()
+	k := copyBytes(key.Data())
+	key.Free()
+	if it.limit != nil && bytes.Compare(k, it.limit) >= 0 {
+		return false, nil
+	}
+
+	value := it.iter.Value()
+	it.key, it.value = k, copyBytes(value.Data())
+	value.Free()
+	return true, nil
+}
+
+// Key return the key of current entry.
+func (it *rocksIterator) Key() []byte {
+	return it.key
+}
+
+// Value return the value of current entry.
+func (it *rocksIterator) Value() []byte {
+	return it.value
+}
+
+// Release release the iterator.
+func (it *rocksIterator) Release() {
+	it.iter.Close()
+}
+
 // RecordMetrics record rocksdb metrics
 func RecordMetrics(storage *RocksStorage) {
 	metricsUpdateChan := time.NewTicker(5 * time.Second).C
@@ -1,3 +1,5 @@
+// +build !norocksdb
+
 package storage
 
 import (
@@ -22,20 +22,61 @@ import "errors"
 
 // const
 var (
-	ErrKeyNotFound = errors.New("not found")
+	ErrKeyNotFound      = errors.New("not found")
+	ErrSnapshotReleased = errors.New("snapshot is released")
 )
 
-// Storage interface of Storage.
-type Storage interface {
+// Iterator iterates the key-value entries of a Storage in ascending key order.
+// Next must be called before the first entry is available.
+type Iterator interface {
+	// Next move to the next entry, return false when the iterator is exhausted.
+	Next() (bool, error)
+
+	// Key return the key of current entry.
+	Key() []byte
+
+	// Value return the value of current entry.
+	Value() []byte
+
+	// Release release the resources held by the iterator.
+	Release()
+}
+
+// Reader interface of read-only Storage.
+type Reader interface {
 	// Get return the value to the key in Storage.
 	Get(key []byte) ([]byte, error)
 
+	// NewIterator return an iterator over the entries whose key has the prefix.
+	// A nil prefix iterates the whole Storage.
+	NewIterator(prefix []byte) (Iterator, error)
+
+	// NewRangeIterator return an iterator over the entries in [start, limit).
+	// A nil start means the first key, a nil limit means no upper bound.
+	NewRangeIterator(start []byte, limit []byte) (Iterator, error)
+}
+
+// Snapshot is a point-in-time read-only view of Storage.
+type Snapshot interface {
+	Reader
+
+	// Release release the snapshot.
+	Release()
+}
+
+// Storage interface of Storage.
+type Storage interface {
+	Reader
+
 	// Put put the key-value entry to Storage.
 	Put(key []byte, value []byte) error
 
 	// Del delete the key entry in Storage.
 	Del(key []byte) error
 
+	// NewSnapshot return a point-in-time read-only view of Storage.
+	NewSnapshot() (Snapshot, error)
+
 	// EnableBatch enable batch write.
 	EnableBatch()
 
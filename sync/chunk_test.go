@@ -166,8 +166,10 @@ func MockGenesisConf() *corepb.Genesis {
 	return &corepb.Genesis{
 		Meta: &corepb.GenesisMeta{ChainId: 0},
 		Consensus: &corepb.GenesisConsensus{
-			Dpos: &corepb.GenesisConsensusDpos{
-				Dynasty: MockDynasty,
+			Engine: &corepb.GenesisConsensus_Dpos{
+				Dpos: &corepb.GenesisConsensusDpos{
+					Dynasty: MockDynasty,
+				},
 			},
 		},
 		TokenDistribution: []*corepb.GenesisTokenDistribution{
@@ -327,7 +329,9 @@ func TestChunk_generateChunkMeta(t *testing.T) {
 		}
 	}
 	tail := blocks[len(blocks)-1]
-	bytes, err := chain2.Storage().Get(tail.Hash())
+	blockStorage, err := storage.OpenKeyspace(neb2.storage, storage.BlockKeyspace)
+	assert.Nil(t, err)
+	bytes, err := blockStorage.Get(tail.Hash())
 	assert.Nil(t, err)
 	pbBlock := new(corepb.Block)
 	checkBlock := new(core.Block)